@@ -0,0 +1,320 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package access
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ManageCaller replaces the actor resolved by audit.ManageActor with the verified identity of the caller while the
+// command access policies are enforced, and rejects the requests carrying an identity which can't be verified. The
+// policies are then evaluated on the verified caller.
+func ManageCaller(dic *di.Container) func(http.Handler) http.Handler {
+	verifier := &identityVerifier{}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			info := container.ConfigurationFrom(dic.Get).Writable.CommandAccess
+			if !info.Enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+			caller, err := verifier.Caller(r, info)
+			if err != nil {
+				lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+				utils.WriteErrorResponse(w, r.Context(), lc, err, "")
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(audit.WithActor(r.Context(), caller)))
+		})
+	}
+}
+
+// identityVerifier resolves the verified identity of the callers. The public keys are loaded from the JWTKeyFile when
+// the first bearer token is verified, and loaded again once the setting or the file is changed.
+type identityVerifier struct {
+	mutex   sync.Mutex
+	keyFile string
+	modTime time.Time
+	keys    []verificationKey
+}
+
+// verificationKey is a public key verifying the JWT signatures, along with its key id if it's loaded from a JWKS
+type verificationKey struct {
+	id  string
+	key interface{}
+}
+
+// Caller returns the identity of the caller of the request. The consumer headers set by the API gateway are trusted
+// only if the request comes from one of the TrustedGateways, and the bearer token only if its signature is verified by
+// one of the keys of the JWTKeyFile. The caller is anonymous if the request carries no identity.
+func (v *identityVerifier) Caller(r *http.Request, info config.CommandAccessInfo) (string, errors.EdgeX) {
+	username := r.Header.Get(audit.ConsumerUsernameHeader)
+	identifier := r.Header.Get(audit.CredentialIdentifierHeader)
+	if username != "" || identifier != "" {
+		if !trustedGateway(r.RemoteAddr, info.TrustedGateways) {
+			return "", errors.NewCommonEdgeX(pkgCommon.KindUnauthorized,
+				fmt.Sprintf("the consumer headers of the request from %s are not trusted", r.RemoteAddr), nil)
+		}
+		if username != "" {
+			return username, nil
+		}
+		return identifier, nil
+	}
+
+	authorization := r.Header.Get(audit.AuthorizationHeader)
+	const bearer = "Bearer "
+	if len(authorization) <= len(bearer) || !strings.EqualFold(authorization[:len(bearer)], bearer) {
+		return audit.Anonymous, nil
+	}
+	subject, err := v.verifyToken(strings.TrimSpace(authorization[len(bearer):]), info.JWTKeyFile)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	return subject, nil
+}
+
+// trustedGateway tells whether the remote address is one of the gateways, given as IPs or CIDRs
+func trustedGateway(remoteAddr string, gateways []string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, gateway := range gateways {
+		if _, network, err := net.ParseCIDR(gateway); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+		} else if gatewayIP := net.ParseIP(gateway); gatewayIP != nil && gatewayIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyToken verifies the signature and the time claims of the token, and returns its sub claim, or its iss claim if
+// the token has no subject. Only the asymmetric signing methods are accepted since the keys are public keys.
+func (v *identityVerifier) verifyToken(token string, keyFile string) (string, errors.EdgeX) {
+	if keyFile == "" {
+		return "", errors.NewCommonEdgeX(pkgCommon.KindUnauthorized, "the bearer token can't be verified since no JWTKeyFile is configured", nil)
+	}
+	keys, edgeXerr := v.loadKeys(keyFile)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var lastErr error
+	for _, k := range keys {
+		claims := &jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+			switch t.Method.(type) {
+			case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA:
+			default:
+				return nil, fmt.Errorf("signing method %v is not accepted", t.Header["alg"])
+			}
+			if kid, ok := t.Header["kid"].(string); ok && k.id != "" && kid != k.id {
+				return nil, fmt.Errorf("key id %s doesn't match", kid)
+			}
+			return k.key, nil
+		})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if claims.Subject != "" {
+			return claims.Subject, nil
+		}
+		if claims.Issuer != "" {
+			return claims.Issuer, nil
+		}
+		return "", errors.NewCommonEdgeX(pkgCommon.KindUnauthorized, "the bearer token has neither a subject nor an issuer", nil)
+	}
+	return "", errors.NewCommonEdgeX(pkgCommon.KindUnauthorized, "fail to verify the bearer token", lastErr)
+}
+
+// loadKeys returns the public keys of the file, which are parsed again only if the file is changed
+func (v *identityVerifier) loadKeys(keyFile string) ([]verificationKey, errors.EdgeX) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	stat, err := os.Stat(keyFile)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to read the JWTKeyFile %s", keyFile), err)
+	}
+	if keyFile == v.keyFile && stat.ModTime().Equal(v.modTime) {
+		return v.keys, nil
+	}
+	bytes, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to read the JWTKeyFile %s", keyFile), err)
+	}
+	keys, edgeXerr := parseKeys(bytes)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to parse the JWTKeyFile %s", keyFile), edgeXerr)
+	}
+	v.keyFile, v.modTime, v.keys = keyFile, stat.ModTime(), keys
+	return keys, nil
+}
+
+// parseKeys parses the public keys from a JWKS document, or from the PEM blocks of public keys and certificates
+func parseKeys(bytes []byte) ([]verificationKey, errors.EdgeX) {
+	if strings.HasPrefix(strings.TrimSpace(string(bytes)), "{") {
+		return parseJWKS(bytes)
+	}
+	var keys []verificationKey
+	for block, rest := pem.Decode(bytes); block != nil; block, rest = pem.Decode(rest) {
+		var key interface{}
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		case "CERTIFICATE":
+			var cert *x509.Certificate
+			if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+				key = cert.PublicKey
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s PEM block", block.Type), err)
+		}
+		keys = append(keys, verificationKey{key: key})
+	}
+	if len(keys) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "no public key found", nil)
+	}
+	return keys, nil
+}
+
+// jsonWebKey is the subset of a JSON Web Key (RFC 7517) describing an RSA or EC public key
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS parses the RSA and EC signature keys of the JWKS document, the other keys are ignored
+func parseJWKS(bytes []byte) ([]verificationKey, errors.EdgeX) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(bytes, &jwks); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid JWKS document", err)
+	}
+	var keys []verificationKey
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var key interface{}
+		var err error
+		switch jwk.Kty {
+		case "RSA":
+			key, err = jwk.rsaPublicKey()
+		case "EC":
+			key, err = jwk.ecPublicKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s key %s", jwk.Kty, jwk.Kid), err)
+		}
+		keys = append(keys, verificationKey{id: jwk.Kid, key: key})
+	}
+	if len(keys) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "no signature key found in the JWKS document", nil)
+	}
+	return keys, nil
+}
+
+func (jwk jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() > int64(^uint32(0)>>1) {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (jwk jsonWebKey) ecPublicKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+	}
+	x, err := decodeBigInt(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(jwk.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("the point is not on curve %s", jwk.Crv)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package access
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyFile(t *testing.T, name string, content []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	return path
+}
+
+func publicKeyPEM(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.RegisteredClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestCaller(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pemFile := writeKeyFile(t, "keys.pem", append(publicKeyPEM(t, &rsaKey.PublicKey), publicKeyPEM(t, &ecKey.PublicKey)...))
+	jwks, err := json.Marshal(map[string][]jsonWebKey{"keys": {
+		{Kty: "RSA", Kid: "rsa1", Use: "sig", N: encodeBigInt(rsaKey.N), E: encodeBigInt(big.NewInt(int64(rsaKey.E)))},
+		{Kty: "EC", Kid: "ec1", Crv: "P-256", X: encodeBigInt(ecKey.X), Y: encodeBigInt(ecKey.Y)},
+		{Kty: "RSA", Kid: "enc1", Use: "enc", N: encodeBigInt(otherKey.N), E: encodeBigInt(big.NewInt(int64(otherKey.E)))},
+	}})
+	require.NoError(t, err)
+	jwksFile := writeKeyFile(t, "jwks.json", jwks)
+
+	subject := jwt.RegisteredClaims{Subject: "operator", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+	issuer := jwt.RegisteredClaims{Issuer: "gateway"}
+	expired := jwt.RegisteredClaims{Subject: "operator", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour))}
+	rsaToken := signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa1", subject)
+	ecToken := signToken(t, jwt.SigningMethodES256, ecKey, "ec1", subject)
+	hmacToken := signToken(t, jwt.SigningMethodHS256, publicKeyPEM(t, &rsaKey.PublicKey), "", subject)
+	noneToken := signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", subject)
+
+	tests := []struct {
+		name          string
+		remoteAddr    string
+		headers       map[string]string
+		keyFile       string
+		expected      string
+		errorExpected bool
+	}{
+		{"consumer headers from trusted gateway", "10.0.0.5:4000", map[string]string{audit.ConsumerUsernameHeader: "admin"}, "", "admin", false},
+		{"credential identifier from trusted gateway", "192.0.2.7:4000", map[string]string{audit.CredentialIdentifierHeader: "id1"}, "", "id1", false},
+		{"consumer headers from untrusted address", "203.0.113.1:4000", map[string]string{audit.ConsumerUsernameHeader: "admin"}, "", "", true},
+		{"RSA token verified by PEM", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + rsaToken}, pemFile, "operator", false},
+		{"EC token verified by PEM", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + ecToken}, pemFile, "operator", false},
+		{"RSA token verified by JWKS", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + rsaToken}, jwksFile, "operator", false},
+		{"EC token verified by JWKS", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "bearer " + ecToken}, jwksFile, "operator", false},
+		{"issuer of token without subject", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + signToken(t, jwt.SigningMethodRS256, rsaKey, "", issuer)}, pemFile, "gateway", false},
+		{"token signed by unknown key", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + signToken(t, jwt.SigningMethodRS256, otherKey, "", subject)}, pemFile, "", true},
+		{"token signed by encryption key", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + signToken(t, jwt.SigningMethodRS256, otherKey, "enc1", subject)}, jwksFile, "", true},
+		{"token with mismatched key id", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + signToken(t, jwt.SigningMethodRS256, rsaKey, "ec1", subject)}, jwksFile, "", true},
+		{"expired token", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + signToken(t, jwt.SigningMethodRS256, rsaKey, "", expired)}, pemFile, "", true},
+		{"HMAC token", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + hmacToken}, pemFile, "", true},
+		{"unsigned token", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + noneToken}, pemFile, "", true},
+		{"malformed token", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer abc"}, pemFile, "", true},
+		{"token without key file", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Bearer " + rsaToken}, "", "", true},
+		{"basic authorization", "203.0.113.1:4000", map[string]string{audit.AuthorizationHeader: "Basic YWRtaW46cGFzcw=="}, "", audit.Anonymous, false},
+		{"no identity", "203.0.113.1:4000", nil, "", audit.Anonymous, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			info := config.CommandAccessInfo{
+				Enabled:         true,
+				TrustedGateways: []string{"10.0.0.5", "192.0.2.0/24", "invalid"},
+				JWTKeyFile:      testCase.keyFile,
+			}
+			req := httptest.NewRequest(http.MethodPut, "/", http.NoBody)
+			req.RemoteAddr = testCase.remoteAddr
+			for header, value := range testCase.headers {
+				req.Header.Set(header, value)
+			}
+
+			caller, err := (&identityVerifier{}).Caller(req, info)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, pkgCommon.KindUnauthorized, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, caller)
+		})
+	}
+}
+
+func TestLoadKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyFile := writeKeyFile(t, "keys.pem", publicKeyPEM(t, &rsaKey.PublicKey))
+	verifier := &identityVerifier{}
+
+	keys, err := verifier.loadKeys(keyFile)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	// the keys are parsed again once the file is changed
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, append(publicKeyPEM(t, &rsaKey.PublicKey), publicKeyPEM(t, &otherKey.PublicKey)...), 0600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(keyFile, later, later))
+	keys, err = verifier.loadKeys(keyFile)
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	_, err = verifier.loadKeys(writeKeyFile(t, "empty.pem", []byte("no keys")))
+	require.Error(t, err)
+	_, err = verifier.loadKeys(filepath.Join(t.TempDir(), "missing.pem"))
+	require.Error(t, err)
+}
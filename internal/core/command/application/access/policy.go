@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Constants of the command access policies
+const (
+	OperationGet = "GET"
+	OperationSet = "SET"
+	EffectAllow  = "allow"
+	EffectDeny   = "deny"
+	Wildcard     = "*"
+)
+
+// Authorize checks that the caller of the request is allowed to issue the command to the device by the command access
+// policies, a Forbidden error is returned and the attempt is logged if it is denied. The caller of a REST request is
+// the identity verified by ManageCaller, which rejects the request beforehand if its identity can't be verified.
+func Authorize(ctx context.Context, dic *di.Container, operation string, device dtos.Device, commandName string) errors.EdgeX {
+	info := container.ConfigurationFrom(dic.Get).Writable.CommandAccess
+	if !info.Enabled {
+		return nil
+	}
+	caller := audit.ActorFromContext(ctx)
+	if caller == "" {
+		caller = audit.Anonymous
+	}
+
+	allowed, policy := Evaluate(info, caller, operation, device, commandName)
+	if allowed {
+		return nil
+	}
+	if policy == "" {
+		policy = "the default effect"
+	} else {
+		policy = "policy " + policy
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Warnf("%s is denied to %s command %s of device %s by %s, correlation id: %s",
+		caller, operation, commandName, device.Name, policy, correlation.FromContext(ctx))
+	return errors.NewCommonEdgeX(pkgCommon.KindForbidden,
+		fmt.Sprintf("%s is not allowed to %s command %s of device %s", caller, operation, commandName, device.Name), nil)
+}
+
+// Evaluate tells whether the caller is allowed to issue the command to the device, along with the name of the policy
+// which decided it. A denying policy takes precedence over the allowing ones, and the DefaultEffect decides if no
+// policy matches, in which case the policy name is empty.
+func Evaluate(info config.CommandAccessInfo, caller string, operation string, device dtos.Device, commandName string) (bool, string) {
+	// the policies are evaluated by name, so that the same policy is reported each time
+	names := make([]string, 0, len(info.Policies))
+	for name := range info.Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	allowedBy := ""
+	for _, name := range names {
+		policy := info.Policies[name]
+		if !policyMatches(policy, caller, operation, device, commandName) {
+			continue
+		}
+		if !strings.EqualFold(policy.Effect, EffectAllow) {
+			return false, name
+		}
+		if allowedBy == "" {
+			allowedBy = name
+		}
+	}
+	if allowedBy != "" {
+		return true, allowedBy
+	}
+	return strings.EqualFold(info.DefaultEffect, EffectAllow), ""
+}
+
+func policyMatches(policy config.CommandAccessPolicy, caller string, operation string, device dtos.Device, commandName string) bool {
+	if !matches(policy.Identities, caller, false) ||
+		!matches(policy.Operations, operation, true) ||
+		!matches(policy.Commands, commandName, false) {
+		return false
+	}
+	if len(policy.Devices) == 0 && len(policy.Labels) == 0 {
+		return true
+	}
+	if len(policy.Devices) > 0 && matches(policy.Devices, device.Name, false) {
+		return true
+	}
+	for _, label := range device.Labels {
+		if len(policy.Labels) > 0 && matches(policy.Labels, label, false) {
+			return true
+		}
+	}
+	return len(policy.Labels) > 0 && contains(policy.Labels, Wildcard, false)
+}
+
+// matches tells whether the value is in the values, any value matches if the values are empty
+func matches(values []string, value string, ignoreCase bool) bool {
+	return len(values) == 0 || contains(values, Wildcard, false) || contains(values, value, ignoreCase)
+}
+
+func contains(values []string, value string, ignoreCase bool) bool {
+	for _, v := range values {
+		if v == value || (ignoreCase && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+	return false
+}
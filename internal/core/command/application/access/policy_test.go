@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package access
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	thermostat = dtos.Device{Name: "thermostat", Labels: []string{"hvac", "floor-1"}}
+	lock       = dtos.Device{Name: "lock", Labels: []string{"security"}}
+)
+
+func testAccessInfo() config.CommandAccessInfo {
+	return config.CommandAccessInfo{
+		Enabled:       true,
+		DefaultEffect: EffectDeny,
+		Policies: map[string]config.CommandAccessPolicy{
+			"anyone-read": {Identities: []string{Wildcard}, Operations: []string{OperationGet}, Effect: EffectAllow},
+			"hvac-write": {Identities: []string{"facility"}, Operations: []string{OperationSet}, Labels: []string{"hvac"},
+				Commands: []string{"setpoint"}, Effect: EffectAllow},
+			"admin-all":     {Identities: []string{"admin"}, Effect: EffectAllow},
+			"no-lock-read":  {Identities: []string{"guest"}, Devices: []string{"lock"}, Effect: EffectDeny},
+			"invalid-write": {Identities: []string{"tester"}, Operations: []string{OperationSet}, Effect: "maybe"},
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	info := testAccessInfo()
+	tests := []struct {
+		name            string
+		caller          string
+		operation       string
+		device          dtos.Device
+		command         string
+		expectedAllowed bool
+		expectedPolicy  string
+	}{
+		{"anyone reads", audit.Anonymous, OperationGet, thermostat, "temperature", true, "anyone-read"},
+		{"operation in lower case", audit.Anonymous, "get", thermostat, "temperature", true, "anyone-read"},
+		{"default effect", audit.Anonymous, OperationSet, thermostat, "setpoint", false, ""},
+		{"write by label", "facility", OperationSet, thermostat, "setpoint", true, "hvac-write"},
+		{"write to another command", "facility", OperationSet, thermostat, "mode", false, ""},
+		{"write to a device without the label", "facility", OperationSet, lock, "setpoint", false, ""},
+		{"any operation", "admin", OperationSet, lock, "unlock", true, "admin-all"},
+		{"deny overrides allow", "guest", OperationGet, lock, "state", false, "no-lock-read"},
+		{"invalid effect denies", "tester", OperationSet, thermostat, "setpoint", false, "invalid-write"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			allowed, policy := Evaluate(info, testCase.caller, testCase.operation, testCase.device, testCase.command)
+			assert.Equal(t, testCase.expectedAllowed, allowed)
+			assert.Equal(t, testCase.expectedPolicy, policy)
+		})
+	}
+}
+
+func TestEvaluateDefaultEffectAllow(t *testing.T) {
+	info := config.CommandAccessInfo{Enabled: true, DefaultEffect: "Allow"}
+	allowed, policy := Evaluate(info, audit.Anonymous, OperationSet, lock, "unlock")
+	assert.True(t, allowed)
+	assert.Empty(t, policy)
+}
+
+func TestAuthorize(t *testing.T) {
+	configuration := &config.ConfigurationStruct{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	// the command access policies are not enforced until they are enabled
+	require.NoError(t, Authorize(context.Background(), dic, OperationSet, lock, "unlock"))
+
+	configuration.Writable.CommandAccess = testAccessInfo()
+	err := Authorize(context.Background(), dic, OperationSet, lock, "unlock")
+	require.Error(t, err)
+	assert.Equal(t, pkgCommon.KindForbidden, errors.Kind(err))
+
+	// the caller is verified from the request by the caller middleware
+	var actorErr errors.EdgeX
+	handler := audit.ManageActor(ManageCaller(dic)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorErr = Authorize(r.Context(), dic, OperationSet, lock, "unlock")
+	})))
+	req := httptest.NewRequest(http.MethodPut, "/", http.NoBody)
+	req.Header.Set(audit.ConsumerUsernameHeader, "admin")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, "the consumer headers shall be rejected from an untrusted gateway")
+
+	configuration.Writable.CommandAccess.TrustedGateways = []string{"192.0.2.0/24"}
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NoError(t, actorErr)
+}
@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/access"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/history"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// batchTarget is a device of a batch, the device is looked up by name when it was selected by name
+type batchTarget struct {
+	deviceName string
+	device     dtos.Device
+	resolved   bool
+}
+
+// serviceAddresses looks up the base address of each device service once per batch, no matter how many of the
+// selected devices are managed by it
+type serviceAddresses struct {
+	cache   *cache.MetadataCache
+	dsc     interfaces.DeviceServiceClient
+	mutex   sync.Mutex
+	entries map[string]*serviceAddress
+}
+
+type serviceAddress struct {
+	once    sync.Once
+	address string
+	err     errors.EdgeX
+}
+
+func (s *serviceAddresses) baseAddress(ctx context.Context, serviceName string) (string, errors.EdgeX) {
+	s.mutex.Lock()
+	entry, ok := s.entries[serviceName]
+	if !ok {
+		entry = &serviceAddress{}
+		s.entries[serviceName] = entry
+	}
+	s.mutex.Unlock()
+
+	entry.once.Do(func() {
+		ds, err := s.cache.DeviceServiceByName(ctx, s.dsc, serviceName)
+		if err != nil {
+			entry.err = errors.NewCommonEdgeXWrapper(err)
+			return
+		}
+		entry.address = ds.BaseAddress
+	})
+	return entry.address, entry.err
+}
+
+// IssueBatchCommand issues the command to each device selected by the request, at most MaxParallelism commands are
+// issued at the same time and the whole batch is bounded by the configured Timeout. The error of a device is reported
+// in its result, an error is returned only if the devices can't be selected. The command access policies are checked
+// for each device, so that a denied device is reported in its own result.
+func IssueBatchCommand(ctx context.Context, req requests.BatchCommandRequest, queryParams string, dic *di.Container) (results []pkgDtos.BatchCommandResult, edgeXerr errors.EdgeX) {
+	dc := bootstrapContainer.MetadataDeviceClientFrom(dic.Get)
+	if dc == nil {
+		return results, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
+	}
+	dsc := bootstrapContainer.MetadataDeviceServiceClientFrom(dic.Get)
+	if dsc == nil {
+		return results, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceServiceClient returned", nil)
+	}
+	dscc := bootstrapContainer.DeviceServiceCommandClientFrom(dic.Get)
+	if dscc == nil {
+		return results, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
+	}
+
+	batchConfig := commandContainer.ConfigurationFrom(dic.Get).Writable.BatchCommand
+	timeout, err := time.ParseDuration(batchConfig.Timeout)
+	if err != nil || timeout <= 0 {
+		return results, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid batch command timeout '%s'", batchConfig.Timeout), err)
+	}
+	parallelism := batchConfig.MaxParallelism
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	targets, edgeXerr := batchTargets(ctx, req, batchConfig.MaxDevices, dc)
+	if edgeXerr != nil {
+		return results, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	addresses := &serviceAddresses{cache: metadataCache, dsc: dsc, entries: make(map[string]*serviceAddress)}
+	results = make([]pkgDtos.BatchCommandResult, len(targets))
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			results[i] = batchErrorResult(target.deviceName,
+				errors.NewCommonEdgeX(errors.KindCommunicationError, "the batch timed out before the command was issued", ctx.Err()))
+			continue
+		}
+		wg.Add(1)
+		go func(i int, target batchTarget) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			started := time.Now()
+			results[i] = issueBatchTargetCommand(ctx, target, req, queryParams, dic, metadataCache, dc, addresses, dscc)
+			history.Record(ctx, dic, models.CommandRecord{DeviceName: target.deviceName, CommandName: req.Command, Method: req.Method,
+				QueryParams: queryParams, Settings: req.Settings, StatusCode: results[i].StatusCode, Message: results[i].Message}, started)
+		}(i, target)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// batchTargets lists the devices selected by the request, the devices selected by name are looked up when the command
+// is issued so that an unknown device is reported in its own result. The number of devices is unlimited if maxDevices
+// is not positive.
+func batchTargets(ctx context.Context, req requests.BatchCommandRequest, maxDevices int, dc interfaces.DeviceClient) ([]batchTarget, errors.EdgeX) {
+	var targets []batchTarget
+	if len(req.DeviceNames) > 0 {
+		targets = make([]batchTarget, len(req.DeviceNames))
+		for i, name := range req.DeviceNames {
+			targets[i] = batchTarget{deviceName: name}
+		}
+	} else {
+		// one more device than allowed is queried to tell whether the selection is too large
+		limit := -1
+		if maxDevices > 0 {
+			limit = maxDevices + 1
+		}
+		var res responses.MultiDevicesResponse
+		var err errors.EdgeX
+		if len(req.Labels) > 0 {
+			res, err = dc.AllDevices(ctx, req.Labels, 0, limit)
+		} else {
+			res, err = dc.DevicesByProfileName(ctx, req.ProfileName, 0, limit)
+		}
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, d := range res.Devices {
+			targets = append(targets, batchTarget{deviceName: d.Name, device: d, resolved: true})
+		}
+	}
+
+	if maxDevices > 0 && len(targets) > maxDevices {
+		return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the batch selects more than %d devices", maxDevices), nil)
+	}
+	return targets, nil
+}
+
+func issueBatchTargetCommand(ctx context.Context, target batchTarget, req requests.BatchCommandRequest, queryParams string, dic *di.Container,
+	metadataCache *cache.MetadataCache, dc interfaces.DeviceClient, addresses *serviceAddresses, dscc interfaces.DeviceServiceCommandClient) pkgDtos.BatchCommandResult {
+	if !target.resolved {
+		device, err := metadataCache.DeviceByName(ctx, dc, target.deviceName)
+		if err != nil {
+			return batchErrorResult(target.deviceName, err)
+		}
+		target.device = device
+	}
+	operation := access.OperationGet
+	if req.Method == http.MethodPut {
+		operation = access.OperationSet
+	}
+	if err := access.Authorize(ctx, dic, operation, target.device, req.Command); err != nil {
+		return batchErrorResult(target.deviceName, err)
+	}
+	if req.Method == http.MethodPut {
+		if err := validateDeviceSettings(ctx, target.device, req.Command, req.Settings, dic); err != nil {
+			return batchErrorResult(target.deviceName, err)
+		}
+	}
+	baseAddress, err := addresses.baseAddress(ctx, target.device.ServiceName)
+	if err != nil {
+		return batchErrorResult(target.deviceName, err)
+	}
+
+	if req.Method == http.MethodPut {
+		res, err := commandContainer.ResponseCacheFrom(dic.Get).SetCommand(ctx, dscc, baseAddress, target.deviceName, req.Command, queryParams, req.Settings)
+		if err != nil {
+			return batchErrorResult(target.deviceName, err)
+		}
+		return pkgDtos.BatchCommandResult{DeviceName: target.deviceName, StatusCode: res.StatusCode, Message: res.Message}
+	}
+
+	res, err := dscc.GetCommand(ctx, baseAddress, target.deviceName, req.Command, queryParams)
+	if err != nil {
+		return batchErrorResult(target.deviceName, err)
+	}
+	result := pkgDtos.BatchCommandResult{DeviceName: target.deviceName, StatusCode: http.StatusOK}
+	// the device service returns no event if ds-returnevent is no
+	if res != nil {
+		result.StatusCode = res.StatusCode
+		result.Message = res.Message
+		event := res.Event
+		result.Event = &event
+	}
+	return result
+}
+
+func batchErrorResult(deviceName string, err errors.EdgeX) pkgDtos.BatchCommandResult {
+	return pkgDtos.BatchCommandResult{DeviceName: deviceName, StatusCode: utils.ErrorCode(err), Message: err.Message()}
+}
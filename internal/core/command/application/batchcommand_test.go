@@ -0,0 +1,141 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = "testService"
+	testBaseAddress = "http://localhost:59900"
+	testProfileName = "testProfile"
+	testCommandName = "testCommand"
+	unknownDevice   = "unknownDevice"
+)
+
+// mockProfileClient returns the profile client of the profile whose command writes an Int32 resource
+func mockProfileClient() *mocks.DeviceProfileClient {
+	profile := dtos.DeviceProfile{
+		Name: testProfileName,
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "resource", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_RW}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: testCommandName, ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "resource"}}},
+		},
+	}
+	dpc := &mocks.DeviceProfileClient{}
+	dpc.On("DeviceProfileByName", mock.Anything, testProfileName).Return(responses.NewDeviceProfileResponse("", "", http.StatusOK, profile), nil)
+	return dpc
+}
+
+func mockBatchDic(maxDevices int, dc *mocks.DeviceClient, dsc *mocks.DeviceServiceClient, dscc *mocks.DeviceServiceCommandClient) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					BatchCommand: config.BatchCommandInfo{MaxDevices: maxDevices, MaxParallelism: 2, Timeout: "5s"},
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dc
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dsc
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return mockProfileClient()
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dscc
+		},
+	})
+}
+
+func TestIssueBatchCommand(t *testing.T) {
+	device1 := dtos.Device{Name: "device1", ServiceName: testServiceName, ProfileName: testProfileName}
+	device2 := dtos.Device{Name: "device2", ServiceName: testServiceName, ProfileName: testProfileName}
+	device3 := dtos.Device{Name: "device3", ServiceName: testServiceName, ProfileName: testProfileName}
+	device4 := dtos.Device{Name: "device4", ServiceName: testServiceName, ProfileName: testProfileName}
+	event := dtos.NewEvent(testProfileName, device1.Name, testCommandName)
+	eventResponse := responses.NewEventResponse("", "", http.StatusOK, event)
+	settings := map[string]string{"resource": "1"}
+
+	dc := &mocks.DeviceClient{}
+	dc.On("DeviceByName", mock.Anything, device1.Name).Return(responses.NewDeviceResponse("", "", http.StatusOK, device1), nil)
+	dc.On("DeviceByName", mock.Anything, device2.Name).Return(responses.NewDeviceResponse("", "", http.StatusOK, device2), nil)
+	dc.On("DeviceByName", mock.Anything, unknownDevice).Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	dc.On("AllDevices", mock.Anything, []string{"floor-1"}, 0, 4).Return(responses.MultiDevicesResponse{Devices: []dtos.Device{device1, device2}}, nil)
+	dc.On("DevicesByProfileName", mock.Anything, testProfileName, 0, 4).Return(responses.MultiDevicesResponse{Devices: []dtos.Device{device1, device2, device3, device4}}, nil)
+	dsc := &mocks.DeviceServiceClient{}
+	dsc.On("DeviceServiceByName", mock.Anything, testServiceName).
+		Return(responses.NewDeviceServiceResponse("", "", http.StatusOK, dtos.DeviceService{Name: testServiceName, BaseAddress: testBaseAddress}), nil)
+	dscc := &mocks.DeviceServiceCommandClient{}
+	dscc.On("GetCommand", mock.Anything, testBaseAddress, device1.Name, testCommandName, "").Return(&eventResponse, nil)
+	dscc.On("GetCommand", mock.Anything, testBaseAddress, device2.Name, testCommandName, "").
+		Return((*responses.EventResponse)(nil), errors.NewCommonEdgeX(errors.KindServiceLocked, "device locked", nil))
+	dscc.On("SetCommand", mock.Anything, testBaseAddress, device1.Name, testCommandName, "", settings).Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dscc.On("SetCommand", mock.Anything, testBaseAddress, device2.Name, testCommandName, "", settings).Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dic := mockBatchDic(3, dc, dsc, dscc)
+
+	t.Run("device names", func(t *testing.T) {
+		req := requests.BatchCommandRequest{DeviceNames: []string{device1.Name, device2.Name, unknownDevice}, Command: testCommandName, Method: http.MethodGet}
+		results, err := IssueBatchCommand(context.Background(), req, "", dic)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, device1.Name, results[0].DeviceName)
+		assert.Equal(t, http.StatusOK, results[0].StatusCode)
+		require.NotNil(t, results[0].Event)
+		assert.Equal(t, event.Id, results[0].Event.Id)
+		assert.Equal(t, device2.Name, results[1].DeviceName)
+		assert.Equal(t, http.StatusLocked, results[1].StatusCode)
+		assert.NotEmpty(t, results[1].Message)
+		assert.Nil(t, results[1].Event)
+		assert.Equal(t, unknownDevice, results[2].DeviceName)
+		assert.Equal(t, http.StatusNotFound, results[2].StatusCode)
+		dsc.AssertNumberOfCalls(t, "DeviceServiceByName", 1)
+	})
+	t.Run("labels", func(t *testing.T) {
+		req := requests.BatchCommandRequest{Labels: []string{"floor-1"}, Command: testCommandName, Method: http.MethodPut, Settings: settings}
+		results, err := IssueBatchCommand(context.Background(), req, "", dic)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, r := range results {
+			assert.Equal(t, http.StatusOK, r.StatusCode)
+		}
+	})
+	t.Run("too many devices", func(t *testing.T) {
+		req := requests.BatchCommandRequest{ProfileName: testProfileName, Command: testCommandName, Method: http.MethodGet}
+		_, err := IssueBatchCommand(context.Background(), req, "", dic)
+		require.Error(t, err)
+		assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	})
+}
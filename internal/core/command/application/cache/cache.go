@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Stats are the counters of the metadata cache since the service started
+type Stats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// HitRate is the ratio of the lookups answered from the cache, zero if nothing has been looked up
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type entry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// MetadataCache keeps the devices, device services and device profiles queried from core-metadata for the TTL, so
+// that issuing commands doesn't take a round-trip to core-metadata each time. The entities are invalidated when
+// core-metadata notifies their change, the TTL bounds how stale they can be if a notification is missed. The errors
+// are never cached. A nil MetadataCache queries core-metadata each time.
+type MetadataCache struct {
+	ttl     time.Duration
+	mutex   sync.Mutex
+	entries map[pkgModels.AuditEntityType]map[string]entry
+	// generation is increased by each invalidation, so that an entity loaded before is not cached afterwards
+	generation uint64
+	hits       uint64
+	misses     uint64
+}
+
+// NewMetadataCache creates the cache keeping the entities for the ttl
+func NewMetadataCache(ttl time.Duration) *MetadataCache {
+	return &MetadataCache{
+		ttl: ttl,
+		entries: map[pkgModels.AuditEntityType]map[string]entry{
+			pkgModels.AuditDevice:        {},
+			pkgModels.AuditDeviceService: {},
+			pkgModels.AuditDeviceProfile: {},
+		},
+	}
+}
+
+// DeviceByName returns the device from the cache, or queries it through the DeviceClient if it's not cached
+func (c *MetadataCache) DeviceByName(ctx context.Context, dc interfaces.DeviceClient, name string) (dtos.Device, errors.EdgeX) {
+	value, err := c.get(pkgModels.AuditDevice, name, func() (interface{}, errors.EdgeX) {
+		res, err := dc.DeviceByName(ctx, name)
+		return res.Device, err
+	})
+	if err != nil {
+		return dtos.Device{}, err
+	}
+	return value.(dtos.Device), nil
+}
+
+// DeviceServiceByName returns the device service from the cache, or queries it through the DeviceServiceClient if
+// it's not cached
+func (c *MetadataCache) DeviceServiceByName(ctx context.Context, dsc interfaces.DeviceServiceClient, name string) (dtos.DeviceService, errors.EdgeX) {
+	value, err := c.get(pkgModels.AuditDeviceService, name, func() (interface{}, errors.EdgeX) {
+		res, err := dsc.DeviceServiceByName(ctx, name)
+		return res.Service, err
+	})
+	if err != nil {
+		return dtos.DeviceService{}, err
+	}
+	return value.(dtos.DeviceService), nil
+}
+
+// DeviceProfileByName returns the device profile from the cache, or queries it through the DeviceProfileClient if
+// it's not cached
+func (c *MetadataCache) DeviceProfileByName(ctx context.Context, dpc interfaces.DeviceProfileClient, name string) (dtos.DeviceProfile, errors.EdgeX) {
+	value, err := c.get(pkgModels.AuditDeviceProfile, name, func() (interface{}, errors.EdgeX) {
+		res, err := dpc.DeviceProfileByName(ctx, name)
+		return res.Profile, err
+	})
+	if err != nil {
+		return dtos.DeviceProfile{}, err
+	}
+	return value.(dtos.DeviceProfile), nil
+}
+
+// Invalidate removes the entity from the cache, all the entities of the type are removed if the name is empty
+func (c *MetadataCache) Invalidate(entityType pkgModels.AuditEntityType, name string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.entries[entityType]; !ok {
+		return
+	}
+	c.generation++
+	if name == "" {
+		c.entries[entityType] = make(map[string]entry)
+		return
+	}
+	delete(c.entries[entityType], name)
+}
+
+// Stats returns the counters of the cache
+func (c *MetadataCache) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entries := 0
+	for _, e := range c.entries {
+		entries += len(e)
+	}
+	return Stats{Hits: c.hits, Misses: c.misses, Entries: entries}
+}
+
+func (c *MetadataCache) get(entityType pkgModels.AuditEntityType, name string, load func() (interface{}, errors.EdgeX)) (interface{}, errors.EdgeX) {
+	if c == nil {
+		value, err := load()
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		return value, nil
+	}
+
+	now := time.Now()
+	c.mutex.Lock()
+	e, ok := c.entries[entityType][name]
+	generation := c.generation
+	if ok && now.Before(e.expires) {
+		c.hits++
+		c.mutex.Unlock()
+		return e.value, nil
+	}
+	c.misses++
+	c.mutex.Unlock()
+
+	value, err := load()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if c.ttl > 0 {
+		c.mutex.Lock()
+		if generation == c.generation {
+			c.entries[entityType][name] = entry{value: value, expires: now.Add(c.ttl)}
+		}
+		c.mutex.Unlock()
+	}
+	return value, nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeviceName  = "testDevice"
+	testServiceName = "testService"
+	unknownDevice   = "unknownDevice"
+)
+
+func mockDeviceClient() *mocks.DeviceClient {
+	dc := &mocks.DeviceClient{}
+	dc.On("DeviceByName", context.Background(), testDeviceName).
+		Return(responses.NewDeviceResponse("", "", http.StatusOK, dtos.Device{Name: testDeviceName, ServiceName: testServiceName}), nil)
+	dc.On("DeviceByName", context.Background(), unknownDevice).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	return dc
+}
+
+func TestDeviceByName(t *testing.T) {
+	dc := mockDeviceClient()
+	c := NewMetadataCache(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		device, err := c.DeviceByName(context.Background(), dc, testDeviceName)
+		require.NoError(t, err)
+		assert.Equal(t, testServiceName, device.ServiceName)
+	}
+	dc.AssertNumberOfCalls(t, "DeviceByName", 1)
+
+	// the errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := c.DeviceByName(context.Background(), dc, unknownDevice)
+		require.Error(t, err)
+		assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	}
+	dc.AssertNumberOfCalls(t, "DeviceByName", 3)
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(3), stats.Misses)
+	assert.Equal(t, 1, stats.Entries)
+	assert.InDelta(t, 0.4, stats.HitRate(), 0.001)
+}
+
+func TestInvalidate(t *testing.T) {
+	dc := mockDeviceClient()
+	c := NewMetadataCache(time.Minute)
+
+	_, err := c.DeviceByName(context.Background(), dc, testDeviceName)
+	require.NoError(t, err)
+	c.Invalidate(pkgModels.AuditDevice, testDeviceName)
+	assert.Equal(t, 0, c.Stats().Entries)
+	_, err = c.DeviceByName(context.Background(), dc, testDeviceName)
+	require.NoError(t, err)
+	dc.AssertNumberOfCalls(t, "DeviceByName", 2)
+
+	// an empty name invalidates all the entities of the type
+	c.Invalidate(pkgModels.AuditDevice, "")
+	assert.Equal(t, 0, c.Stats().Entries)
+}
+
+func TestExpiration(t *testing.T) {
+	dc := mockDeviceClient()
+	c := NewMetadataCache(time.Millisecond)
+
+	_, err := c.DeviceByName(context.Background(), dc, testDeviceName)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = c.DeviceByName(context.Background(), dc, testDeviceName)
+	require.NoError(t, err)
+	dc.AssertNumberOfCalls(t, "DeviceByName", 2)
+	assert.Equal(t, uint64(0), c.Stats().Hits)
+}
+
+func TestNilCache(t *testing.T) {
+	dc := mockDeviceClient()
+	var c *MetadataCache
+
+	for i := 0; i < 2; i++ {
+		device, err := c.DeviceByName(context.Background(), dc, testDeviceName)
+		require.NoError(t, err)
+		assert.Equal(t, testDeviceName, device.Name)
+	}
+	dc.AssertNumberOfCalls(t, "DeviceByName", 2)
+	c.Invalidate(pkgModels.AuditDevice, testDeviceName)
+	assert.Equal(t, Stats{}, c.Stats())
+}
@@ -0,0 +1,233 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	// MaxAgeAttribute is the attribute of a device resource which declares how long the readings of the resource can
+	// be served from the response cache, e.g. 10s
+	MaxAgeAttribute = "cacheMaxAge"
+	// CacheControlHeader carries the directives of a GET command request, i.e. max-age and no-cache
+	CacheControlHeader = "Cache-Control"
+	// AgeHeader carries the age in seconds of a response served from the cache
+	AgeHeader = "Age"
+)
+
+type responseEntry struct {
+	key        string
+	deviceName string
+	response   responses.EventResponse
+	read       time.Time
+}
+
+// ResponseCache keeps the latest responses of the GET commands by device, command and query parameters, so that the
+// devices polled by many clients are only read once per max age. The least recently used response is evicted once the
+// cache holds maxEntries responses. A nil ResponseCache caches nothing.
+type ResponseCache struct {
+	maxEntries    int
+	defaultMaxAge time.Duration
+	mutex         sync.Mutex
+	lru           *list.List
+	entries       map[string]*list.Element
+}
+
+// NewResponseCache creates the cache holding up to maxEntries responses, the responses of the commands whose resources
+// declare no max age are kept for defaultMaxAge
+func NewResponseCache(maxEntries int, defaultMaxAge time.Duration) *ResponseCache {
+	return &ResponseCache{
+		maxEntries:    maxEntries,
+		defaultMaxAge: defaultMaxAge,
+		lru:           list.New(),
+		entries:       make(map[string]*list.Element),
+	}
+}
+
+// ResponseKey returns the key of the response of the GET command
+func ResponseKey(deviceName string, commandName string, queryParams string) string {
+	return deviceName + "/" + commandName + "?" + queryParams
+}
+
+// MaxAge returns how long the response of the command can be served from the cache. The command is either a device
+// resource or a device command of the profile, the max age of a device command is the shortest max age of its
+// resources. A resource without the MaxAgeAttribute falls back to the default max age.
+func (c *ResponseCache) MaxAge(profile dtos.DeviceProfile, commandName string) (time.Duration, error) {
+	if c == nil {
+		return 0, nil
+	}
+	resources := make(map[string]dtos.DeviceResource, len(profile.DeviceResources))
+	for _, r := range profile.DeviceResources {
+		resources[r.Name] = r
+	}
+	if r, ok := resources[commandName]; ok {
+		return c.resourceMaxAge(r)
+	}
+	for _, command := range profile.DeviceCommands {
+		if command.Name != commandName {
+			continue
+		}
+		maxAge := time.Duration(-1)
+		for _, op := range command.ResourceOperations {
+			age, err := c.resourceMaxAge(resources[op.DeviceResource])
+			if err != nil {
+				return 0, err
+			}
+			if maxAge < 0 || age < maxAge {
+				maxAge = age
+			}
+		}
+		if maxAge < 0 {
+			return 0, nil
+		}
+		return maxAge, nil
+	}
+	return 0, nil
+}
+
+func (c *ResponseCache) resourceMaxAge(r dtos.DeviceResource) (time.Duration, error) {
+	value, ok := r.Attributes[MaxAgeAttribute]
+	if !ok {
+		return c.defaultMaxAge, nil
+	}
+	maxAge, err := time.ParseDuration(fmt.Sprintf("%v", value))
+	if err != nil || maxAge < 0 {
+		return 0, fmt.Errorf("invalid %s '%v' of resource %s", MaxAgeAttribute, value, r.Name)
+	}
+	return maxAge, nil
+}
+
+// Get returns the cached response and its age if the response was read within maxAge
+func (c *ResponseCache) Get(key string, maxAge time.Duration) (*responses.EventResponse, time.Duration, bool) {
+	if c == nil || maxAge <= 0 {
+		return nil, 0, false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+	e := element.Value.(*responseEntry)
+	age := time.Since(e.read)
+	if age > maxAge {
+		return nil, 0, false
+	}
+	c.lru.MoveToFront(element)
+	res := e.response
+	return &res, age, true
+}
+
+// Put caches the response read at the time, evicting the least recently used response if the cache is full
+func (c *ResponseCache) Put(key string, deviceName string, res responses.EventResponse, read time.Time) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[key]; ok {
+		e := element.Value.(*responseEntry)
+		e.response = res
+		e.read = read
+		c.lru.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&responseEntry{key: key, deviceName: deviceName, response: res, read: read})
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*responseEntry).key)
+	}
+}
+
+// InvalidateDevice removes the responses of the device, e.g. once a SET command has changed its values
+func (c *ResponseCache) InvalidateDevice(deviceName string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for element := c.lru.Front(); element != nil; {
+		next := element.Next()
+		if e := element.Value.(*responseEntry); e.deviceName == deviceName {
+			c.lru.Remove(element)
+			delete(c.entries, e.key)
+		}
+		element = next
+	}
+}
+
+// SetCommand issues the SET command to the device through the device service, and removes the responses of the device
+// once the command succeeds, so that no GET command is served the values the SET command has overwritten. Every SET
+// command shall be issued through it.
+func (c *ResponseCache) SetCommand(ctx context.Context, dscc interfaces.DeviceServiceCommandClient, baseAddress string, deviceName string, commandName string, queryParams string, settings map[string]string) (common.BaseResponse, errors.EdgeX) {
+	res, err := dscc.SetCommand(ctx, baseAddress, deviceName, commandName, queryParams, settings)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	c.InvalidateDevice(deviceName)
+	return res, nil
+}
+
+// Control holds the Cache-Control directives of a GET command request. The cache reports through it whether the
+// response was served from the cache and how old the response is.
+type Control struct {
+	// MaxAge overrides the max age of the command's resources if MaxAgeSet is true
+	MaxAge    time.Duration
+	MaxAgeSet bool
+	// NoCache reads the device even if a fresh response is cached, the response read is cached still
+	NoCache bool
+	Hit     bool
+	Age     time.Duration
+}
+
+type controlKey struct{}
+
+// ParseControl parses the directives of the Cache-Control header, the unknown directives are ignored as required by
+// RFC 7234
+func ParseControl(header string) *Control {
+	control := &Control{}
+	for _, directive := range strings.Split(header, ",") {
+		name, value := strings.TrimSpace(directive), ""
+		if i := strings.Index(name, "="); i >= 0 {
+			name, value = strings.TrimSpace(name[:i]), strings.Trim(strings.TrimSpace(name[i+1:]), `"`)
+		}
+		switch strings.ToLower(name) {
+		case "no-cache":
+			control.NoCache = true
+		case "max-age":
+			if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+				control.MaxAge = time.Duration(seconds) * time.Second
+				control.MaxAgeSet = true
+			}
+		}
+	}
+	return control
+}
+
+// WithControl returns a copy of the context carrying the Cache-Control directives of the request
+func WithControl(ctx context.Context, control *Control) context.Context {
+	return context.WithValue(ctx, controlKey{}, control)
+}
+
+// ControlFromContext returns the Cache-Control directives carried by the context, nil if there are none
+func ControlFromContext(ctx context.Context) *Control {
+	control, _ := ctx.Value(controlKey{}).(*Control)
+	return control
+}
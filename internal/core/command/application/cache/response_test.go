@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildMaxAgeProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "temperature", Attributes: map[string]interface{}{MaxAgeAttribute: "10s"}},
+			{Name: "humidity", Attributes: map[string]interface{}{MaxAgeAttribute: "1m"}},
+			{Name: "switch"},
+			{Name: "invalid", Attributes: map[string]interface{}{MaxAgeAttribute: "soon"}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "climate", ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "humidity"}, {DeviceResource: "temperature"}}},
+			{Name: "all", ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "humidity"}, {DeviceResource: "switch"}}},
+		},
+	}
+}
+
+func TestMaxAge(t *testing.T) {
+	profile := buildMaxAgeProfile()
+	tests := []struct {
+		name           string
+		defaultMaxAge  time.Duration
+		commandName    string
+		expectedMaxAge time.Duration
+		errorExpected  bool
+	}{
+		{"resource", 0, "temperature", 10 * time.Second, false},
+		{"resource without attribute", 0, "switch", 0, false},
+		{"resource with default", 5 * time.Second, "switch", 5 * time.Second, false},
+		{"command of the shortest resource", 0, "climate", 10 * time.Second, false},
+		{"command with a resource without attribute", 0, "all", 0, false},
+		{"command with default", 5 * time.Second, "all", 5 * time.Second, false},
+		{"unknown command", 5 * time.Second, "unknown", 0, false},
+		{"invalid attribute", 0, "invalid", 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			maxAge, err := NewResponseCache(10, testCase.defaultMaxAge).MaxAge(profile, testCase.commandName)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedMaxAge, maxAge)
+		})
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	c := NewResponseCache(2, 0)
+	res := responses.NewEventResponse("", "", http.StatusOK, dtos.Event{Id: "event"})
+	now := time.Now()
+	key := ResponseKey(testDeviceName, "temperature", "")
+	c.Put(key, testDeviceName, res, now.Add(-5*time.Second))
+
+	cached, age, ok := c.Get(key, 10*time.Second)
+	require.True(t, ok)
+	assert.Equal(t, "event", cached.Event.Id)
+	assert.GreaterOrEqual(t, age, 5*time.Second)
+	_, _, ok = c.Get(key, 2*time.Second)
+	assert.False(t, ok, "the response older than max age should not be served")
+	_, _, ok = c.Get(key, 0)
+	assert.False(t, ok, "nothing should be served without max age")
+
+	// the least recently used response is evicted
+	other := ResponseKey("other", "temperature", "")
+	c.Put(other, "other", res, now)
+	_, _, ok = c.Get(key, time.Minute)
+	require.True(t, ok)
+	c.Put(ResponseKey("third", "temperature", ""), "third", res, now)
+	_, _, ok = c.Get(other, time.Minute)
+	assert.False(t, ok, "the least recently used response should be evicted")
+	_, _, ok = c.Get(key, time.Minute)
+	assert.True(t, ok)
+
+	c.InvalidateDevice(testDeviceName)
+	_, _, ok = c.Get(key, time.Minute)
+	assert.False(t, ok, "the responses of the device should be invalidated")
+
+	var nilCache *ResponseCache
+	nilCache.Put(key, testDeviceName, res, now)
+	_, _, ok = nilCache.Get(key, time.Minute)
+	assert.False(t, ok)
+}
+
+func TestResponseCacheSetCommand(t *testing.T) {
+	c := NewResponseCache(10, 0)
+	res := responses.NewEventResponse("", "", http.StatusOK, dtos.Event{Id: "event"})
+	key := ResponseKey(testDeviceName, "temperature", "")
+	dscc := &mocks.DeviceServiceCommandClient{}
+	dscc.On("SetCommand", mock.Anything, mock.Anything, testDeviceName, "setpoint", "", mock.Anything).
+		Return(common.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device service unavailable", nil)).Once()
+	dscc.On("SetCommand", mock.Anything, mock.Anything, testDeviceName, "setpoint", "", mock.Anything).
+		Return(common.NewBaseResponse("", "", http.StatusOK), nil)
+
+	// the responses are kept if the SET command fails
+	c.Put(key, testDeviceName, res, time.Now())
+	_, err := c.SetCommand(context.Background(), dscc, "http://device-service", testDeviceName, "setpoint", "", nil)
+	require.Error(t, err)
+	_, _, ok := c.Get(key, time.Minute)
+	assert.True(t, ok)
+
+	_, err = c.SetCommand(context.Background(), dscc, "http://device-service", testDeviceName, "setpoint", "", nil)
+	require.NoError(t, err)
+	_, _, ok = c.Get(key, time.Minute)
+	assert.False(t, ok, "the responses of the device should be invalidated by the SET command")
+
+	var nilCache *ResponseCache
+	_, err = nilCache.SetCommand(context.Background(), dscc, "http://device-service", testDeviceName, "setpoint", "", nil)
+	require.NoError(t, err)
+}
+
+func TestParseControl(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected Control
+	}{
+		{"empty", "", Control{}},
+		{"max-age", "max-age=30", Control{MaxAge: 30 * time.Second, MaxAgeSet: true}},
+		{"zero max-age", "max-age=0", Control{MaxAgeSet: true}},
+		{"quoted max-age", `max-age="5"`, Control{MaxAge: 5 * time.Second, MaxAgeSet: true}},
+		{"no-cache", "No-Cache", Control{NoCache: true}},
+		{"multiple directives", "no-cache, max-age=10, no-store", Control{MaxAge: 10 * time.Second, MaxAgeSet: true, NoCache: true}},
+		{"invalid max-age", "max-age=-1", Control{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, *ParseControl(testCase.header))
+		})
+	}
+
+	control := ParseControl("max-age=1")
+	assert.Same(t, control, ControlFromContext(WithControl(context.Background(), control)))
+	assert.Nil(t, ControlFromContext(context.Background()))
+}
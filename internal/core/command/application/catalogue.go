@@ -0,0 +1,289 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/openapi"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	baseResponseSchema  = "BaseResponse"
+	eventResponseSchema = "EventResponse"
+	jsonContent         = "application/json"
+)
+
+// CatalogueByDeviceName generates the OpenAPI document of the commands of the device, so that the clients of the
+// device can be generated from it
+func CatalogueByDeviceName(name string, dic *di.Container) (document openapi.Document, err errors.EdgeX) {
+	if name == "" {
+		return document, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+	}
+
+	// retrieve device information through Metadata DeviceClient
+	dc := bootstrapContainer.MetadataDeviceClientFrom(dic.Get)
+	if dc == nil {
+		return document, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
+	}
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	device, err := metadataCache.DeviceByName(context.Background(), dc, name)
+	if err != nil {
+		return document, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// retrieve device profile information through Metadata DeviceProfileClient
+	dpc := bootstrapContainer.MetadataDeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return document, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceProfileClient returned", nil)
+	}
+	profile, err := metadataCache.DeviceProfileByName(context.Background(), dpc, device.ProfileName)
+	if err != nil {
+		return document, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	configuration := commandContainer.ConfigurationFrom(dic.Get)
+	document, err = buildCatalogue(device, profile, configuration.Service.Url())
+	if err != nil {
+		return document, errors.NewCommonEdgeXWrapper(err)
+	}
+	return document, nil
+}
+
+// buildCatalogue describes the same commands as buildCoreCommands. Each command has a schema of its resources in the
+// components, which is the body of the set command and describes the readings of the get command. The schema is keyed
+// by schemaKey, so that no command replaces the shared response schemas or the schema of another command.
+func buildCatalogue(device dtos.Device, profile dtos.DeviceProfile, serviceUrl string) (openapi.Document, errors.EdgeX) {
+	document := openapi.Document{
+		OpenAPI: openapi.Version,
+		Info: openapi.Info{
+			Title:       fmt.Sprintf("Commands of device %s", device.Name),
+			Description: device.Description,
+			Version:     common.ApiVersion,
+		},
+		Servers: []openapi.Server{{Url: serviceUrl}},
+		Paths:   make(map[string]openapi.PathItem),
+		Components: openapi.Components{
+			Schemas: map[string]*openapi.Schema{
+				baseResponseSchema:  baseResponseSchemaOf(),
+				eventResponseSchema: eventResponseSchemaOf(),
+			},
+		},
+	}
+
+	addCommand := func(name string, readWrite string, operations []dtos.ResourceOperation) errors.EdgeX {
+		schema, err := commandSchema(operations, profile.DeviceResources)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		key := schemaKey(profile.Name, name, document.Components.Schemas)
+		document.Components.Schemas[key] = schema
+		var item openapi.PathItem
+		if strings.Contains(readWrite, common.ReadWrite_R) {
+			item.Get = getOperation(name, key)
+		}
+		if strings.Contains(readWrite, common.ReadWrite_W) {
+			item.Put = setOperation(name, key)
+		}
+		document.Paths[commandPath(device.Name, name)] = item
+		return nil
+	}
+	for _, c := range profile.DeviceCommands {
+		if c.IsHidden {
+			continue
+		}
+		if err := addCommand(c.Name, c.ReadWrite, c.ResourceOperations); err != nil {
+			return document, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	for _, r := range profile.DeviceResources {
+		if _, ok := document.Paths[commandPath(device.Name, r.Name)]; ok || r.IsHidden {
+			continue
+		}
+		if err := addCommand(r.Name, r.Properties.ReadWrite, []dtos.ResourceOperation{{DeviceResource: r.Name}}); err != nil {
+			return document, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return document, nil
+}
+
+// schemaKey returns the key of the schema of the command in the components, i.e. <profile>_<command> with the
+// characters not allowed in a component key replaced by underscores. A number is appended if the key is taken already,
+// e.g. by a command whose name only differs in such characters.
+func schemaKey(profileName string, commandName string, schemas map[string]*openapi.Schema) string {
+	key := strings.Map(func(r rune) rune {
+		if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, profileName+"_"+commandName)
+	unique := key
+	for i := 2; schemas[unique] != nil; i++ {
+		unique = fmt.Sprintf("%s_%d", key, i)
+	}
+	return unique
+}
+
+// commandSchema returns the object schema of the resources of the command, the resources without a default value are
+// required by the set command
+func commandSchema(operations []dtos.ResourceOperation, resources []dtos.DeviceResource) (*openapi.Schema, errors.EdgeX) {
+	schema := &openapi.Schema{Type: "object", Properties: make(map[string]*openapi.Schema, len(operations))}
+	for _, ro := range operations {
+		r, exists := deviceResourcesByName(resources, ro.DeviceResource)
+		if !exists {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device command's resource %s doesn't match any device resource", ro.DeviceResource), nil)
+		}
+		property := resourceSchema(r, ro)
+		schema.Properties[r.Name] = property
+		if property.Default == "" && strings.Contains(r.Properties.ReadWrite, common.ReadWrite_W) {
+			schema.Required = append(schema.Required, r.Name)
+		}
+	}
+	return schema, nil
+}
+
+// resourceSchema returns the schema of the value of the resource. The values are exchanged as strings, so the type of
+// the schema is always string and the value type is given by the format, the numeric keywords apply to the parsed
+// value. The mapped values of the resource operation are enumerated as they are the ones read.
+func resourceSchema(r dtos.DeviceResource, ro dtos.ResourceOperation) *openapi.Schema {
+	valueType := r.Properties.ValueType
+	schema := &openapi.Schema{
+		Type:        "string",
+		Format:      strings.ToLower(valueType),
+		Description: r.Description,
+		Default:     r.Properties.DefaultValue,
+		ReadOnly:    r.Properties.ReadWrite == common.ReadWrite_R,
+		WriteOnly:   r.Properties.ReadWrite == common.ReadWrite_W,
+		Units:       r.Properties.Units,
+		ValueType:   valueType,
+	}
+	if ro.DefaultValue != "" {
+		schema.Default = ro.DefaultValue
+	}
+	switch valueType {
+	case common.ValueTypeBool:
+		schema.Enum = []string{"true", "false"}
+	case common.ValueTypeBinary:
+		schema.Format = "binary"
+		schema.MediaType = r.Properties.MediaType
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		if minimum, err := strconv.ParseFloat(r.Properties.Minimum, 64); err == nil {
+			schema.Minimum = &minimum
+		}
+		if maximum, err := strconv.ParseFloat(r.Properties.Maximum, 64); err == nil {
+			schema.Maximum = &maximum
+		}
+	}
+	if len(ro.Mappings) > 0 {
+		schema.Enum = make([]string, 0, len(ro.Mappings))
+		for _, mapped := range ro.Mappings {
+			schema.Enum = append(schema.Enum, mapped)
+		}
+		sort.Strings(schema.Enum)
+	}
+	return schema
+}
+
+func getOperation(name string, schemaKey string) *openapi.Operation {
+	yesNo := &openapi.Schema{Type: "string", Enum: []string{common.ValueYes, common.ValueNo}}
+	return &openapi.Operation{
+		OperationId: "get" + name,
+		Summary:     fmt.Sprintf("Read %s from the device", name),
+		Parameters: []openapi.Parameter{
+			{Name: common.PushEvent, In: "query", Description: "Whether the event is pushed to core-data", Schema: yesNo},
+			{Name: common.ReturnEvent, In: "query", Description: "Whether the event is returned", Schema: yesNo},
+		},
+		Responses: map[string]openapi.Response{
+			"200": {
+				Description: fmt.Sprintf("An event with a reading of each resource of schema %s", schemaKey),
+				Content:     map[string]openapi.MediaType{jsonContent: {Schema: openapi.RefSchema(eventResponseSchema)}},
+			},
+			"default": errorResponse(),
+		},
+	}
+}
+
+func setOperation(name string, schemaKey string) *openapi.Operation {
+	return &openapi.Operation{
+		OperationId: "set" + name,
+		Summary:     fmt.Sprintf("Write %s to the device", name),
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content:  map[string]openapi.MediaType{jsonContent: {Schema: openapi.RefSchema(schemaKey)}},
+		},
+		Responses: map[string]openapi.Response{
+			"200": {
+				Description: "OK",
+				Content:     map[string]openapi.MediaType{jsonContent: {Schema: openapi.RefSchema(baseResponseSchema)}},
+			},
+			"default": errorResponse(),
+		},
+	}
+}
+
+func errorResponse() openapi.Response {
+	return openapi.Response{
+		Description: "The command failed, the statusCode and message of the response tell why",
+		Content:     map[string]openapi.MediaType{jsonContent: {Schema: openapi.RefSchema(baseResponseSchema)}},
+	}
+}
+
+func baseResponseSchemaOf() *openapi.Schema {
+	return &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"apiVersion": {Type: "string"},
+			"requestId":  {Type: "string"},
+			"statusCode": {Type: "integer"},
+			"message":    {Type: "string"},
+		},
+	}
+}
+
+func eventResponseSchemaOf() *openapi.Schema {
+	str := &openapi.Schema{Type: "string"}
+	reading := &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"id":           str,
+			"origin":       {Type: "integer", Format: "int64"},
+			"deviceName":   str,
+			"resourceName": str,
+			"profileName":  str,
+			"valueType":    str,
+			"units":        str,
+			"value":        str,
+			"binaryValue":  {Type: "string", Format: "byte"},
+			"mediaType":    str,
+		},
+	}
+	schema := baseResponseSchemaOf()
+	schema.Properties["event"] = &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"id":          str,
+			"deviceName":  str,
+			"profileName": str,
+			"sourceName":  str,
+			"origin":      {Type: "integer", Format: "int64"},
+			"readings":    {Type: "array", Items: reading},
+		},
+	}
+	return schema
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCatalogue(t *testing.T) {
+	profile := settingsTestProfile()
+	profile.DeviceResources[0].Properties.Units = "degC"
+	profile.DeviceResources = append(profile.DeviceResources,
+		dtos.DeviceResource{Name: "hidden", IsHidden: true, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}})
+	device := dtos.Device{Name: "thermostat", ProfileName: profile.Name}
+
+	document, err := buildCatalogue(device, profile, "http://localhost:59882")
+	require.NoError(t, err)
+
+	// the same commands as buildCoreCommands
+	commands, err := buildCoreCommands(device.Name, "http://localhost:59882", profile)
+	require.NoError(t, err)
+	require.Len(t, document.Paths, len(commands))
+	for _, command := range commands {
+		item, ok := document.Paths[command.Path]
+		require.True(t, ok, "path of command %s not found", command.Name)
+		assert.Equal(t, command.Get, item.Get != nil, "get of command %s", command.Name)
+		assert.Equal(t, command.Set, item.Put != nil, "set of command %s", command.Name)
+	}
+	assert.NotContains(t, document.Paths, commandPath(device.Name, "hidden"))
+
+	climate := document.Components.Schemas[testProfileName+"_climate"]
+	require.NotNil(t, climate)
+	assert.Equal(t, []string{"setpoint", "fan"}, climate.Required)
+	setpoint := climate.Properties["setpoint"]
+	assert.Equal(t, "float32", setpoint.Format)
+	assert.Equal(t, "degC", setpoint.Units)
+	require.NotNil(t, setpoint.Minimum)
+	require.NotNil(t, setpoint.Maximum)
+	assert.Equal(t, 10.0, *setpoint.Minimum)
+	assert.Equal(t, 35.0, *setpoint.Maximum)
+	assert.Equal(t, []string{"high", "low"}, climate.Properties["fan"].Enum)
+	enabled := climate.Properties["enabled"]
+	assert.Equal(t, []string{"true", "false"}, enabled.Enum)
+	assert.Equal(t, "true", enabled.Default)
+	assert.True(t, enabled.WriteOnly)
+	assert.True(t, document.Components.Schemas[testProfileName+"_temperature"].Properties["temperature"].ReadOnly)
+	assert.Empty(t, document.Components.Schemas[testProfileName+"_status"].Required, "read-only resources are never required")
+
+	put := document.Paths[commandPath(device.Name, "climate")].Put
+	require.NotNil(t, put)
+	assert.Equal(t, "#/components/schemas/"+testProfileName+"_climate", put.RequestBody.Content[jsonContent].Schema.Ref)
+
+	// the document is valid JSON with the extensions of the EdgeX values
+	data, jsonErr := json.Marshal(document)
+	require.NoError(t, jsonErr)
+	assert.Contains(t, string(data), `"openapi":"3.0.3"`)
+	assert.Contains(t, string(data), `"x-units":"degC"`)
+}
+
+func TestBuildCatalogueSchemaKeys(t *testing.T) {
+	profile := dtos.DeviceProfile{
+		Name: "hvac profile",
+		DeviceResources: []dtos.DeviceResource{
+			{Name: baseResponseSchema, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}},
+			{Name: "fan/speed", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}},
+			{Name: "fan speed", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}},
+		},
+	}
+	document, err := buildCatalogue(dtos.Device{Name: "thermostat", ProfileName: profile.Name}, profile, "http://localhost:59882")
+	require.NoError(t, err)
+
+	// the shared response schemas are not replaced by a command of the same name
+	assert.Contains(t, document.Components.Schemas[baseResponseSchema].Properties, "statusCode")
+	assert.Contains(t, document.Components.Schemas, "hvac_profile_"+baseResponseSchema)
+	// the commands whose names only differ in the replaced characters get a schema each
+	assert.Contains(t, document.Components.Schemas, "hvac_profile_fan_speed")
+	assert.Contains(t, document.Components.Schemas, "hvac_profile_fan_speed_2")
+	for key := range document.Components.Schemas {
+		assert.Regexp(t, `^[A-Za-z0-9._-]+$`, key)
+	}
+	for path, item := range document.Paths {
+		ref := item.Put.RequestBody.Content[jsonContent].Schema.Ref
+		assert.Contains(t, document.Components.Schemas, strings.TrimPrefix(ref, "#/components/schemas/"), "schema of %s", path)
+	}
+}
+
+func TestBuildCatalogueUnknownResource(t *testing.T) {
+	profile := settingsTestProfile()
+	profile.DeviceCommands = append(profile.DeviceCommands,
+		dtos.DeviceCommand{Name: "broken", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "unknown"}}})
+
+	_, err := buildCatalogue(dtos.Device{Name: "thermostat"}, profile, "http://localhost:59882")
+	require.Error(t, err)
+}
@@ -8,9 +8,16 @@ package application
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/access"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/history"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
@@ -43,13 +50,20 @@ func AllCommands(offset int, limit int, dic *di.Container) (deviceCoreCommands [
 	configuration := commandContainer.ConfigurationFrom(dic.Get)
 	serviceUrl := configuration.Service.Url()
 
+	// the devices usually share a few profiles, each of them is queried once
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	profiles := make(map[string]dtos.DeviceProfile)
 	deviceCoreCommands = make([]dtos.DeviceCoreCommand, len(multiDevicesResponse.Devices))
 	for i, device := range multiDevicesResponse.Devices {
-		deviceProfileResponse, err := dpc.DeviceProfileByName(context.Background(), device.ProfileName)
-		if err != nil {
-			return deviceCoreCommands, errors.NewCommonEdgeXWrapper(err)
+		profile, ok := profiles[device.ProfileName]
+		if !ok {
+			profile, err = metadataCache.DeviceProfileByName(context.Background(), dpc, device.ProfileName)
+			if err != nil {
+				return deviceCoreCommands, errors.NewCommonEdgeXWrapper(err)
+			}
+			profiles[device.ProfileName] = profile
 		}
-		commands, err := buildCoreCommands(device.Name, serviceUrl, deviceProfileResponse.Profile)
+		commands, err := buildCoreCommands(device.Name, serviceUrl, profile)
 		if err != nil {
 			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
@@ -73,7 +87,8 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 	if dc == nil {
 		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
 	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), name)
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	device, err := metadataCache.DeviceByName(context.Background(), dc, name)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -83,7 +98,7 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 	if dpc == nil {
 		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceProfileClient returned", nil)
 	}
-	deviceProfileResponse, err := dpc.DeviceProfileByName(context.Background(), deviceResponse.Device.ProfileName)
+	profile, err := metadataCache.DeviceProfileByName(context.Background(), dpc, device.ProfileName)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -92,14 +107,14 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 	configuration := commandContainer.ConfigurationFrom(dic.Get)
 	serviceUrl := configuration.Service.Url()
 
-	commands, err := buildCoreCommands(deviceResponse.Device.Name, serviceUrl, deviceProfileResponse.Profile)
+	commands, err := buildCoreCommands(device.Name, serviceUrl, profile)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	deviceCoreCommand = dtos.DeviceCoreCommand{
-		DeviceName:   deviceResponse.Device.Name,
-		ProfileName:  deviceResponse.Device.ProfileName,
+		DeviceName:   device.Name,
+		ProfileName:  device.ProfileName,
 		CoreCommands: commands,
 	}
 	return deviceCoreCommand, nil
@@ -183,8 +198,10 @@ func buildCoreCommands(deviceName string, serviceUrl string, profile dtos.Device
 }
 
 // IssueGetCommandByName issues the specified get(read) command referenced by the command name to the device/sensor, also
-// referenced by name.
-func IssueGetCommandByName(deviceName string, commandName string, queryParams string, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
+// referenced by name. The command is issued only if the caller of the request is allowed to by the command access policies.
+// The response is served from the response cache if it was read within the max age of the command, and the Cache-Control
+// directives carried by the context report whether it was. The command and its outcome are kept in the command history.
+func IssueGetCommandByName(ctx context.Context, deviceName string, commandName string, queryParams string, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -193,22 +210,57 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
+	started := time.Now()
+	defer func() {
+		record := models.CommandRecord{DeviceName: deviceName, CommandName: commandName, Method: http.MethodGet, QueryParams: queryParams}
+		switch {
+		case err != nil:
+			record.StatusCode = utils.ErrorCode(err)
+			record.Message = err.Message()
+		case res != nil:
+			record.StatusCode = res.StatusCode
+			record.Message = res.Message
+		default:
+			// the device service returns no event if ds-returnevent is no
+			record.StatusCode = http.StatusOK
+		}
+		history.Record(ctx, dic, record, started)
+	}()
+
 	// retrieve device information through Metadata DeviceClient
 	dc := bootstrapContainer.MetadataDeviceClientFrom(dic.Get)
 	if dc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
 	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	device, err := metadataCache.DeviceByName(context.Background(), dc, deviceName)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
+	if err = access.Authorize(ctx, dic, access.OperationGet, device, commandName); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	responseCache := commandContainer.ResponseCacheFrom(dic.Get)
+	cacheKey := cache.ResponseKey(deviceName, commandName, queryParams)
+	maxAge, cacheable := responseMaxAge(ctx, device, commandName, queryParams, dic)
+	control := cache.ControlFromContext(ctx)
+	if cacheable && (control == nil || !control.NoCache) {
+		if cached, age, ok := responseCache.Get(cacheKey, maxAge); ok {
+			if control != nil {
+				control.Hit = true
+				control.Age = age
+			}
+			return cached, nil
+		}
+	}
 
 	// retrieve device service information through Metadata DeviceClient
 	dsc := bootstrapContainer.MetadataDeviceServiceClientFrom(dic.Get)
 	if dsc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceServiceClient returned", nil)
 	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := metadataCache.DeviceServiceByName(context.Background(), dsc, device.ServiceName)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -218,17 +270,23 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	res, err = dscc.GetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
+	read := time.Now()
+	res, err = dscc.GetCommand(context.Background(), deviceService.BaseAddress, deviceName, commandName, queryParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
+	if cacheable && maxAge > 0 && res != nil {
+		responseCache.Put(cacheKey, deviceName, *res, read)
+	}
 
 	return res, nil
 }
 
 // IssueSetCommandByName issues the specified set(write) command referenced by the command name to the device/sensor, also
-// referenced by name.
-func IssueSetCommandByName(deviceName string, commandName string, queryParams string, settings map[string]string, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
+// referenced by name. The command is issued only if the caller of the request is allowed to by the command access policies,
+// and the settings are valid parameters of the command by the device profile. The command and its outcome are kept in the
+// command history.
+func IssueSetCommandByName(ctx context.Context, deviceName string, commandName string, queryParams string, settings map[string]string, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -237,22 +295,42 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
+	started := time.Now()
+	defer func() {
+		record := models.CommandRecord{DeviceName: deviceName, CommandName: commandName, Method: http.MethodPut, QueryParams: queryParams, Settings: settings}
+		if err != nil {
+			record.StatusCode = utils.ErrorCode(err)
+			record.Message = err.Message()
+		} else {
+			record.StatusCode = response.StatusCode
+			record.Message = response.Message
+		}
+		history.Record(ctx, dic, record, started)
+	}()
+
 	// retrieve device information through Metadata DeviceClient
 	dc := bootstrapContainer.MetadataDeviceClientFrom(dic.Get)
 	if dc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
 	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	device, err := metadataCache.DeviceByName(context.Background(), dc, deviceName)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
+	if err = access.Authorize(ctx, dic, access.OperationSet, device, commandName); err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateDeviceSettings(context.Background(), device, commandName, settings, dic); err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
 
 	// retrieve device service information through Metadata DeviceClient
 	dsc := bootstrapContainer.MetadataDeviceServiceClientFrom(dic.Get)
 	if dsc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceServiceClient returned", nil)
 	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := metadataCache.DeviceServiceByName(context.Background(), dsc, device.ServiceName)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -262,5 +340,9 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	return dscc.SetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
+	response, err = commandContainer.ResponseCacheFrom(dic.Get).SetCommand(context.Background(), dscc, deviceService.BaseAddress, deviceName, commandName, queryParams, settings)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
 }
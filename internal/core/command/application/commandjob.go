@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/access"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// IssueCommandAsync persists the command as a pending job and submits it to the job runner, the job is returned
+// without waiting for the device. The device is looked up, the command access policies are checked and the settings are
+// validated beforehand, so that an unknown device, a denied command or invalid settings are reported at once.
+func IssueCommandAsync(ctx context.Context, deviceName string, commandName string, method string, queryParams string,
+	settings map[string]string, callbackUrl string, dic *di.Container) (job models.CommandJob, edgeXerr errors.EdgeX) {
+	if deviceName == "" {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
+	}
+	if commandName == "" {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
+	}
+	if callbackUrl != "" {
+		if edgeXerr = validateCallbackUrl(callbackUrl); edgeXerr != nil {
+			return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
+	dc := bootstrapContainer.MetadataDeviceClientFrom(dic.Get)
+	if dc == nil {
+		return job, errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
+	}
+	device, edgeXerr := commandContainer.MetadataCacheFrom(dic.Get).DeviceByName(ctx, dc, deviceName)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	operation := access.OperationGet
+	if method == http.MethodPut {
+		operation = access.OperationSet
+	}
+	if edgeXerr = access.Authorize(ctx, dic, operation, device, commandName); edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if method == http.MethodPut {
+		if edgeXerr = validateDeviceSettings(ctx, device, commandName, settings, dic); edgeXerr != nil {
+			return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
+	job, edgeXerr = commandContainer.DBClientFrom(dic.Get).AddCommandJob(models.CommandJob{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        method,
+		QueryParams:   queryParams,
+		Settings:      settings,
+		CallbackUrl:   callbackUrl,
+		Status:        models.JobPending,
+		CorrelationId: correlation.FromContext(ctx),
+		Caller:        audit.ActorFromContext(ctx),
+	})
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if edgeXerr = commandContainer.JobRunnerFrom(dic.Get).Submit(job); edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Debugf("Command job %s is created for command %s of device %s. Correlation-ID: %s ", job.Id, commandName, deviceName, job.CorrelationId)
+	return job, nil
+}
+
+func validateCallbackUrl(callbackUrl string) errors.EdgeX {
+	u, err := url.ParseRequestURI(callbackUrl)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid callback URL '%s'", callbackUrl), err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("callback URL '%s' has to be an absolute http or https URL", callbackUrl), nil)
+	}
+	return nil
+}
+
+// CommandJobById queries the command job by id
+func CommandJobById(id string, dic *di.Container) (job pkgDtos.CommandJob, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	j, edgeXerr := commandContainer.DBClientFrom(dic.Get).CommandJobById(id)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromCommandJobModelToDTO(j), nil
+}
+
+// AllCommandJobs queries the command jobs with offset and limit, newest first
+func AllCommandJobs(offset int, limit int, dic *di.Container) (jobs []pkgDtos.CommandJob, edgeXerr errors.EdgeX) {
+	js, edgeXerr := commandContainer.DBClientFrom(dic.Get).AllCommandJobs(offset, limit)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromCommandJobModelsToDTOs(js), nil
+}
+
+// CommandJobsByStatus queries the command jobs in the status with offset and limit, newest first
+func CommandJobsByStatus(offset int, limit int, status string, dic *di.Container) (jobs []pkgDtos.CommandJob, edgeXerr errors.EdgeX) {
+	switch status {
+	case models.JobPending, models.JobRunning, models.JobSucceeded, models.JobFailed, models.JobCancelled:
+	default:
+		return jobs, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid command job status '%s'", status), nil)
+	}
+	js, edgeXerr := commandContainer.DBClientFrom(dic.Get).CommandJobsByStatus(offset, limit, status)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromCommandJobModelsToDTOs(js), nil
+}
+
+// CancelCommandJob cancels the pending or running command job, the job is marked as cancelled by the job runner once
+// its command is stopped. A job which is no longer in the runner, e.g. one of a previous run of the service, is marked
+// as cancelled at once, unless it has been completed in the meantime.
+func CancelCommandJob(id string, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	job, edgeXerr := dbClient.CommandJobById(id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if job.IsCompleted() {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("command job %s is %s already", id, job.Status), nil)
+	}
+	if commandContainer.JobRunnerFrom(dic.Get).Cancel(id) {
+		return nil
+	}
+
+	job.Status = models.JobCancelled
+	job.StatusCode = 0
+	job.Message = "the job was cancelled"
+	job.Completed = pkgCommon.MakeTimestamp()
+	if edgeXerr = dbClient.CancelCommandJob(job); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
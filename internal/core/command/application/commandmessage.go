@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+)
+
+// Methods of the command requests received from the message bus, which are the last level of the request topic
+const (
+	MessageMethodGet = "get"
+	MessageMethodSet = "set"
+)
+
+// IssueCommandMessage issues the command requested by the message received from the message bus, and returns the topic
+// and the envelope of the reply. The reply carries the correlation id of the request along with the EventResponse of a
+// read command, or the BaseResponse of a written command or of the error. An error is returned only if the request
+// topic doesn't tell the command, in which case there is nothing to reply.
+func IssueCommandMessage(ctx context.Context, request types.MessageEnvelope, dic *di.Container) (string, types.MessageEnvelope, errors.EdgeX) {
+	configuration := commandContainer.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	// Parse the request topic by the pattern `<subscribe-topic-prefix>/<device-name>/<command-name>/<get|set>`
+	fields := strings.Split(request.ReceivedTopic, "/")
+	if len(fields) < 4 {
+		return "", types.MessageEnvelope{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid command request topic %s", request.ReceivedTopic), nil)
+	}
+	commandFields := fields[len(fields)-3:]
+	deviceName, commandName, method := commandFields[0], commandFields[1], strings.ToLower(commandFields[2])
+	replyTopic := strings.Join(append([]string{configuration.MessageQueue.PublishTopicPrefix}, commandFields...), "/")
+
+	correlationId := request.CorrelationID
+	if correlationId == "" {
+		correlationId = uuid.New().String()
+	}
+	ctx = context.WithValue(ctx, common.CorrelationHeader, correlationId)
+	identity := configuration.MessageCommand.Identity
+	if identity == "" {
+		identity = audit.Anonymous
+	}
+	ctx = audit.WithActor(ctx, identity)
+	lc.Debugf("Command request received on message queue. Topic: %s, Correlation-id: %s", request.ReceivedTopic, correlationId)
+
+	var req requests.CommandMessageRequest
+	response, edgeXerr := issueCommandMessage(ctx, request, &req, deviceName, commandName, method, dic)
+	if edgeXerr != nil {
+		lc.Errorf("failed to issue command %s of device %s requested on message queue: %v. Correlation-id: %s", commandName, deviceName, edgeXerr, correlationId)
+		response = commonDTO.NewBaseResponse(req.RequestId, edgeXerr.Message(), utils.ErrorCode(edgeXerr))
+	}
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return "", types.MessageEnvelope{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the command reply", err)
+	}
+
+	reply := types.MessageEnvelope{
+		CorrelationID: correlationId,
+		Payload:       payload,
+		ContentType:   common.ContentTypeJSON,
+	}
+	return replyTopic, reply, nil
+}
+
+func issueCommandMessage(ctx context.Context, request types.MessageEnvelope, req *requests.CommandMessageRequest,
+	deviceName string, commandName string, method string, dic *di.Container) (interface{}, errors.EdgeX) {
+	if len(request.Payload) > 0 {
+		if err := unmarshalCommandPayload(request, req); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the command request", err)
+		}
+	}
+	query := make(url.Values)
+	for k, v := range req.QueryParameters {
+		query.Set(k, v)
+	}
+	for _, param := range []string{common.ReturnEvent, common.PushEvent} {
+		if v := query.Get(param); v != "" && v != common.ValueYes && v != common.ValueNo {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be %s or %s", param, common.ValueYes, common.ValueNo), nil)
+		}
+	}
+
+	switch method {
+	case MessageMethodGet:
+		res, err := IssueGetCommandByName(ctx, deviceName, commandName, query.Encode(), dic)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		// the device service returns no event if ds-returnevent is no
+		if res == nil {
+			return commonDTO.NewBaseResponse(req.RequestId, "", http.StatusOK), nil
+		}
+		res.RequestId = req.RequestId
+		return res, nil
+	case MessageMethodSet:
+		if len(req.Settings) == 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "settings are required to write the command", nil)
+		}
+		res, err := IssueSetCommandByName(ctx, deviceName, commandName, query.Encode(), req.Settings, dic)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		res.RequestId = req.RequestId
+		return res, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid command method %s, it has to be %s or %s", method, MessageMethodGet, MessageMethodSet), nil)
+	}
+}
+
+func unmarshalCommandPayload(envelope types.MessageEnvelope, target interface{}) error {
+	switch envelope.ContentType {
+	case common.ContentTypeJSON, "":
+		return json.Unmarshal(envelope.Payload, target)
+	case common.ContentTypeCBOR:
+		return cbor.Unmarshal(envelope.Payload, target)
+	default:
+		return fmt.Errorf("unsupported content-type '%s' received", envelope.ContentType)
+	}
+}
@@ -0,0 +1,159 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/access"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRequestTopic   = "edgex/command/request"
+	testResponseTopic  = "edgex/command/response"
+	testCorrelationId  = "1a2b3c"
+	testMessageCaller  = "cloud-bridge"
+	testReadOnlyDevice = "readOnlyDevice"
+)
+
+func mockCommandMessageDic(accessInfo config.CommandAccessInfo) *di.Container {
+	device := dtos.Device{Name: testDeviceName, ServiceName: testServiceName, ProfileName: testProfileName}
+	readOnlyDevice := dtos.Device{Name: testReadOnlyDevice, ServiceName: testServiceName, ProfileName: testProfileName}
+	event := dtos.NewEvent(testProfileName, testDeviceName, testCommandName)
+	eventResponse := responses.NewEventResponse("", "", http.StatusOK, event)
+	settings := map[string]string{"resource": "1"}
+
+	dc := &mocks.DeviceClient{}
+	dc.On("DeviceByName", mock.Anything, testDeviceName).Return(responses.NewDeviceResponse("", "", http.StatusOK, device), nil)
+	dc.On("DeviceByName", mock.Anything, testReadOnlyDevice).Return(responses.NewDeviceResponse("", "", http.StatusOK, readOnlyDevice), nil)
+	dc.On("DeviceByName", mock.Anything, unknownDevice).Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	dsc := &mocks.DeviceServiceClient{}
+	dsc.On("DeviceServiceByName", mock.Anything, testServiceName).
+		Return(responses.NewDeviceServiceResponse("", "", http.StatusOK, dtos.DeviceService{Name: testServiceName, BaseAddress: testBaseAddress}), nil)
+	dscc := &mocks.DeviceServiceCommandClient{}
+	dscc.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "").Return(&eventResponse, nil)
+	dscc.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "ds-returnevent=no").Return((*responses.EventResponse)(nil), nil)
+	dscc.On("SetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "", settings).Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	return di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable:       config.WritableInfo{CommandAccess: accessInfo},
+				MessageQueue:   bootstrapConfig.MessageBusInfo{PublishTopicPrefix: testResponseTopic, SubscribeTopic: testRequestTopic + "/#"},
+				MessageCommand: config.MessageCommandInfo{Identity: testMessageCaller},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dc
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dsc
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return mockProfileClient()
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dscc
+		},
+	})
+}
+
+func commandRequestEnvelope(t *testing.T, topic string, req *requests.CommandMessageRequest) types.MessageEnvelope {
+	envelope := types.MessageEnvelope{ReceivedTopic: topic, CorrelationID: testCorrelationId, ContentType: common.ContentTypeJSON}
+	if req != nil {
+		payload, err := json.Marshal(req)
+		require.NoError(t, err)
+		envelope.Payload = payload
+	}
+	return envelope
+}
+
+func TestIssueCommandMessage(t *testing.T) {
+	dic := mockCommandMessageDic(config.CommandAccessInfo{
+		Enabled:       true,
+		DefaultEffect: access.EffectDeny,
+		Policies: map[string]config.CommandAccessPolicy{
+			"bridge": {Identities: []string{testMessageCaller}, Devices: []string{testDeviceName}, Effect: access.EffectAllow},
+		},
+	})
+	commandTopic := testDeviceName + "/" + testCommandName
+	requestId := "82eb2e26-0f24-48ba-ae4c-de9dac3fb9bc"
+
+	tests := []struct {
+		name               string
+		topic              string
+		request            *requests.CommandMessageRequest
+		expectedStatusCode int
+		expectedEvent      bool
+	}{
+		{"Valid - read command", commandTopic + "/get", nil, http.StatusOK, true},
+		{"Valid - read command without event", commandTopic + "/GET",
+			&requests.CommandMessageRequest{QueryParameters: map[string]string{common.ReturnEvent: common.ValueNo}}, http.StatusOK, false},
+		{"Valid - write command", commandTopic + "/set", &requests.CommandMessageRequest{Settings: map[string]string{"resource": "1"}}, http.StatusOK, false},
+		{"Invalid - write command without settings", commandTopic + "/set", nil, http.StatusBadRequest, false},
+		{"Invalid - unknown method", commandTopic + "/delete", nil, http.StatusBadRequest, false},
+		{"Invalid - invalid query parameter", commandTopic + "/get",
+			&requests.CommandMessageRequest{QueryParameters: map[string]string{common.PushEvent: "maybe"}}, http.StatusBadRequest, false},
+		{"Invalid - unknown device", unknownDevice + "/" + testCommandName + "/get", nil, http.StatusNotFound, false},
+		{"Invalid - command denied", testReadOnlyDevice + "/" + testCommandName + "/get", nil, http.StatusForbidden, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.request != nil {
+				testCase.request.RequestId = requestId
+			}
+			request := commandRequestEnvelope(t, testRequestTopic+"/"+testCase.topic, testCase.request)
+			topic, reply, err := IssueCommandMessage(context.Background(), request, dic)
+			require.NoError(t, err)
+			assert.Equal(t, testResponseTopic+"/"+testCase.topic, topic)
+			assert.Equal(t, testCorrelationId, reply.CorrelationID)
+			assert.Equal(t, common.ContentTypeJSON, reply.ContentType)
+
+			var res responses.EventResponse
+			require.NoError(t, json.Unmarshal(reply.Payload, &res))
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode)
+			assert.Equal(t, testCase.expectedEvent, res.Event.Id != "")
+			if testCase.request != nil {
+				assert.Equal(t, requestId, res.RequestId)
+			}
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotEmpty(t, res.Message)
+			}
+		})
+	}
+}
+
+func TestIssueCommandMessageInvalidTopic(t *testing.T) {
+	dic := mockCommandMessageDic(config.CommandAccessInfo{})
+	_, _, err := IssueCommandMessage(context.Background(), commandRequestEnvelope(t, testCommandName+"/get", nil), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/history"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// CommandRecordById queries the command record by id
+func CommandRecordById(id string, dic *di.Container) (record pkgDtos.CommandRecord, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return record, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	r, edgeXerr := commandContainer.DBClientFrom(dic.Get).CommandRecordById(id)
+	if edgeXerr != nil {
+		return record, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromCommandRecordModelToDTO(r), nil
+}
+
+// CommandRecordsByDeviceName queries the command records of the device with offset and limit, newest first
+func CommandRecordsByDeviceName(offset int, limit int, name string, dic *di.Container) (records []pkgDtos.CommandRecord, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return records, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	rs, edgeXerr := commandContainer.DBClientFrom(dic.Get).CommandRecordsByDeviceName(offset, limit, name)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromCommandRecordModelsToDTOs(rs), nil
+}
+
+// ReplayCommandRecord issues the set command of the record again with the same settings. The replay is a new command of
+// the caller, so it is checked against the command access policies and the current device profile as usual, and it is
+// recorded as the replay of the record.
+func ReplayCommandRecord(ctx context.Context, id string, dic *di.Container) (response commonDTO.BaseResponse, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	record, edgeXerr := commandContainer.DBClientFrom(dic.Get).CommandRecordById(id)
+	if edgeXerr != nil {
+		return response, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if record.Method != http.MethodPut {
+		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command record %s is not a set command and can't be replayed", id), nil)
+	}
+	response, edgeXerr = IssueSetCommandByName(history.WithReplayOf(ctx, id), record.DeviceName, record.CommandName, record.QueryParams, record.Settings, dic)
+	if edgeXerr != nil {
+		return response, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return response, nil
+}
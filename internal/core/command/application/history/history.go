@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+type replayKey struct{}
+
+// WithReplayOf marks the commands issued with the context as the replay of the record
+func WithReplayOf(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, replayKey{}, id)
+}
+
+// Record persists the command issued to the device along with its outcome if the command history is enabled. The caller
+// and the correlation id are taken from the context, and the latency is measured from when the command was started. A
+// failure is only logged, so that it never fails the command which has been issued already.
+func Record(ctx context.Context, dic *di.Container, record models.CommandRecord, started time.Time) {
+	if !container.ConfigurationFrom(dic.Get).CommandHistory.Enabled {
+		return
+	}
+
+	record.Caller = audit.ActorFromContext(ctx)
+	if record.Caller == "" {
+		record.Caller = audit.Anonymous
+	}
+	if record.CorrelationId == "" {
+		record.CorrelationId = correlation.FromContext(ctx)
+	}
+	if replayOf, ok := ctx.Value(replayKey{}).(string); ok {
+		record.ReplayOf = replayOf
+	}
+	record.Latency = time.Since(started).Milliseconds()
+
+	if _, err := container.DBClientFrom(dic.Get).AddCommandRecord(record); err != nil {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		lc.Errorf("fail to record command %s of device %s, correlation id: %s, err: %v", record.CommandName, record.DeviceName, record.CorrelationId, err)
+	}
+}
+
+// Start purges the records older than MaxAge every PurgeInterval until the context is done, an error is returned if the
+// settings are invalid
+func Start(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	info := container.ConfigurationFrom(dic.Get).CommandHistory
+	if !info.Enabled || info.MaxAge == "" {
+		return nil
+	}
+	maxAge, err := time.ParseDuration(info.MaxAge)
+	if err != nil || maxAge <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid command history MaxAge '%s'", info.MaxAge), err)
+	}
+	purgeInterval, err := time.ParseDuration(info.PurgeInterval)
+	if err != nil || purgeInterval <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid command history PurgeInterval '%s'", info.PurgeInterval), err)
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(purgeInterval)
+		defer ticker.Stop()
+		for {
+			count, err := dbClient.DeleteCommandRecordsByAge(maxAge.Milliseconds())
+			if err != nil {
+				lc.Errorf("fail to purge the expired command records, err: %v", err)
+			} else if count > 0 {
+				lc.Debugf("%d expired command records are purged", count)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,398 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/history"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	interruptedMessage = "the job was interrupted by the restart of core-command"
+	cancelledMessage   = "the job was cancelled"
+	timedOutMessage    = "the job timed out"
+	rejectedMessage    = "too many command jobs are pending"
+
+	defaultMaxPending = 100
+)
+
+type runner struct {
+	dic             *di.Container
+	lc              logger.LoggingClient
+	timeout         time.Duration
+	callbackTimeout time.Duration
+	maxAge          time.Duration
+	purgeInterval   time.Duration
+	semaphore       chan struct{}
+	// maxJobs is the number of jobs which may be pending or running in the runner, each of which takes a goroutine
+	maxJobs int
+	ctx     context.Context
+	wg      *sync.WaitGroup
+	mutex   sync.Mutex
+	// jobs holds the jobs which are pending or running in the runner by id
+	jobs map[string]*runningJob
+}
+
+type runningJob struct {
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// NewRunner creates the runner of the command jobs, an error is returned if the settings are invalid
+func NewRunner(dic *di.Container, info config.CommandJobInfo) (interfaces.JobRunner, errors.EdgeX) {
+	r := &runner{
+		dic:  dic,
+		lc:   bootstrapContainer.LoggingClientFrom(dic.Get),
+		jobs: make(map[string]*runningJob),
+	}
+	maxConcurrent := info.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+	r.semaphore = make(chan struct{}, maxConcurrent)
+	maxPending := info.MaxPending
+	if maxPending <= 0 {
+		maxPending = defaultMaxPending
+	}
+	r.maxJobs = maxConcurrent + maxPending
+
+	var err errors.EdgeX
+	if r.timeout, err = parseDuration("Timeout", info.Timeout); err != nil {
+		return nil, err
+	}
+	if r.callbackTimeout, err = parseDuration("CallbackTimeout", info.CallbackTimeout); err != nil {
+		return nil, err
+	}
+	if r.maxAge, err = parseDuration("MaxAge", info.MaxAge); err != nil {
+		return nil, err
+	}
+	if r.maxAge > 0 {
+		if r.purgeInterval, err = parseDuration("PurgeInterval", info.PurgeInterval); err != nil {
+			return nil, err
+		} else if r.purgeInterval == 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "command job PurgeInterval is required along with MaxAge", nil)
+		}
+	}
+	return r, nil
+}
+
+// parseDuration parses the duration setting, zero is returned if it is empty
+func parseDuration(name string, value string) (time.Duration, errors.EdgeX) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid command job %s '%s'", name, value), err)
+	}
+	return d, nil
+}
+
+// Start fails the jobs which were left pending or running by the previous run of the service, since their commands
+// may or may not have been issued, and starts purging the completed jobs older than MaxAge
+func (r *runner) Start(ctx context.Context, wg *sync.WaitGroup) {
+	r.mutex.Lock()
+	r.ctx = ctx
+	r.wg = wg
+	r.mutex.Unlock()
+
+	dbClient := container.DBClientFrom(r.dic.Get)
+	for _, status := range []string{models.JobPending, models.JobRunning} {
+		jobs, err := dbClient.CommandJobsByStatus(0, -1, status)
+		if err != nil {
+			r.lc.Errorf("fail to load the %s command jobs, err: %v", status, err)
+			continue
+		}
+		for _, job := range jobs {
+			job.Status = models.JobFailed
+			job.StatusCode = http.StatusServiceUnavailable
+			job.Message = interruptedMessage
+			r.complete(job)
+		}
+	}
+
+	if r.maxAge == 0 {
+		return
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(r.purgeInterval)
+		defer ticker.Stop()
+		for {
+			count, err := dbClient.DeleteCommandJobsByAge(r.maxAge.Milliseconds())
+			if err != nil {
+				r.lc.Errorf("fail to purge the expired command jobs, err: %v", err)
+			} else if count > 0 {
+				r.lc.Debugf("%d expired command jobs are purged", count)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Submit runs the persisted job once a slot is available. The job is failed rather than waiting if MaxPending jobs are
+// waiting already, so that the goroutines of the runner are bounded by the settings.
+func (r *runner) Submit(job models.CommandJob) errors.EdgeX {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.ctx == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("command job %s is submitted before the runner is started", job.Id), nil)
+	}
+	if len(r.jobs) >= r.maxJobs {
+		// the submitter is told at once, so the callback URL isn't notified
+		job.Status = models.JobFailed
+		job.StatusCode = http.StatusServiceUnavailable
+		job.Message = rejectedMessage
+		job.Completed = pkgCommon.MakeTimestamp()
+		if err := container.DBClientFrom(r.dic.Get).UpdateCommandJob(job); err != nil {
+			r.lc.Errorf("fail to update the status of command job %s, correlation id: %s, err: %v", job.Id, job.CorrelationId, err)
+		}
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("command job %s is failed, %s", job.Id, rejectedMessage), nil)
+	}
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if r.timeout > 0 {
+		ctx, cancel = context.WithTimeout(r.ctx, r.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(r.ctx)
+	}
+	ctx = context.WithValue(ctx, common.CorrelationHeader, job.CorrelationId)
+	rj := &runningJob{cancel: cancel}
+	r.jobs[job.Id] = rj
+	r.wg.Add(1)
+	go r.run(ctx, rj, job)
+	return nil
+}
+
+// Cancel stops the job if it's pending or running in the runner
+func (r *runner) Cancel(id string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	rj, ok := r.jobs[id]
+	if !ok {
+		return false
+	}
+	rj.cancelled = true
+	rj.cancel()
+	return true
+}
+
+// run waits for a slot, issues the command of the job and records the outcome
+func (r *runner) run(ctx context.Context, rj *runningJob, job models.CommandJob) {
+	defer r.wg.Done()
+	defer func() {
+		r.mutex.Lock()
+		delete(r.jobs, job.Id)
+		r.mutex.Unlock()
+		rj.cancel()
+	}()
+
+	select {
+	case r.semaphore <- struct{}{}:
+		defer func() { <-r.semaphore }()
+	case <-ctx.Done():
+		r.complete(r.abort(ctx, rj, job))
+		return
+	}
+
+	job.Status = models.JobRunning
+	job.Started = pkgCommon.MakeTimestamp()
+	if err := container.DBClientFrom(r.dic.Get).UpdateCommandJob(job); err != nil {
+		r.lc.Errorf("fail to update the status of command job %s, correlation id: %s, err: %v", job.Id, job.CorrelationId, err)
+	}
+
+	started := time.Now()
+	err := r.execute(ctx, &job)
+	r.record(ctx, job, err, started)
+	if err != nil {
+		if ctx.Err() != nil {
+			job = r.abort(ctx, rj, job)
+		} else {
+			job.Status = models.JobFailed
+			job.StatusCode = err.Code()
+			job.Message = err.Message()
+		}
+	} else {
+		job.Status = models.JobSucceeded
+	}
+	r.complete(job)
+}
+
+// record keeps the command of the job in the command history, the outcome of a failed command is the error of the
+// device service rather than the status of the job
+func (r *runner) record(ctx context.Context, job models.CommandJob, err errors.EdgeX, started time.Time) {
+	record := models.CommandRecord{
+		DeviceName:    job.DeviceName,
+		CommandName:   job.CommandName,
+		Method:        job.Method,
+		QueryParams:   job.QueryParams,
+		Settings:      job.Settings,
+		CorrelationId: job.CorrelationId,
+		StatusCode:    job.StatusCode,
+		Message:       job.Message,
+	}
+	if err != nil {
+		record.StatusCode = utils.ErrorCode(err)
+		record.Message = err.Message()
+	}
+	history.Record(audit.WithActor(ctx, job.Caller), r.dic, record, started)
+}
+
+// abort marks the job whose context is done as cancelled, or as failed if it timed out or the service is stopping
+func (r *runner) abort(ctx context.Context, rj *runningJob, job models.CommandJob) models.CommandJob {
+	r.mutex.Lock()
+	cancelled := rj.cancelled
+	r.mutex.Unlock()
+
+	switch {
+	case cancelled:
+		job.Status = models.JobCancelled
+		job.StatusCode = 0
+		job.Message = cancelledMessage
+	case ctx.Err() == context.DeadlineExceeded:
+		job.Status = models.JobFailed
+		job.StatusCode = http.StatusGatewayTimeout
+		job.Message = timedOutMessage
+	default:
+		job.Status = models.JobFailed
+		job.StatusCode = http.StatusServiceUnavailable
+		job.Message = interruptedMessage
+	}
+	return job
+}
+
+// execute issues the command of the job to the device service which manages the device, the outcome is recorded in
+// the job
+func (r *runner) execute(ctx context.Context, job *models.CommandJob) errors.EdgeX {
+	dc := bootstrapContainer.MetadataDeviceClientFrom(r.dic.Get)
+	if dc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceClient returned", nil)
+	}
+	dsc := bootstrapContainer.MetadataDeviceServiceClientFrom(r.dic.Get)
+	if dsc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceServiceClient returned", nil)
+	}
+	dscc := bootstrapContainer.DeviceServiceCommandClientFrom(r.dic.Get)
+	if dscc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
+	}
+
+	metadataCache := container.MetadataCacheFrom(r.dic.Get)
+	device, err := metadataCache.DeviceByName(ctx, dc, job.DeviceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceService, err := metadataCache.DeviceServiceByName(ctx, dsc, device.ServiceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if job.Method == http.MethodPut {
+		res, err := container.ResponseCacheFrom(r.dic.Get).SetCommand(ctx, dscc, deviceService.BaseAddress, job.DeviceName, job.CommandName, job.QueryParams, job.Settings)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		job.StatusCode = res.StatusCode
+		job.Message = res.Message
+		return nil
+	}
+
+	res, err := dscc.GetCommand(ctx, deviceService.BaseAddress, job.DeviceName, job.CommandName, job.QueryParams)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	job.StatusCode = http.StatusOK
+	// the device service returns no event if ds-returnevent is no
+	if res != nil {
+		event, jsonErr := json.Marshal(res.Event)
+		if jsonErr != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the event read by the command", jsonErr)
+		}
+		job.StatusCode = res.StatusCode
+		job.Message = res.Message
+		job.Event = event
+	}
+	return nil
+}
+
+// complete persists the completed job and posts it to the callback URL of the job, a failure is only logged since the
+// job has been completed already
+func (r *runner) complete(job models.CommandJob) {
+	job.Completed = pkgCommon.MakeTimestamp()
+	if err := container.DBClientFrom(r.dic.Get).UpdateCommandJob(job); err != nil {
+		r.lc.Errorf("fail to update the status of command job %s, correlation id: %s, err: %v", job.Id, job.CorrelationId, err)
+	}
+	if job.CallbackUrl == "" {
+		return
+	}
+	if err := r.notify(job); err != nil {
+		r.lc.Errorf("fail to notify the callback URL of command job %s, correlation id: %s, err: %v", job.Id, job.CorrelationId, err)
+	}
+}
+
+// notify posts the completed job to the callback URL of the job
+func (r *runner) notify(job models.CommandJob) errors.EdgeX {
+	body, err := json.Marshal(pkgResponses.NewCommandJobResponse("", "", http.StatusOK, pkgDtos.FromCommandJobModelToDTO(job)))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the command job", err)
+	}
+
+	ctx := context.Background()
+	if r.callbackTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.callbackTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.CallbackUrl, bytes.NewReader(body))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to create the callback request", err)
+	}
+	req.Header.Set(common.ContentType, common.ContentTypeJSON)
+	req.Header.Set(common.CorrelationHeader, job.CorrelationId)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, "failed to send the callback request", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), fmt.Sprintf("callback request failed, status code: %d", resp.StatusCode), nil)
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeviceName        = "testDevice"
+	testDeviceServiceName = "testDeviceService"
+	testCommandName       = "testCommand"
+	testBaseAddress       = "http://localhost:49990"
+)
+
+// mockRunnerDic returns the DIC of the runner whose job updates are sent to the returned channel
+func mockRunnerDic(dsccMock *mocks.DeviceServiceCommandClient, pendingJobs []models.CommandJob) (*di.Container, chan models.CommandJob) {
+	updates := make(chan models.CommandJob, 10)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateCommandJob", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updates <- args.Get(0).(models.CommandJob)
+	})
+	dbClientMock.On("CommandJobsByStatus", 0, -1, models.JobPending).Return(pendingJobs, nil)
+	dbClientMock.On("CommandJobsByStatus", 0, -1, models.JobRunning).Return([]models.CommandJob{}, nil)
+	dbClientMock.On("AddCommandRecord", mock.Anything).Return(models.CommandRecord{}, nil)
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testDeviceName, ServiceName: testDeviceServiceName}}, nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testDeviceServiceName, BaseAddress: testBaseAddress}}, nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{CommandHistory: config.CommandHistoryInfo{Enabled: true}}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	return dic, updates
+}
+
+// waitForStatus returns the first update of the job to the status
+func waitForStatus(t *testing.T, updates chan models.CommandJob, status models.CommandJobStatus) models.CommandJob {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case job := <-updates:
+			if job.Status == status {
+				return job
+			}
+		case <-timeout:
+			require.Failf(t, "timed out", "the job is not updated to %s", status)
+		}
+	}
+}
+
+// blockUntilDone makes the mocked command return only once its context is done
+func blockUntilDone(args mock.Arguments) {
+	<-args.Get(0).(context.Context).Done()
+}
+
+func startRunner(t *testing.T, dic *di.Container, info config.CommandJobInfo) (*runner, func()) {
+	r, err := NewRunner(dic, info)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	r.Start(ctx, wg)
+	return r.(*runner), func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+func TestRunnerSucceeded(t *testing.T) {
+	event := dtos.NewEvent("testProfile", testDeviceName, "testSource")
+	eventResponse := responses.NewEventResponse("", "", http.StatusOK, event)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "").
+		Return(&eventResponse, nil)
+	dsccMock.On("SetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "", map[string]string{"a": "1"}).
+		Return(common.NewBaseResponse("", "", http.StatusOK), nil)
+	dic, updates := mockRunnerDic(dsccMock, nil)
+	r, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1})
+	defer stop()
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "get", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+	job := waitForStatus(t, updates, models.JobSucceeded)
+	assert.Equal(t, "get", job.Id)
+	assert.Equal(t, http.StatusOK, job.StatusCode)
+	assert.NotZero(t, job.Started)
+	assert.NotZero(t, job.Completed)
+	var readEvent dtos.Event
+	require.NoError(t, json.Unmarshal(job.Event, &readEvent))
+	assert.Equal(t, event.Id, readEvent.Id)
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "put", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut,
+		Settings: map[string]string{"a": "1"}, Status: models.JobPending}))
+	job = waitForStatus(t, updates, models.JobSucceeded)
+	assert.Equal(t, "put", job.Id)
+	assert.Empty(t, job.Event)
+}
+
+func TestRunnerFailed(t *testing.T) {
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "").
+		Return(nil, errors.NewCommonEdgeX(errors.KindCommunicationError, "device is unreachable", nil))
+	dic, updates := mockRunnerDic(dsccMock, nil)
+	r, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1})
+	defer stop()
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "get", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+	job := waitForStatus(t, updates, models.JobFailed)
+	assert.Equal(t, http.StatusBadGateway, job.StatusCode)
+	assert.Contains(t, job.Message, "device is unreachable")
+}
+
+func TestRunnerRecordsHistory(t *testing.T) {
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "", map[string]string{"a": "1"}).
+		Return(common.BaseResponse{}, errors.NewCommonEdgeX(errors.KindCommunicationError, "device is unreachable", nil))
+	dic, updates := mockRunnerDic(dsccMock, nil)
+	r, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1})
+	defer stop()
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "put", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut,
+		Settings: map[string]string{"a": "1"}, Status: models.JobPending, CorrelationId: "testCorrelationId", Caller: "testCaller"}))
+	waitForStatus(t, updates, models.JobFailed)
+
+	dbClientMock := container.DBClientFrom(dic.Get).(*dbMock.DBClient)
+	dbClientMock.AssertCalled(t, "AddCommandRecord", mock.MatchedBy(func(record models.CommandRecord) bool {
+		return record.DeviceName == testDeviceName && record.CommandName == testCommandName && record.Method == http.MethodPut &&
+			record.Settings["a"] == "1" && record.Caller == "testCaller" && record.CorrelationId == "testCorrelationId" &&
+			record.StatusCode == http.StatusBadGateway
+	}))
+}
+
+func TestRunnerCancel(t *testing.T) {
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "").
+		Return(nil, errors.NewCommonEdgeX(errors.KindCommunicationError, "request cancelled", nil)).Run(blockUntilDone)
+	dic, updates := mockRunnerDic(dsccMock, nil)
+	r, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1})
+	defer stop()
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "running", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+	waitForStatus(t, updates, models.JobRunning)
+	// the second job waits for the slot taken by the first one
+	require.NoError(t, r.Submit(models.CommandJob{Id: "pending", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+
+	assert.True(t, r.Cancel("pending"))
+	job := waitForStatus(t, updates, models.JobCancelled)
+	assert.Equal(t, "pending", job.Id)
+	assert.Zero(t, job.Started)
+
+	assert.True(t, r.Cancel("running"))
+	job = waitForStatus(t, updates, models.JobCancelled)
+	assert.Equal(t, "running", job.Id)
+	assert.NotZero(t, job.Started)
+
+	assert.Eventually(t, func() bool { return !r.Cancel("running") }, time.Second, 10*time.Millisecond,
+		"the completed job should be no longer in the runner")
+}
+
+func TestRunnerRejectsJobs(t *testing.T) {
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "").
+		Return(nil, errors.NewCommonEdgeX(errors.KindCommunicationError, "request cancelled", nil)).Run(blockUntilDone)
+	dic, updates := mockRunnerDic(dsccMock, nil)
+	r, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1, MaxPending: 1})
+	defer stop()
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "running", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+	waitForStatus(t, updates, models.JobRunning)
+	require.NoError(t, r.Submit(models.CommandJob{Id: "pending", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+
+	// the third job is failed at once since one job is running and one is pending already
+	err := r.Submit(models.CommandJob{Id: "rejected", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	job := waitForStatus(t, updates, models.JobFailed)
+	assert.Equal(t, "rejected", job.Id)
+	assert.Equal(t, http.StatusServiceUnavailable, job.StatusCode)
+	assert.Equal(t, rejectedMessage, job.Message)
+	assert.False(t, r.Cancel("rejected"))
+}
+
+func TestRunnerTimeout(t *testing.T) {
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "").
+		Return(nil, errors.NewCommonEdgeX(errors.KindCommunicationError, "request timed out", nil)).Run(blockUntilDone)
+	dic, updates := mockRunnerDic(dsccMock, nil)
+	r, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1, Timeout: "50ms"})
+	defer stop()
+
+	require.NoError(t, r.Submit(models.CommandJob{Id: "slow", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobPending}))
+	job := waitForStatus(t, updates, models.JobFailed)
+	assert.Equal(t, http.StatusGatewayTimeout, job.StatusCode)
+	assert.Equal(t, timedOutMessage, job.Message)
+}
+
+func TestRunnerStartFailsInterruptedJobs(t *testing.T) {
+	callbacks := make(chan pkgResponses.CommandJobResponse, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res pkgResponses.CommandJobResponse
+		_ = json.NewDecoder(r.Body).Decode(&res)
+		callbacks <- res
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	interrupted := models.CommandJob{Id: "interrupted", DeviceName: testDeviceName, CommandName: testCommandName,
+		Method: http.MethodGet, Status: models.JobPending, CallbackUrl: server.URL}
+	dic, updates := mockRunnerDic(&mocks.DeviceServiceCommandClient{}, []models.CommandJob{interrupted})
+	_, stop := startRunner(t, dic, config.CommandJobInfo{MaxConcurrent: 1, CallbackTimeout: "5s"})
+	defer stop()
+
+	job := waitForStatus(t, updates, models.JobFailed)
+	assert.Equal(t, "interrupted", job.Id)
+	assert.Equal(t, interruptedMessage, job.Message)
+
+	select {
+	case res := <-callbacks:
+		assert.Equal(t, "interrupted", res.Job.Id)
+		assert.Equal(t, models.JobFailed, res.Job.Status)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the callback URL is not notified")
+	}
+}
+
+func TestNewRunnerInvalidSettings(t *testing.T) {
+	dic, _ := mockRunnerDic(&mocks.DeviceServiceCommandClient{}, nil)
+	tests := []struct {
+		name string
+		info config.CommandJobInfo
+	}{
+		{"invalid Timeout", config.CommandJobInfo{Timeout: "abc"}},
+		{"negative CallbackTimeout", config.CommandJobInfo{CallbackTimeout: "-1s"}},
+		{"invalid MaxAge", config.CommandJobInfo{MaxAge: "1x"}},
+		{"MaxAge without PurgeInterval", config.CommandJobInfo{MaxAge: "1h"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewRunner(dic, testCase.info)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
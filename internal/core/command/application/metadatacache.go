@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// InvalidateMetadataCache removes the changed entity from the metadata cache. When a device service or device profile
+// is deleted, the devices associated with it are deleted along with it, so all the devices are invalidated since the
+// change notifications of those devices may arrive later.
+func InvalidateMetadataCache(change pkgDtos.MetadataChange, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if change.EntityType == string(pkgModels.AuditDevice) {
+		// the responses of the device may no longer be valid, e.g. its protocols have changed
+		commandContainer.ResponseCacheFrom(dic.Get).InvalidateDevice(change.Name)
+	}
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	if metadataCache == nil {
+		return
+	}
+
+	entityType := pkgModels.AuditEntityType(change.EntityType)
+	metadataCache.Invalidate(entityType, change.Name)
+	if change.Action == string(pkgModels.AuditDelete) && entityType != pkgModels.AuditDevice {
+		metadataCache.Invalidate(pkgModels.AuditDevice, "")
+	}
+	lc.Debugf("%s %s invalidated in the metadata cache, action: %s", change.EntityType, change.Name, change.Action)
+}
+
+// MetadataCacheStats returns the counters of the metadata cache and whether the cache is enabled
+func MetadataCacheStats(dic *di.Container) (stats cache.Stats, enabled bool) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	return metadataCache.Stats(), metadataCache != nil
+}
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddRecipe adds a new recipe, the devices and commands of its steps are only checked when the recipe is run
+func AddRecipe(r models.Recipe, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	addedRecipe, edgeXerr := commandContainer.DBClientFrom(dic.Get).AddRecipe(r)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Debugf("Recipe created on DB successfully. Recipe ID: %s, Correlation-ID: %s ", addedRecipe.Id, correlation.FromContext(ctx))
+	return addedRecipe.Id, nil
+}
+
+// RecipeByName queries the recipe by name
+func RecipeByName(name string, dic *di.Container) (recipe pkgDtos.Recipe, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return recipe, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	r, edgeXerr := commandContainer.DBClientFrom(dic.Get).RecipeByName(name)
+	if edgeXerr != nil {
+		return recipe, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromRecipeModelToDTO(r), nil
+}
+
+// AllRecipes queries the recipes with offset and limit
+func AllRecipes(offset int, limit int, dic *di.Container) (recipes []pkgDtos.Recipe, edgeXerr errors.EdgeX) {
+	rs, edgeXerr := commandContainer.DBClientFrom(dic.Get).AllRecipes(offset, limit)
+	if edgeXerr != nil {
+		return recipes, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromRecipeModelsToDTOs(rs), nil
+}
+
+// DeleteRecipeByName deletes the recipe by name, the logs of its runs are kept until they expire
+func DeleteRecipeByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if edgeXerr := commandContainer.DBClientFrom(dic.Get).DeleteRecipeByName(name); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// RunRecipe persists a new run of the recipe and submits it to the recipe executor, the run is returned without
+// waiting for its steps. The steps are issued on behalf of the caller, so the command access policies apply to them.
+func RunRecipe(ctx context.Context, name string, dic *di.Container) (run pkgDtos.RecipeRun, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return run, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	recipe, edgeXerr := dbClient.RecipeByName(name)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	caller := audit.ActorFromContext(ctx)
+	if caller == "" {
+		caller = audit.Anonymous
+	}
+	r, edgeXerr := dbClient.AddRecipeRun(models.RecipeRun{
+		RecipeName:    recipe.Name,
+		Status:        models.RecipeRunRunning,
+		Steps:         []models.RecipeStepLog{},
+		Caller:        caller,
+		CorrelationId: correlation.FromContext(ctx),
+		Started:       pkgCommon.MakeTimestamp(),
+	})
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	commandContainer.RecipeExecutorFrom(dic.Get).Submit(recipe, r)
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Debugf("Recipe run %s is started for recipe %s. Correlation-ID: %s ", r.Id, recipe.Name, r.CorrelationId)
+	return pkgDtos.FromRecipeRunModelToDTO(r), nil
+}
+
+// RecipeRunById queries the recipe run by id
+func RecipeRunById(id string, dic *di.Container) (run pkgDtos.RecipeRun, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return run, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	r, edgeXerr := commandContainer.DBClientFrom(dic.Get).RecipeRunById(id)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromRecipeRunModelToDTO(r), nil
+}
+
+// RecipeRunsByRecipeName queries the runs of the recipe with offset and limit, newest first
+func RecipeRunsByRecipeName(offset int, limit int, name string, dic *di.Container) (runs []pkgDtos.RecipeRun, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return runs, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	rs, edgeXerr := commandContainer.DBClientFrom(dic.Get).RecipeRunsByRecipeName(offset, limit, name)
+	if edgeXerr != nil {
+		return runs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromRecipeRunModelsToDTOs(rs), nil
+}
@@ -0,0 +1,284 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recipe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	interruptedMessage  = "the run was interrupted by the restart of core-command"
+	conditionNotMetText = "the condition is not met"
+)
+
+type executor struct {
+	dic           *di.Container
+	lc            logger.LoggingClient
+	maxAge        time.Duration
+	purgeInterval time.Duration
+	ctx           context.Context
+	wg            *sync.WaitGroup
+	mutex         sync.Mutex
+}
+
+// NewExecutor creates the executor of the recipes, an error is returned if the settings are invalid
+func NewExecutor(dic *di.Container, info config.RecipeInfo) (interfaces.RecipeExecutor, errors.EdgeX) {
+	e := &executor{
+		dic: dic,
+		lc:  bootstrapContainer.LoggingClientFrom(dic.Get),
+	}
+	if info.RunMaxAge == "" {
+		return e, nil
+	}
+	var err error
+	if e.maxAge, err = time.ParseDuration(info.RunMaxAge); err != nil || e.maxAge <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid recipe RunMaxAge '%s'", info.RunMaxAge), err)
+	}
+	if e.purgeInterval, err = time.ParseDuration(info.PurgeInterval); err != nil || e.purgeInterval <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid recipe PurgeInterval '%s'", info.PurgeInterval), err)
+	}
+	return e, nil
+}
+
+// Start fails the runs which were left running by the previous run of the service, and starts purging the runs older
+// than RunMaxAge
+func (e *executor) Start(ctx context.Context, wg *sync.WaitGroup) {
+	e.mutex.Lock()
+	e.ctx = ctx
+	e.wg = wg
+	e.mutex.Unlock()
+
+	dbClient := container.DBClientFrom(e.dic.Get)
+	runs, err := dbClient.RecipeRunsByStatus(0, -1, models.RecipeRunRunning)
+	if err != nil {
+		e.lc.Errorf("fail to load the running recipe runs, err: %v", err)
+	}
+	for _, run := range runs {
+		run.Status = models.RecipeRunFailed
+		run.Message = interruptedMessage
+		e.complete(run)
+	}
+
+	if e.maxAge == 0 {
+		return
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(e.purgeInterval)
+		defer ticker.Stop()
+		for {
+			count, err := dbClient.DeleteRecipeRunsByAge(e.maxAge.Milliseconds())
+			if err != nil {
+				e.lc.Errorf("fail to purge the expired recipe runs, err: %v", err)
+			} else if count > 0 {
+				e.lc.Debugf("%d expired recipe runs are purged", count)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Submit runs the steps of the recipe in the background on behalf of the caller of the run
+func (e *executor) Submit(recipe models.Recipe, run models.RecipeRun) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.ctx == nil {
+		e.lc.Errorf("recipe run %s is submitted before the executor is started", run.Id)
+		return
+	}
+	ctx := context.WithValue(e.ctx, common.CorrelationHeader, run.CorrelationId)
+	ctx = audit.WithActor(ctx, run.Caller)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.complete(e.run(ctx, recipe, run))
+	}()
+}
+
+// run issues the steps one after another, the run is failed by the first step which fails. The log of each step is
+// persisted once the step is completed, so that the progress of a long run can be followed.
+func (e *executor) run(ctx context.Context, recipe models.Recipe, run models.RecipeRun) models.RecipeRun {
+	dbClient := container.DBClientFrom(e.dic.Get)
+	// readings holds the values read by each GET step which was issued, by step name and resource name
+	readings := make(map[string]map[string]string)
+	for i, step := range recipe.Steps {
+		if step.Delay != "" {
+			delay, err := time.ParseDuration(step.Delay)
+			if err != nil {
+				return failRun(run, fmt.Sprintf("invalid delay '%s' of step %d", step.Delay, i))
+			}
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return failRun(run, interruptedMessage)
+			case <-timer.C:
+			}
+		}
+
+		log, err := e.runStep(ctx, step, readings)
+		run.Steps = append(run.Steps, log)
+		if err != nil {
+			return failRun(run, fmt.Sprintf("step %d failed: %s", i, err.Message()))
+		}
+		if step.Name != "" && !log.Skipped && step.Method == http.MethodGet {
+			readings[step.Name] = log.Readings
+		}
+		if i < len(recipe.Steps)-1 {
+			if err := dbClient.UpdateRecipeRun(run); err != nil {
+				e.lc.Errorf("fail to update recipe run %s, correlation id: %s, err: %v", run.Id, run.CorrelationId, err)
+			}
+		}
+	}
+	run.Status = models.RecipeRunSucceeded
+	return run
+}
+
+// runStep issues the command of the step unless its condition isn't met, the error of the failed command is logged in
+// the step and returned
+func (e *executor) runStep(ctx context.Context, step models.RecipeStep, readings map[string]map[string]string) (log models.RecipeStepLog, err errors.EdgeX) {
+	log = models.RecipeStepLog{
+		Name:        step.Name,
+		DeviceName:  step.DeviceName,
+		CommandName: step.CommandName,
+		Method:      step.Method,
+		Started:     pkgCommon.MakeTimestamp(),
+	}
+	defer func() {
+		if err != nil {
+			log.StatusCode = utils.ErrorCode(err)
+			log.Message = err.Message()
+		}
+		log.Completed = pkgCommon.MakeTimestamp()
+	}()
+
+	if step.Condition != nil {
+		met, err := conditionMet(*step.Condition, readings)
+		if err != nil {
+			return log, errors.NewCommonEdgeXWrapper(err)
+		}
+		if !met {
+			log.Skipped = true
+			log.Message = conditionNotMetText
+			return log, nil
+		}
+	}
+
+	if step.Method == http.MethodPut {
+		res, err := application.IssueSetCommandByName(ctx, step.DeviceName, step.CommandName, step.QueryParams, step.Settings, e.dic)
+		if err != nil {
+			return log, errors.NewCommonEdgeXWrapper(err)
+		}
+		log.StatusCode = res.StatusCode
+		log.Message = res.Message
+		return log, nil
+	}
+
+	res, err := application.IssueGetCommandByName(ctx, step.DeviceName, step.CommandName, step.QueryParams, e.dic)
+	if err != nil {
+		return log, errors.NewCommonEdgeXWrapper(err)
+	}
+	log.StatusCode = http.StatusOK
+	// the device service returns no event if ds-returnevent is no
+	if res != nil {
+		log.StatusCode = res.StatusCode
+		log.Message = res.Message
+		log.Readings = make(map[string]string, len(res.Event.Readings))
+		for _, r := range res.Event.Readings {
+			if r.BinaryValue == nil {
+				log.Readings[r.ResourceName] = r.Value
+			}
+		}
+	}
+	return log, nil
+}
+
+// conditionMet compares the reading of the earlier step with the value of the condition. The condition isn't met if
+// the earlier step was skipped, and an error is returned if the step didn't return the reading.
+func conditionMet(condition models.RecipeCondition, readings map[string]map[string]string) (bool, errors.EdgeX) {
+	stepReadings, ok := readings[condition.Step]
+	if !ok {
+		return false, nil
+	}
+	actual, ok := stepReadings[condition.ResourceName]
+	if !ok {
+		return false, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("step %s returned no reading of resource %s", condition.Step, condition.ResourceName), nil)
+	}
+	return compare(actual, condition.Operator, condition.Value)
+}
+
+// compare compares the values as numbers if both of them are numbers, otherwise they can only be compared as strings
+// for equality
+func compare(actual string, operator string, expected string) (bool, errors.EdgeX) {
+	a, aErr := strconv.ParseFloat(actual, 64)
+	b, bErr := strconv.ParseFloat(expected, 64)
+	if aErr == nil && bErr == nil {
+		switch operator {
+		case models.OperatorEqual:
+			return a == b, nil
+		case models.OperatorNotEqual:
+			return a != b, nil
+		case models.OperatorGreater:
+			return a > b, nil
+		case models.OperatorGreaterOrEqual:
+			return a >= b, nil
+		case models.OperatorLess:
+			return a < b, nil
+		case models.OperatorLessOrEqual:
+			return a <= b, nil
+		}
+	} else {
+		switch operator {
+		case models.OperatorEqual:
+			return actual == expected, nil
+		case models.OperatorNotEqual:
+			return actual != expected, nil
+		}
+	}
+	return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("'%s' %s '%s' can't be compared", actual, operator, expected), nil)
+}
+
+func failRun(run models.RecipeRun, message string) models.RecipeRun {
+	run.Status = models.RecipeRunFailed
+	run.Message = message
+	return run
+}
+
+// complete persists the completed run, a failure is only logged since the run has been completed already
+func (e *executor) complete(run models.RecipeRun) {
+	run.Completed = pkgCommon.MakeTimestamp()
+	if err := container.DBClientFrom(e.dic.Get).UpdateRecipeRun(run); err != nil {
+		e.lc.Errorf("fail to update recipe run %s, correlation id: %s, err: %v", run.Id, run.CorrelationId, err)
+	}
+}
@@ -0,0 +1,218 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recipe
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProfileName       = "testProfile"
+	testDeviceServiceName = "testDeviceService"
+	testBaseAddress       = "http://localhost:49990"
+	boilerDevice          = "boiler"
+	sensorDevice          = "sensor"
+	fanDevice             = "fan"
+	unknownDevice         = "unknown"
+)
+
+// mockExecutorDic returns the DIC of the executor whose run updates are sent to the returned channel
+func mockExecutorDic(dscc *mocks.DeviceServiceCommandClient) (*di.Container, chan models.RecipeRun) {
+	updates := make(chan models.RecipeRun, 10)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateRecipeRun", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updates <- args.Get(0).(models.RecipeRun)
+	})
+	dbClientMock.On("RecipeRunsByStatus", 0, -1, models.RecipeRunRunning).Return([]models.RecipeRun{}, nil)
+
+	profile := dtos.DeviceProfile{
+		Name: testProfileName,
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "setpoint", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+			{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+			{Name: "speed", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_RW}},
+		},
+	}
+	dpc := &mocks.DeviceProfileClient{}
+	dpc.On("DeviceProfileByName", mock.Anything, testProfileName).Return(responses.NewDeviceProfileResponse("", "", http.StatusOK, profile), nil)
+	dc := &mocks.DeviceClient{}
+	for _, name := range []string{boilerDevice, sensorDevice, fanDevice} {
+		device := dtos.Device{Name: name, ProfileName: testProfileName, ServiceName: testDeviceServiceName}
+		dc.On("DeviceByName", mock.Anything, name).Return(responses.NewDeviceResponse("", "", http.StatusOK, device), nil)
+	}
+	dc.On("DeviceByName", mock.Anything, unknownDevice).Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	dsc := &mocks.DeviceServiceClient{}
+	dsc.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).
+		Return(responses.NewDeviceServiceResponse("", "", http.StatusOK, dtos.DeviceService{Name: testDeviceServiceName, BaseAddress: testBaseAddress}), nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dc
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dsc
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpc
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dscc
+		},
+	})
+	return dic, updates
+}
+
+func startExecutor(t *testing.T, dic *di.Container) (*executor, func()) {
+	e, err := NewExecutor(dic, config.RecipeInfo{})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	e.Start(ctx, wg)
+	return e.(*executor), func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// waitForCompletion returns the first update of the run which is no longer running
+func waitForCompletion(t *testing.T, updates chan models.RecipeRun) models.RecipeRun {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case run := <-updates:
+			if run.Status != models.RecipeRunRunning {
+				return run
+			}
+		case <-timeout:
+			require.Fail(t, "timed out", "the run is not completed")
+		}
+	}
+}
+
+func TestExecutorRun(t *testing.T) {
+	event := dtos.NewEvent(testProfileName, sensorDevice, "temperature")
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeFloat32, float32(30)))
+	eventResponse := responses.NewEventResponse("", "", http.StatusOK, event)
+	dscc := &mocks.DeviceServiceCommandClient{}
+	dscc.On("SetCommand", mock.Anything, testBaseAddress, boilerDevice, "setpoint", "", map[string]string{"setpoint": "20"}).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dscc.On("GetCommand", mock.Anything, testBaseAddress, sensorDevice, "temperature", "").Return(&eventResponse, nil)
+	dscc.On("SetCommand", mock.Anything, testBaseAddress, fanDevice, "speed", "", map[string]string{"speed": "3"}).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dic, updates := mockExecutorDic(dscc)
+	e, stop := startExecutor(t, dic)
+	defer stop()
+
+	recipe := models.Recipe{Name: "cooling", Steps: []models.RecipeStep{
+		{Name: "setA", DeviceName: boilerDevice, CommandName: "setpoint", Method: http.MethodPut, Settings: map[string]string{"setpoint": "20"}},
+		{Name: "readB", DeviceName: sensorDevice, CommandName: "temperature", Method: http.MethodGet, Delay: "10ms"},
+		{Name: "setC", DeviceName: fanDevice, CommandName: "speed", Method: http.MethodPut, Settings: map[string]string{"speed": "3"},
+			Condition: &models.RecipeCondition{Step: "readB", ResourceName: "temperature", Operator: models.OperatorGreater, Value: "25"}},
+		{Name: "setD", DeviceName: fanDevice, CommandName: "speed", Method: http.MethodPut, Settings: map[string]string{"speed": "0"},
+			Condition: &models.RecipeCondition{Step: "readB", ResourceName: "temperature", Operator: models.OperatorLessOrEqual, Value: "25"}},
+	}}
+	e.Submit(recipe, models.RecipeRun{Id: "run", RecipeName: recipe.Name, Status: models.RecipeRunRunning})
+	run := waitForCompletion(t, updates)
+
+	assert.Equal(t, models.RecipeRunStatus(models.RecipeRunSucceeded), run.Status)
+	assert.NotZero(t, run.Completed)
+	require.Len(t, run.Steps, 4)
+	assert.False(t, run.Steps[0].Skipped)
+	assert.Equal(t, http.StatusOK, run.Steps[0].StatusCode)
+	assert.Equal(t, "3.000000e+01", run.Steps[1].Readings["temperature"])
+	assert.False(t, run.Steps[2].Skipped)
+	assert.True(t, run.Steps[3].Skipped)
+	dscc.AssertNotCalled(t, "SetCommand", mock.Anything, testBaseAddress, fanDevice, "speed", "", map[string]string{"speed": "0"})
+}
+
+func TestExecutorRunFailed(t *testing.T) {
+	dscc := &mocks.DeviceServiceCommandClient{}
+	dscc.On("SetCommand", mock.Anything, testBaseAddress, boilerDevice, "setpoint", "", map[string]string{"setpoint": "20"}).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dic, updates := mockExecutorDic(dscc)
+	e, stop := startExecutor(t, dic)
+	defer stop()
+
+	recipe := models.Recipe{Name: "broken", Steps: []models.RecipeStep{
+		{DeviceName: unknownDevice, CommandName: "setpoint", Method: http.MethodPut, Settings: map[string]string{"setpoint": "20"}},
+		{DeviceName: boilerDevice, CommandName: "setpoint", Method: http.MethodPut, Settings: map[string]string{"setpoint": "20"}},
+	}}
+	e.Submit(recipe, models.RecipeRun{Id: "run", RecipeName: recipe.Name, Status: models.RecipeRunRunning})
+	run := waitForCompletion(t, updates)
+
+	assert.Equal(t, models.RecipeRunStatus(models.RecipeRunFailed), run.Status)
+	assert.Contains(t, run.Message, "step 0 failed")
+	require.Len(t, run.Steps, 1)
+	assert.Equal(t, http.StatusNotFound, run.Steps[0].StatusCode)
+	dscc.AssertNotCalled(t, "SetCommand", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		actual        string
+		operator      string
+		expected      string
+		result        bool
+		errorExpected bool
+	}{
+		{"greater", "30", models.OperatorGreater, "25", true, false},
+		{"not greater", "25", models.OperatorGreater, "25", false, false},
+		{"greater or equal", "25", models.OperatorGreaterOrEqual, "25", true, false},
+		{"less", "2.5e+01", models.OperatorLess, "30", true, false},
+		{"less or equal", "31", models.OperatorLessOrEqual, "30", false, false},
+		{"equal numbers", "1.0", models.OperatorEqual, "1", true, false},
+		{"not equal numbers", "1", models.OperatorNotEqual, "2", true, false},
+		{"equal strings", "on", models.OperatorEqual, "on", true, false},
+		{"not equal strings", "on", models.OperatorNotEqual, "off", true, false},
+		{"string greater", "on", models.OperatorGreater, "off", false, true},
+		{"string with number", "on", models.OperatorLess, "1", false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := compare(testCase.actual, testCase.operator, testCase.expected)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.result, result)
+			}
+		})
+	}
+}
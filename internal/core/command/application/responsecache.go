@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+)
+
+// responseMaxAge returns how long the response of the GET command can be served from the response cache, the max-age
+// directive of the request takes precedence over the max age declared by the device profile. The response isn't
+// cacheable if the cache is disabled, or if the command pushes an event to core-data which a cached response wouldn't.
+func responseMaxAge(ctx context.Context, device dtos.Device, commandName string, queryParams string, dic *di.Container) (time.Duration, bool) {
+	responseCache := commandContainer.ResponseCacheFrom(dic.Get)
+	if responseCache == nil {
+		return 0, false
+	}
+	if values, err := url.ParseQuery(queryParams); err == nil && values.Get(common.PushEvent) == common.ValueYes {
+		return 0, false
+	}
+	if control := cache.ControlFromContext(ctx); control != nil && control.MaxAgeSet {
+		return control.MaxAge, true
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dpc := bootstrapContainer.MetadataDeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		lc.Warn("nil MetadataDeviceProfileClient returned, the response is not cached")
+		return 0, false
+	}
+	profile, err := commandContainer.MetadataCacheFrom(dic.Get).DeviceProfileByName(context.Background(), dpc, device.ProfileName)
+	if err != nil {
+		lc.Warnf("fail to query device profile %s, the response is not cached, err: %v", device.ProfileName, err)
+		return 0, false
+	}
+	maxAge, maxAgeErr := responseCache.MaxAge(profile, commandName)
+	if maxAgeErr != nil {
+		lc.Warnf("the response of command %s of device %s is not cached, err: %v", commandName, device.Name, maxAgeErr)
+		return 0, false
+	}
+	return maxAge, true
+}
@@ -0,0 +1,185 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// validateDeviceSettings checks the settings of the set command against the profile of the device
+func validateDeviceSettings(ctx context.Context, device dtos.Device, commandName string, settings map[string]string, dic *di.Container) errors.EdgeX {
+	dpc := bootstrapContainer.MetadataDeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataDeviceProfileClient returned", nil)
+	}
+	profile, err := commandContainer.MetadataCacheFrom(dic.Get).DeviceProfileByName(ctx, dpc, device.ProfileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateSettings(profile, commandName, settings); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// validateSettings checks the settings of the set command against the device profile before the command is issued to
+// the device service: the command and each of its resources have to be writable, the settings can only be the
+// resources of the command, the resources without a default value are required, and each value has to parse as the
+// value type of its resource within the minimum and maximum.
+func validateSettings(profile dtos.DeviceProfile, commandName string, settings map[string]string) errors.EdgeX {
+	var readWrite string
+	var operations []dtos.ResourceOperation
+	if command, ok := deviceCommandByName(profile.DeviceCommands, commandName); ok {
+		readWrite = command.ReadWrite
+		operations = command.ResourceOperations
+	} else if resource, ok := deviceResourcesByName(profile.DeviceResources, commandName); ok {
+		readWrite = resource.Properties.ReadWrite
+		operations = []dtos.ResourceOperation{{DeviceResource: resource.Name}}
+	} else {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("command %s is not defined by device profile %s", commandName, profile.Name), nil)
+	}
+	if !strings.Contains(readWrite, common.ReadWrite_W) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command %s is not writable", commandName), nil)
+	}
+
+	parameters := make(map[string]bool, len(operations))
+	var edgeXerr errors.EdgeX
+	for _, ro := range operations {
+		resource, ok := deviceResourcesByName(profile.DeviceResources, ro.DeviceResource)
+		if !ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device command's resource %s doesn't match any device resource", ro.DeviceResource), nil)
+		}
+		parameters[resource.Name] = true
+		if !strings.Contains(resource.Properties.ReadWrite, common.ReadWrite_W) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("resource %s of command %s is not writable", resource.Name, commandName), nil)
+		}
+
+		value, ok := settings[resource.Name]
+		if !ok {
+			if ro.DefaultValue == "" && resource.Properties.DefaultValue == "" {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("resource %s is required to set command %s", resource.Name, commandName), nil)
+			}
+			continue
+		}
+		// the device service maps the value back to the raw value before it's written
+		for raw, mapped := range ro.Mappings {
+			if mapped == value {
+				value = raw
+				break
+			}
+		}
+		if edgeXerr = validateSettingValue(resource, value); edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
+	var unknown []string
+	for name := range settings {
+		if !parameters[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s are not resources of command %s", strings.Join(unknown, ", "), commandName), nil)
+	}
+	return nil
+}
+
+func deviceCommandByName(commands []dtos.DeviceCommand, name string) (dtos.DeviceCommand, bool) {
+	for _, command := range commands {
+		if command.Name == name {
+			return command, true
+		}
+	}
+	return dtos.DeviceCommand{}, false
+}
+
+// validateSettingValue checks that the value parses as the value type of the resource, an array is expected in JSON
+// and each of its elements is checked
+func validateSettingValue(resource dtos.DeviceResource, value string) errors.EdgeX {
+	valueType := resource.Properties.ValueType
+	elementType := strings.TrimSuffix(valueType, "Array")
+	if elementType == valueType {
+		return validateSettingElement(resource, elementType, value)
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &elements); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%s' of resource %s is not a %s", value, resource.Name, valueType), err)
+	}
+	for _, element := range elements {
+		s := string(element)
+		if elementType == common.ValueTypeString {
+			if err := json.Unmarshal(element, &s); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%s' of resource %s is not a %s", value, resource.Name, valueType), err)
+			}
+		}
+		if edgeXerr := validateSettingElement(resource, elementType, s); edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return nil
+}
+
+func validateSettingElement(resource dtos.DeviceResource, valueType string, value string) errors.EdgeX {
+	var number float64
+	var err error
+	switch valueType {
+	case common.ValueTypeBool:
+		_, err = strconv.ParseBool(value)
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64:
+		var u uint64
+		u, err = strconv.ParseUint(value, 10, bitSize(valueType))
+		number = float64(u)
+	case common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		var i int64
+		i, err = strconv.ParseInt(value, 10, bitSize(valueType))
+		number = float64(i)
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		number, err = strconv.ParseFloat(value, bitSize(valueType))
+		if err == nil && (math.IsNaN(number) || math.IsInf(number, 0)) {
+			err = fmt.Errorf("%s is not a finite number", value)
+		}
+	default:
+		// strings and binaries are passed to the device service as they are
+		return nil
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%s' of resource %s is not a %s", value, resource.Name, valueType), err)
+	}
+	if valueType == common.ValueTypeBool {
+		return nil
+	}
+
+	if minimum, err := strconv.ParseFloat(resource.Properties.Minimum, 64); err == nil && number < minimum {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%s' of resource %s is less than the minimum %s", value, resource.Name, resource.Properties.Minimum), nil)
+	}
+	if maximum, err := strconv.ParseFloat(resource.Properties.Maximum, 64); err == nil && number > maximum {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%s' of resource %s is greater than the maximum %s", value, resource.Name, resource.Properties.Maximum), nil)
+	}
+	return nil
+}
+
+// bitSize returns the size of the numeric value type, e.g. 16 of Int16
+func bitSize(valueType string) int {
+	size, _ := strconv.Atoi(strings.TrimLeft(valueType, "UIintFloat"))
+	return size
+}
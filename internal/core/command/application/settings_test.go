@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func settingsTestProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		Name: testProfileName,
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "setpoint", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Minimum: "10", Maximum: "35"}},
+			{Name: "fan", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_RW, Maximum: "3"}},
+			{Name: "enabled", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_W, DefaultValue: "true"}},
+			{Name: "schedule", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt16Array, ReadWrite: common.ReadWrite_RW, Minimum: "-10"}},
+			{Name: "labels", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeStringArray, ReadWrite: common.ReadWrite_RW}},
+			{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "climate", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "setpoint"},
+				{DeviceResource: "fan", Mappings: map[string]string{"1": "low", "3": "high"}},
+				{DeviceResource: "enabled"},
+			}},
+			{Name: "status", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "temperature"}}},
+			{Name: "mixed", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "temperature"}}},
+		},
+	}
+}
+
+func TestValidateSettings(t *testing.T) {
+	profile := settingsTestProfile()
+	tests := []struct {
+		name          string
+		command       string
+		settings      map[string]string
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid command", "climate", map[string]string{"setpoint": "21.5", "fan": "2", "enabled": "false"}, false, ""},
+		{"valid default value", "climate", map[string]string{"setpoint": "21.5", "fan": "2"}, false, ""},
+		{"valid mapped value", "climate", map[string]string{"setpoint": "21.5", "fan": "high"}, false, ""},
+		{"valid resource", "fan", map[string]string{"fan": "0"}, false, ""},
+		{"valid array", "schedule", map[string]string{"schedule": "[1, -2, 300]"}, false, ""},
+		{"valid string array", "labels", map[string]string{"labels": `["a", "b"]`}, false, ""},
+		{"unknown command", "unknown", map[string]string{"fan": "1"}, true, errors.KindEntityDoesNotExist},
+		{"read-only command", "status", map[string]string{"temperature": "20"}, true, errors.KindContractInvalid},
+		{"read-only resource", "temperature", map[string]string{"temperature": "20"}, true, errors.KindContractInvalid},
+		{"read-only resource of command", "mixed", map[string]string{"temperature": "20"}, true, errors.KindContractInvalid},
+		{"missing resource", "climate", map[string]string{"fan": "1"}, true, errors.KindContractInvalid},
+		{"unknown resource", "fan", map[string]string{"fan": "1", "mode": "cool"}, true, errors.KindContractInvalid},
+		{"not a number", "climate", map[string]string{"setpoint": "warm", "fan": "1"}, true, errors.KindContractInvalid},
+		{"not a bool", "climate", map[string]string{"setpoint": "20", "fan": "1", "enabled": "yes"}, true, errors.KindContractInvalid},
+		{"overflow", "fan", map[string]string{"fan": "256"}, true, errors.KindContractInvalid},
+		{"negative unsigned", "fan", map[string]string{"fan": "-1"}, true, errors.KindContractInvalid},
+		{"less than minimum", "climate", map[string]string{"setpoint": "9.9", "fan": "1"}, true, errors.KindContractInvalid},
+		{"greater than maximum", "fan", map[string]string{"fan": "4"}, true, errors.KindContractInvalid},
+		{"unmapped value", "climate", map[string]string{"setpoint": "20", "fan": "medium"}, true, errors.KindContractInvalid},
+		{"not an array", "schedule", map[string]string{"schedule": "1"}, true, errors.KindContractInvalid},
+		{"array element less than minimum", "schedule", map[string]string{"schedule": "[1, -11]"}, true, errors.KindContractInvalid},
+		{"array element of another type", "schedule", map[string]string{"schedule": "[1, 2.5]"}, true, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateSettings(profile, testCase.command, testCase.settings)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -20,20 +20,146 @@ import (
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable    WritableInfo
-	Clients     map[string]bootstrapConfig.ClientInfo
-	Databases   map[string]bootstrapConfig.Database
-	Registry    bootstrapConfig.RegistryInfo
-	Service     bootstrapConfig.ServiceInfo
-	SecretStore bootstrapConfig.SecretStoreInfo
+	Writable       WritableInfo
+	Clients        map[string]bootstrapConfig.ClientInfo
+	Databases      map[string]bootstrapConfig.Database
+	Registry       bootstrapConfig.RegistryInfo
+	Service        bootstrapConfig.ServiceInfo
+	SecretStore    bootstrapConfig.SecretStoreInfo
+	MetadataCache  MetadataCacheInfo
+	ResponseCache  ResponseCacheInfo
+	CommandJob     CommandJobInfo
+	CommandHistory CommandHistoryInfo
+	Recipe         RecipeInfo
+	MessageQueue   bootstrapConfig.MessageBusInfo
+	MessageCommand MessageCommandInfo
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
 type WritableInfo struct {
 	LogLevel        string
+	BatchCommand    BatchCommandInfo
+	CommandAccess   CommandAccessInfo
 	InsecureSecrets bootstrapConfig.InsecureSecrets
 }
 
+// BatchCommandInfo provides the limits of issuing a command to many devices in one request
+type BatchCommandInfo struct {
+	// MaxDevices is the maximum number of devices a batch can select
+	MaxDevices int
+	// MaxParallelism is the maximum number of commands of a batch which are issued at the same time
+	MaxParallelism int
+	// Timeout is the time to wait for the whole batch, the devices which have not responded by then are reported
+	// as failed
+	Timeout string
+}
+
+// CommandAccessInfo provides the policies which tell the callers allowed to issue the commands. The caller is the
+// consumer authenticated by a trusted API gateway, or the subject of the JWT bearer token of the request whose signature
+// is verified, and it's anonymous if the request carries no identity. A request carrying an identity which can't be
+// verified is rejected.
+type CommandAccessInfo struct {
+	// Enabled enforces the policies, any caller may issue any command if it is false
+	Enabled bool
+	// DefaultEffect is the effect, allow or deny, of the commands which match no policy
+	DefaultEffect string
+	// TrustedGateways are the addresses, as IPs or CIDRs, of the API gateways whose consumer headers are trusted
+	TrustedGateways []string
+	// JWTKeyFile is the file of the public keys verifying the signature of the JWT bearer tokens, either as PEM blocks
+	// or as a JWKS document. The bearer tokens are rejected if it is empty.
+	JWTKeyFile string
+	// Policies are the access policies by name, a command denied by any matching policy is denied even if another
+	// one allows it
+	Policies map[string]CommandAccessPolicy
+}
+
+// CommandAccessPolicy allows or denies the callers to issue the commands to the devices, an empty list matches
+// anything and "*" matches any value
+type CommandAccessPolicy struct {
+	// Identities are the callers the policy applies to
+	Identities []string
+	// Operations are GET for reading and SET for writing the commands
+	Operations []string
+	// Devices are the names of the devices the policy applies to
+	Devices []string
+	// Labels select the devices the policy applies to by label, along with the Devices
+	Labels []string
+	// Commands are the names of the commands the policy applies to
+	Commands []string
+	// Effect is allow or deny, any other value denies
+	Effect string
+}
+
+// MetadataCacheInfo provides the settings of caching the devices, device services and device profiles queried from
+// core-metadata. The cached entities are invalidated by the change notifications of core-metadata, which are delivered
+// by subscribing the metadatacache/invalidate endpoint to the metadata-change category of support-notifications.
+type MetadataCacheInfo struct {
+	// TTL is how long an entity is kept in the cache, the cache is disabled if it is empty
+	TTL string
+}
+
+// ResponseCacheInfo provides the settings of serving the responses of the GET commands from a cache. How long a
+// response is served is declared by the cacheMaxAge attribute of the device resources, or requested by the
+// max-age directive of the Cache-Control header.
+type ResponseCacheInfo struct {
+	// MaxEntries is how many responses are kept, the cache is disabled if it is zero
+	MaxEntries int
+	// DefaultMaxAge is the max age of the resources without the cacheMaxAge attribute, their responses are only cached
+	// on request if it is empty
+	DefaultMaxAge string
+}
+
+// CommandJobInfo provides the settings of executing the commands issued asynchronously, which are tracked as command
+// jobs in the database
+type CommandJobInfo struct {
+	// MaxConcurrent is the maximum number of jobs which are running at the same time, the others wait in the pending
+	// status
+	MaxConcurrent int
+	// MaxPending is the maximum number of jobs which wait for a running slot, the jobs submitted beyond it are failed
+	// at once. It defaults to 100 if it is not positive.
+	MaxPending int
+	// Timeout is how long a job may wait and run before it's failed, the jobs never time out if it is empty
+	Timeout string
+	// CallbackTimeout is the time to wait for the callback URL of a job to accept the completed job
+	CallbackTimeout string
+	// MaxAge is how long the completed jobs are kept, e.g. "168h", the jobs are kept forever if it is empty
+	MaxAge string
+	// PurgeInterval is how often the completed jobs older than MaxAge are deleted
+	PurgeInterval string
+}
+
+// CommandHistoryInfo provides the settings of recording the commands issued to the devices, along with their callers
+// and outcomes
+type CommandHistoryInfo struct {
+	// Enabled turns on the recording
+	Enabled bool
+	// MaxAge is how long the records are kept, e.g. "720h", the records are kept forever if it is empty
+	MaxAge string
+	// PurgeInterval is how often the records older than MaxAge are deleted
+	PurgeInterval string
+}
+
+// RecipeInfo provides the settings of running the recipes, the sequences of commands which are issued on demand
+type RecipeInfo struct {
+	// RunMaxAge is how long the logs of the completed runs are kept, e.g. "168h", the logs are kept forever if it is
+	// empty
+	RunMaxAge string
+	// PurgeInterval is how often the logs of the runs older than RunMaxAge are deleted
+	PurgeInterval string
+}
+
+// MessageCommandInfo provides the settings of issuing the commands received from the message bus, which is subscribed
+// when MessageQueue.SubscribeEnabled is true. The commands are requested on the MessageQueue.SubscribeTopic followed by
+// /<device-name>/<command-name>/<get|set>, and replied on the MessageQueue.PublishTopicPrefix followed by the same levels.
+type MessageCommandInfo struct {
+	// Identity is the caller of the commands received from the message bus which the command access policies apply
+	// to, the caller is anonymous if it is empty
+	Identity string
+	// MaxConcurrent is the number of commands received from the message bus which are issued at the same time, the
+	// other requests wait on the message bus. It defaults to 16 if not positive.
+	MaxConcurrent int
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// MetadataCacheName contains the name of the cache.MetadataCache instance in the DIC.
+var MetadataCacheName = di.TypeInstanceToName((*cache.MetadataCache)(nil))
+
+// MetadataCacheFrom helper function queries the DIC and returns the cache.MetadataCache instance, nil is returned if
+// the cache is not created, which queries core-metadata each time.
+func MetadataCacheFrom(get di.Get) *cache.MetadataCache {
+	c, ok := get(MetadataCacheName).(*cache.MetadataCache)
+	if !ok {
+		return nil
+	}
+	return c
+}
+
+// ResponseCacheName contains the name of the cache.ResponseCache instance in the DIC.
+var ResponseCacheName = di.TypeInstanceToName((*cache.ResponseCache)(nil))
+
+// ResponseCacheFrom helper function queries the DIC and returns the cache.ResponseCache instance, nil is returned if
+// the cache is not created, which reads the device for each GET command.
+func ResponseCacheFrom(get di.Get) *cache.ResponseCache {
+	c, ok := get(ResponseCacheName).(*cache.ResponseCache)
+	if !ok {
+		return nil
+	}
+	return c
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// JobRunnerName contains the name of the interfaces.JobRunner implementation in the DIC.
+var JobRunnerName = di.TypeInstanceToName((*interfaces.JobRunner)(nil))
+
+// JobRunnerFrom helper function queries the DIC and returns the interfaces.JobRunner implementation.
+func JobRunnerFrom(get di.Get) interfaces.JobRunner {
+	return get(JobRunnerName).(interfaces.JobRunner)
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// RecipeExecutorName contains the name of the interfaces.RecipeExecutor implementation in the DIC.
+var RecipeExecutorName = di.TypeInstanceToName((*interfaces.RecipeExecutor)(nil))
+
+// RecipeExecutorFrom helper function queries the DIC and returns the interfaces.RecipeExecutor implementation.
+func RecipeExecutorFrom(get di.Get) interfaces.RecipeExecutor {
+	return get(RecipeExecutorName).(interfaces.RecipeExecutor)
+}
@@ -6,13 +6,20 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
@@ -89,6 +96,52 @@ func validateGetCommandParameters(r *http.Request) (err errors.EdgeX) {
 	return nil
 }
 
+// parseAsyncParameters parses the query parameters of issuing the command as a job, which are removed from the query
+// parameters passed to the device service
+func parseAsyncParameters(r *http.Request) (async bool, callbackUrl string, queryParams string, err errors.EdgeX) {
+	query := r.URL.Query()
+	_, hasAsync := query[pkgCommon.Async]
+	_, hasCallbackUrl := query[pkgCommon.CallbackUrl]
+	if !hasAsync && !hasCallbackUrl {
+		return false, "", r.URL.RawQuery, nil
+	}
+
+	if hasAsync {
+		var parseErr error
+		async, parseErr = strconv.ParseBool(query.Get(pkgCommon.Async))
+		if parseErr != nil {
+			return false, "", "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be true or false", pkgCommon.Async), parseErr)
+		}
+	}
+	callbackUrl = query.Get(pkgCommon.CallbackUrl)
+	if callbackUrl != "" && !async {
+		return false, "", "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("query parameter %s is only accepted along with %s=true", pkgCommon.CallbackUrl, pkgCommon.Async), nil)
+	}
+	query.Del(pkgCommon.Async)
+	query.Del(pkgCommon.CallbackUrl)
+	return async, callbackUrl, query.Encode(), nil
+}
+
+// issueCommandAsync issues the command as a job and responds with the pending job
+func (cc *CommandController) issueCommandAsync(w http.ResponseWriter, r *http.Request, method string, queryParams string,
+	settings map[string]string, callbackUrl string) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	job, err := application.IssueCommandAsync(ctx, vars[common.Name], vars[common.Command], method, queryParams, settings, callbackUrl, cc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewCommandJobResponse("", "", http.StatusAccepted, pkgDtos.FromCommandJobModelToDTO(job))
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.Encode(response, w, lc)
+}
+
+// IssueGetCommandByName issues the get command to the device and responds with the event read, the command is issued as
+// a job in the background if the async query parameter is true
 func (cc *CommandController) IssueGetCommandByName(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(cc.dic.Get)
 	ctx := r.Context()
@@ -99,19 +152,31 @@ func (cc *CommandController) IssueGetCommandByName(w http.ResponseWriter, r *htt
 	commandName := vars[common.Command]
 
 	// Query params
-	queryParams := r.URL.RawQuery
-	err := validateGetCommandParameters(r)
+	async, callbackUrl, queryParams, err := parseAsyncParameters(r)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	err = validateGetCommandParameters(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	if async {
+		cc.issueCommandAsync(w, r, http.MethodGet, queryParams, nil, callbackUrl)
+		return
+	}
 
-	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, cc.dic)
+	control := cache.ParseControl(r.Header.Get(cache.CacheControlHeader))
+	response, err := application.IssueGetCommandByName(cache.WithControl(r.Context(), control), deviceName, commandName, queryParams, cc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
 
+	if control.Hit {
+		w.Header().Set(cache.AgeHeader, strconv.FormatInt(int64(control.Age.Seconds()), 10))
+	}
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	// encode and send out the response
 	// If dsReturnEvent is no, there will be no content returned in the http response
@@ -120,6 +185,28 @@ func (cc *CommandController) IssueGetCommandByName(w http.ResponseWriter, r *htt
 	}
 }
 
+// CatalogueByDeviceName returns the OpenAPI document of the commands of the device. The document is returned as it is,
+// without the envelope of the other responses, so that it can be fed to the OpenAPI tools.
+func (cc *CommandController) CatalogueByDeviceName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	document, err := application.CatalogueByDeviceName(name, cc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(document, w, lc)
+}
+
+// IssueSetCommandByName issues the set command to the device with the settings of the request body, the command is
+// issued as a job in the background if the async query parameter is true
 func (cc *CommandController) IssueSetCommandByName(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(cc.dic.Get)
 	ctx := r.Context()
@@ -130,7 +217,11 @@ func (cc *CommandController) IssueSetCommandByName(w http.ResponseWriter, r *htt
 	commandName := vars[common.Command]
 
 	// Query params
-	queryParams := r.URL.RawQuery
+	async, callbackUrl, queryParams, err := parseAsyncParameters(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	// Request body
 	settings, err := utils.ParseBodyToMap(r)
@@ -138,7 +229,11 @@ func (cc *CommandController) IssueSetCommandByName(w http.ResponseWriter, r *htt
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-	response, err := application.IssueSetCommandByName(deviceName, commandName, queryParams, settings, cc.dic)
+	if async {
+		cc.issueCommandAsync(w, r, http.MethodPut, queryParams, settings, callbackUrl)
+		return
+	}
+	response, err := application.IssueSetCommandByName(r.Context(), deviceName, commandName, queryParams, settings, cc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -148,3 +243,45 @@ func (cc *CommandController) IssueSetCommandByName(w http.ResponseWriter, r *htt
 	// encode and send out the response
 	pkg.Encode(response, w, lc)
 }
+
+// IssueBatchCommand issues the command to the devices selected by the request body, the query parameters are passed to
+// each device service as they are for a single device. The result of each device is returned along with its status
+// code, so the response is a multi-status one.
+func (cc *CommandController) IssueBatchCommand(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	// Query params
+	queryParams := r.URL.RawQuery
+
+	var req pkgRequests.BatchCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		edgeXerr, ok := err.(errors.EdgeX)
+		if !ok {
+			edgeXerr = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse request body", err)
+		}
+		utils.WriteErrorResponse(w, ctx, lc, edgeXerr, "")
+		return
+	}
+	if req.Method == http.MethodGet {
+		if err := validateGetCommandParameters(r); err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+			return
+		}
+	}
+
+	results, err := application.IssueBatchCommand(r.Context(), req, queryParams, cc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+		return
+	}
+
+	response := pkgResponses.NewBatchCommandResponse(req.RequestId, "", http.StatusMultiStatus, results)
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	// encode and send out the response
+	pkg.Encode(response, w, lc)
+}
@@ -14,8 +14,14 @@ import (
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/access"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/openapi"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -32,6 +38,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -56,6 +63,9 @@ func NewMockDIC() *di.Container {
 	return di.NewContainer(di.ServiceConstructorMap{
 		commandContainer.ConfigurationName: func(get di.Get) interface{} {
 			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					BatchCommand: config.BatchCommandInfo{MaxDevices: 10, MaxParallelism: 2, Timeout: "5s"},
+				},
 				Service: bootstrapConfig.ServiceInfo{
 					Host:           mockHost,
 					Port:           mockPort,
@@ -145,6 +155,23 @@ func buildDeviceProfileResponse() responseDTO.DeviceProfileResponse {
 	return deviceResponse
 }
 
+// buildSetCommandProfileResponse returns the profile whose command writes the resources of buildTestSettings
+func buildSetCommandProfileResponse() responseDTO.DeviceProfileResponse {
+	profile := dtos.DeviceProfile{
+		Name: testProfileName,
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "AHU-TargetTemperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Minimum: "10", Maximum: "35"}},
+			{Name: "AHU-TargetBand", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: testCommandName, ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "AHU-TargetTemperature"}, {DeviceResource: "AHU-TargetBand"},
+			}},
+		},
+	}
+	return responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, profile)
+}
+
 func buildDeviceServiceResponse() responseDTO.DeviceServiceResponse {
 	service := dtos.DeviceService{
 		Name:        testDeviceServiceName,
@@ -323,6 +350,66 @@ func TestCommandsByDeviceName(t *testing.T) {
 	}
 }
 
+func TestCatalogueByDeviceName(t *testing.T) {
+	var nonExistDeviceName = "nonExistDevice"
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	dcMock.On("DeviceByName", context.Background(), nonExistDeviceName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(buildSetCommandProfileResponse(), nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+	})
+	cc := NewCommandController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+	}{
+		{"Valid - catalogue of device", testDeviceName, http.StatusOK},
+		{"Invalid - empty device name", "", http.StatusBadRequest},
+		{"Invalid - device not found", nonExistDeviceName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiCatalogueByDeviceNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(cc.CatalogueByDeviceName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var document openapi.Document
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+			assert.Equal(t, openapi.Version, document.OpenAPI)
+			assert.Len(t, document.Paths, 3)
+			item := document.Paths[common.ApiDeviceRoute+"/"+common.Name+"/"+testDeviceName+"/"+testCommandName]
+			assert.NotNil(t, item.Get)
+			assert.NotNil(t, item.Put)
+			assert.Contains(t, document.Components.Schemas, testProfileName+"_"+testCommandName)
+		})
+	}
+}
+
 func TestIssueGetCommand(t *testing.T) {
 	var nonExistName = "nonExist"
 
@@ -408,6 +495,89 @@ func TestIssueGetCommand(t *testing.T) {
 	}
 }
 
+func TestIssueGetCommandCache(t *testing.T) {
+	eventResponse := buildEventResponse()
+	profileResponse := buildSetCommandProfileResponse()
+	profileResponse.Profile.DeviceResources[0].Attributes = map[string]interface{}{cache.MaxAgeAttribute: "1m"}
+	profileResponse.Profile.DeviceResources[1].Attributes = map[string]interface{}{cache.MaxAgeAttribute: "30s"}
+	settings := buildTestSettings()
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(profileResponse, nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, testDeviceName, testCommandName, "").Return(&eventResponse, nil)
+	dsccMock.On("SetCommand", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", settings).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+		commandContainer.ResponseCacheName: func(get di.Get) interface{} {
+			return cache.NewResponseCache(10, 0)
+		},
+	})
+	cc := NewCommandController(dic)
+
+	issueGet := func(cacheControl string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, common.ApiDeviceNameCommandNameRoute, http.NoBody)
+		require.NoError(t, err)
+		if cacheControl != "" {
+			req.Header.Set(cache.CacheControlHeader, cacheControl)
+		}
+		req = mux.SetURLVars(req, map[string]string{common.Name: testDeviceName, common.Command: testCommandName})
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(cc.IssueGetCommandByName).ServeHTTP(recorder, req)
+		require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+		var res responseDTO.EventResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+		assert.Equal(t, eventResponse.Event.Id, res.Event.Id)
+		return recorder
+	}
+
+	recorder := issueGet("")
+	assert.Empty(t, recorder.Header().Get(cache.AgeHeader))
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 1)
+
+	// the response is served from the cache within the shortest max age of the resources
+	recorder = issueGet("")
+	assert.Equal(t, "0", recorder.Header().Get(cache.AgeHeader))
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 1)
+
+	issueGet("no-cache")
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 2)
+	issueGet("max-age=0")
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 3)
+
+	// the set command invalidates the responses of the device, max-age=0 has cached nothing
+	issueGet("")
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 3)
+	jsonData, err := json.Marshal(settings)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, common.ApiDeviceNameCommandNameRoute, bytes.NewReader(jsonData))
+	require.NoError(t, err)
+	req = mux.SetURLVars(req, map[string]string{common.Name: testDeviceName, common.Command: testCommandName})
+	setRecorder := httptest.NewRecorder()
+	http.HandlerFunc(cc.IssueSetCommandByName).ServeHTTP(setRecorder, req)
+	require.Equal(t, http.StatusOK, setRecorder.Result().StatusCode)
+	issueGet("")
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 4)
+}
+
 func TestIssueSetCommand(t *testing.T) {
 	var nonExistName = "nonExist"
 
@@ -422,6 +592,9 @@ func TestIssueSetCommand(t *testing.T) {
 	dscMock := &mocks.DeviceServiceClient{}
 	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(expectedDeviceServiceResponse, nil)
 
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(buildSetCommandProfileResponse(), nil)
+
 	testSettings := buildTestSettings()
 	testSettingsJsonStr, _ := json.Marshal(testSettings)
 	dsccMock := &mocks.DeviceServiceCommandClient{}
@@ -438,6 +611,9 @@ func TestIssueSetCommand(t *testing.T) {
 		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} { // add v2 API MetadataDeviceProfileClient
 			return dscMock
 		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
 		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} { // add v2 API DeviceServiceCommandClient
 			return dsccMock
 		},
@@ -457,10 +633,14 @@ func TestIssueSetCommand(t *testing.T) {
 		{"Valid - execute set command with valid deviceName, commandName, query strings, and settings", testDeviceName, testCommandName, testQueryStrings, testSettingsJsonStr, false, http.StatusOK},
 		{"Valid - empty query strings", testDeviceName, testCommandName, "", testSettingsJsonStr, false, http.StatusOK},
 		{"Invalid - execute set command with invalid deviceName", nonExistName, testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusNotFound},
-		{"Invalid - execute set command with invalid commandName", testDeviceName, nonExistName, testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
+		{"Invalid - execute set command with invalid commandName", testDeviceName, nonExistName, testQueryStrings, testSettingsJsonStr, true, http.StatusNotFound},
 		{"Invalid - empty device name", "", testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
 		{"Invalid - empty command name", testDeviceName, "", testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
 		{"Invalid - empty settings", testDeviceName, testCommandName, testQueryStrings, []byte{}, true, http.StatusInternalServerError},
+		{"Invalid - missing resource", testDeviceName, testCommandName, testQueryStrings, []byte(`{"AHU-TargetTemperature":"28.5"}`), true, http.StatusBadRequest},
+		{"Invalid - unknown resource", testDeviceName, testCommandName, testQueryStrings, []byte(`{"AHU-TargetTemperature":"28.5","AHU-TargetBand":"4.0","AHU-Mode":"cool"}`), true, http.StatusBadRequest},
+		{"Invalid - value of another type", testDeviceName, testCommandName, testQueryStrings, []byte(`{"AHU-TargetTemperature":"warm","AHU-TargetBand":"4.0"}`), true, http.StatusBadRequest},
+		{"Invalid - value out of range", testDeviceName, testCommandName, testQueryStrings, []byte(`{"AHU-TargetTemperature":"40","AHU-TargetBand":"4.0"}`), true, http.StatusBadRequest},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -489,3 +669,169 @@ func TestIssueSetCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueCommandAccess(t *testing.T) {
+	expectedEventResponse := buildEventResponse()
+	expectedBaseResponse := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	testSettings := buildTestSettings()
+	testSettingsJsonStr, _ := json.Marshal(testSettings)
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(buildSetCommandProfileResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, testDeviceName, testCommandName, "").Return(&expectedEventResponse, nil)
+	dsccMock.On("SetCommand", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", testSettings).Return(expectedBaseResponse, nil)
+
+	dic := NewMockDIC()
+	configuration := commandContainer.ConfigurationFrom(dic.Get)
+	configuration.Writable.CommandAccess = config.CommandAccessInfo{
+		Enabled:       true,
+		DefaultEffect: access.EffectDeny,
+		Policies: map[string]config.CommandAccessPolicy{
+			"anyone-read":    {Identities: []string{access.Wildcard}, Operations: []string{access.OperationGet}, Effect: access.EffectAllow},
+			"operator-write": {Identities: []string{"operator"}, Operations: []string{access.OperationSet}, Effect: access.EffectAllow},
+		},
+	}
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+
+	tests := []struct {
+		name               string
+		method             string
+		username           string
+		expectedStatusCode int
+	}{
+		{"Valid - anonymous read", http.MethodGet, "", http.StatusOK},
+		{"Valid - operator write", http.MethodPut, "operator", http.StatusOK},
+		{"Invalid - anonymous write", http.MethodPut, "", http.StatusForbidden},
+		{"Invalid - viewer write", http.MethodPut, "viewer", http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, common.ApiDeviceNameCommandNameRoute, bytes.NewBuffer(testSettingsJsonStr))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testDeviceName, common.Command: testCommandName})
+			if testCase.username != "" {
+				req.Header.Set(audit.ConsumerUsernameHeader, testCase.username)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(cc.IssueGetCommandByName)
+			if testCase.method == http.MethodPut {
+				handler = cc.IssueSetCommandByName
+			}
+			audit.ManageActor(handler).ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusForbidden {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusForbidden, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+	dsccMock.AssertNumberOfCalls(t, "SetCommand", 1)
+}
+
+func TestIssueBatchCommand(t *testing.T) {
+	var nonExistName = "nonExist"
+
+	expectedEventResponse := buildEventResponse()
+	expectedDeviceResponse := buildDeviceResponse()
+	expectedDeviceServiceResponse := buildDeviceServiceResponse()
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(expectedDeviceResponse, nil)
+	dcMock.On("DeviceByName", mock.Anything, nonExistName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(expectedDeviceServiceResponse, nil)
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, testQueryStrings).Return(&expectedEventResponse, nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+	assert.NotNil(t, cc)
+
+	valid := `{"apiVersion":"v2","deviceNames":["` + testDeviceName + `","` + nonExistName + `"],"command":"` + testCommandName + `","method":"GET"}`
+	tests := []struct {
+		name               string
+		body               string
+		queryStrings       string
+		expectedStatusCode int
+	}{
+		{"Valid - devices selected by name", valid, testQueryStrings, http.StatusMultiStatus},
+		{"Invalid - no device selected", `{"apiVersion":"v2","command":"` + testCommandName + `","method":"GET"}`, "", http.StatusBadRequest},
+		{"Invalid - both names and labels", `{"apiVersion":"v2","deviceNames":["a"],"labels":["b"],"command":"` + testCommandName + `","method":"GET"}`, "", http.StatusBadRequest},
+		{"Invalid - unknown method", `{"apiVersion":"v2","deviceNames":["a"],"command":"` + testCommandName + `","method":"POST"}`, "", http.StatusBadRequest},
+		{"Invalid - no settings to write", `{"apiVersion":"v2","deviceNames":["a"],"command":"` + testCommandName + `","method":"PUT"}`, "", http.StatusBadRequest},
+		{"Invalid - invalid ds-pushevent paramter", valid, "ds-pushevent=123", http.StatusBadRequest},
+		{"Invalid - malformed body", `{"deviceNames":`, "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceBatchCommandRoute, bytes.NewBufferString(testCase.body))
+			req.URL.RawQuery = testCase.queryStrings
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(cc.IssueBatchCommand)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.BatchCommandResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusMultiStatus {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			require.Len(t, res.Results, 2)
+			assert.Equal(t, testDeviceName, res.Results[0].DeviceName)
+			assert.Equal(t, http.StatusOK, res.Results[0].StatusCode)
+			assert.NotNil(t, res.Results[0].Event)
+			assert.Equal(t, nonExistName, res.Results[1].DeviceName)
+			assert.Equal(t, http.StatusNotFound, res.Results[1].StatusCode)
+		})
+	}
+}
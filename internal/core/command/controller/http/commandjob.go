@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+type CommandJobController struct {
+	dic *di.Container
+}
+
+// NewCommandJobController creates and initializes an CommandJobController
+func NewCommandJobController(dic *di.Container) *CommandJobController {
+	return &CommandJobController{
+		dic: dic,
+	}
+}
+
+func (jc *CommandJobController) AllCommandJobs(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(jc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	jobs, err := application.AllCommandJobs(offset, limit, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiCommandJobsResponse("", "", http.StatusOK, jobs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (jc *CommandJobController) CommandJobsByStatus(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(jc.dic.Get)
+
+	vars := mux.Vars(r)
+	status := vars[common.Status]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	jobs, err := application.CommandJobsByStatus(offset, limit, status, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiCommandJobsResponse("", "", http.StatusOK, jobs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (jc *CommandJobController) CommandJobById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	job, err := application.CommandJobById(id, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewCommandJobResponse("", "", http.StatusOK, job)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// CancelCommandJobById cancels the pending or running job, the job is cancelled once its command is stopped so the
+// request is only accepted
+func (jc *CommandJobController) CancelCommandJobById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	err := application.CancelCommandJob(id, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusAccepted)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.Encode(response, w, lc)
+}
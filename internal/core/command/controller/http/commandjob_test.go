@@ -0,0 +1,311 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testJobId           = "0a7d3bf6-2a4c-45e7-8e3f-0fd6a1d4c7f5"
+	testCallbackUrl     = "http://localhost:8080/jobs"
+	nonExistJobId       = "7d8e5b0b-5d47-4a2e-8c1d-1f1f2c8e9b3a"
+	completedJobId      = "b2f6c1e5-9c3b-4f0a-a7f2-3d5c6e7f8a9b"
+	testJobQueryStrings = "a=1&ds-pushevent=no"
+)
+
+func TestIssueCommandAsync(t *testing.T) {
+	var nonExistName = "nonExist"
+	settings := buildTestSettings()
+	settingsJson, err := json.Marshal(settings)
+	require.NoError(t, err)
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(buildDeviceResponse(), nil)
+	dcMock.On("DeviceByName", mock.Anything, nonExistName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).Return(buildSetCommandProfileResponse(), nil)
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddCommandJob", mock.Anything).Return(func(job models.CommandJob) models.CommandJob {
+		job.Id = testJobId
+		return job
+	}, nil)
+	runnerMock := &dbMock.JobRunner{}
+	runnerMock.On("Submit", mock.Anything).Return(nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.JobRunnerName: func(get di.Get) interface{} {
+			return runnerMock
+		},
+	})
+	cc := NewCommandController(dic)
+
+	tests := []struct {
+		name                string
+		method              string
+		deviceName          string
+		queryStrings        string
+		expectedStatusCode  int
+		expectedQueryParams string
+		expectedCallbackUrl string
+	}{
+		{"Valid - read asynchronously", http.MethodGet, testDeviceName, "async=true&" + testJobQueryStrings, http.StatusAccepted, testJobQueryStrings, ""},
+		{"Valid - read asynchronously with callback", http.MethodGet, testDeviceName, "async=true&callbackUrl=" + testCallbackUrl, http.StatusAccepted, "", testCallbackUrl},
+		{"Valid - write asynchronously with callback", http.MethodPut, testDeviceName, "callbackUrl=" + testCallbackUrl + "&async=true&a=1", http.StatusAccepted, "a=1", testCallbackUrl},
+		{"Invalid - non-boolean async", http.MethodGet, testDeviceName, "async=yes", http.StatusBadRequest, "", ""},
+		{"Invalid - callback without async", http.MethodPut, testDeviceName, "callbackUrl=" + testCallbackUrl, http.StatusBadRequest, "", ""},
+		{"Invalid - relative callback URL", http.MethodGet, testDeviceName, "async=true&callbackUrl=/jobs", http.StatusBadRequest, "", ""},
+		{"Invalid - unsupported callback scheme", http.MethodPut, testDeviceName, "async=true&callbackUrl=ftp://localhost/jobs", http.StatusBadRequest, "", ""},
+		{"Invalid - device not found", http.MethodGet, nonExistName, "async=true", http.StatusNotFound, "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var req *http.Request
+			var err error
+			handler := http.HandlerFunc(cc.IssueGetCommandByName)
+			if testCase.method == http.MethodPut {
+				req, err = http.NewRequest(http.MethodPut, common.ApiDeviceNameCommandNameRoute, bytes.NewReader(settingsJson))
+				handler = cc.IssueSetCommandByName
+			} else {
+				req, err = http.NewRequest(http.MethodGet, common.ApiDeviceNameCommandNameRoute, http.NoBody)
+			}
+			require.NoError(t, err)
+			req.URL.RawQuery = testCase.queryStrings
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceName, common.Command: testCommandName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.CommandJobResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusAccepted {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, testJobId, res.Job.Id)
+			assert.Equal(t, models.JobPending, res.Job.Status)
+			assert.Equal(t, testCase.method, res.Job.Method)
+			assert.Equal(t, testCase.expectedQueryParams, res.Job.QueryParams, "async parameters should not be passed to the device service")
+			assert.Equal(t, testCase.expectedCallbackUrl, res.Job.CallbackUrl)
+			if testCase.method == http.MethodPut {
+				assert.Equal(t, settings, res.Job.Settings)
+			}
+			runnerMock.AssertCalled(t, "Submit", mock.MatchedBy(func(job models.CommandJob) bool { return job.Id == testJobId }))
+		})
+	}
+}
+
+func TestAllCommandJobs(t *testing.T) {
+	jobs := []models.CommandJob{
+		{Id: testJobId, DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, Status: models.JobRunning},
+		{Id: completedJobId, DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut, Status: models.JobSucceeded},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllCommandJobs", 0, 20).Return(jobs, nil)
+	dbClientMock.On("AllCommandJobs", 0, 1).Return(jobs[:1], nil)
+	dbClientMock.On("CommandJobsByStatus", 0, 20, models.JobSucceeded).Return(jobs[1:], nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	jc := NewCommandJobController(dic)
+
+	tests := []struct {
+		name               string
+		status             string
+		limit              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - all jobs", "", "", 2, http.StatusOK},
+		{"Valid - limit", "", "1", 1, http.StatusOK},
+		{"Valid - by status", models.JobSucceeded, "", 1, http.StatusOK},
+		{"Invalid - unknown status", "DONE", "", 0, http.StatusBadRequest},
+		{"Invalid - limit", "", "abc", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiAllCommandJobRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+			handler := http.HandlerFunc(jc.AllCommandJobs)
+			if testCase.status != "" {
+				req = mux.SetURLVars(req, map[string]string{common.Status: testCase.status})
+				handler = jc.CommandJobsByStatus
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.MultiCommandJobsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Len(t, res.Jobs, testCase.expectedCount)
+		})
+	}
+}
+
+func TestCommandJobById(t *testing.T) {
+	job := models.CommandJob{Id: testJobId, DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet,
+		Status: models.JobSucceeded, StatusCode: http.StatusOK, Event: []byte(`{"id":"e1"}`)}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandJobById", testJobId).Return(job, nil)
+	dbClientMock.On("CommandJobById", nonExistJobId).Return(models.CommandJob{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "command job doesn't exist", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	jc := NewCommandJobController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - job found", testJobId, http.StatusOK},
+		{"Invalid - job not found", nonExistJobId, http.StatusNotFound},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiCommandJobByIdRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(jc.CommandJobById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.CommandJobResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testJobId, res.Job.Id)
+				assert.JSONEq(t, `{"id":"e1"}`, string(res.Job.Event))
+			}
+		})
+	}
+}
+
+func TestCancelCommandJobById(t *testing.T) {
+	staleJobId := "e4d1c7a2-6f3b-4c8e-9a5d-2b7f0e1c3d4a"
+	finishingJobId := "7a3c9e1f-2b4d-4e6a-8c0f-5d7b9a1e3c2f"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandJobById", testJobId).Return(models.CommandJob{Id: testJobId, Status: models.JobRunning}, nil)
+	dbClientMock.On("CommandJobById", staleJobId).Return(models.CommandJob{Id: staleJobId, Status: models.JobPending}, nil)
+	dbClientMock.On("CommandJobById", finishingJobId).Return(models.CommandJob{Id: finishingJobId, Status: models.JobRunning}, nil)
+	dbClientMock.On("CommandJobById", completedJobId).Return(models.CommandJob{Id: completedJobId, Status: models.JobSucceeded}, nil)
+	dbClientMock.On("CommandJobById", nonExistJobId).Return(models.CommandJob{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "command job doesn't exist", nil))
+	dbClientMock.On("CancelCommandJob", mock.MatchedBy(func(job models.CommandJob) bool {
+		return job.Id == staleJobId && job.Status == models.JobCancelled
+	})).Return(nil)
+	// the job is completed by the runner between the query and the cancellation
+	dbClientMock.On("CancelCommandJob", mock.MatchedBy(func(job models.CommandJob) bool {
+		return job.Id == finishingJobId
+	})).Return(errors.NewCommonEdgeX(errors.KindStatusConflict, "command job is SUCCEEDED already", nil))
+	runnerMock := &dbMock.JobRunner{}
+	runnerMock.On("Cancel", testJobId).Return(true)
+	runnerMock.On("Cancel", staleJobId).Return(false)
+	runnerMock.On("Cancel", finishingJobId).Return(false)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.JobRunnerName: func(get di.Get) interface{} {
+			return runnerMock
+		},
+	})
+	jc := NewCommandJobController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - running job", testJobId, http.StatusAccepted},
+		{"Valid - job no longer in the runner", staleJobId, http.StatusAccepted},
+		{"Invalid - completed job", completedJobId, http.StatusConflict},
+		{"Invalid - job completed concurrently", finishingJobId, http.StatusConflict},
+		{"Invalid - job not found", nonExistJobId, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiCommandJobCancelByIdRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(jc.CancelCommandJobById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "UpdateCommandJob", mock.Anything)
+}
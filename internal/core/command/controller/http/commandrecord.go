@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/gorilla/mux"
+)
+
+type CommandRecordController struct {
+	dic *di.Container
+}
+
+// NewCommandRecordController creates and initializes an CommandRecordController
+func NewCommandRecordController(dic *di.Container) *CommandRecordController {
+	return &CommandRecordController{
+		dic: dic,
+	}
+}
+
+func (rc *CommandRecordController) CommandRecordById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	record, err := application.CommandRecordById(id, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewCommandRecordResponse("", "", http.StatusOK, record)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (rc *CommandRecordController) CommandRecordsByDeviceName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(rc.dic.Get)
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	records, err := application.CommandRecordsByDeviceName(offset, limit, name, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiCommandRecordsResponse("", "", http.StatusOK, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// ReplayCommandRecordById issues the set command of the record again, the response of the device service is returned
+func (rc *CommandRecordController) ReplayCommandRecordById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	response, err := application.ReplayCommandRecord(ctx, id, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, response.StatusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,220 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecordId     = "3c5b1e7a-8d2f-4a6b-9e0c-1f2d3a4b5c6d"
+	getRecordId      = "9f8e7d6c-5b4a-4321-8fed-cba987654321"
+	nonExistRecordId = "1a2b3c4d-5e6f-4708-9a1b-2c3d4e5f6a7b"
+)
+
+func TestCommandRecordsByDeviceName(t *testing.T) {
+	records := []models.CommandRecord{
+		{Id: testRecordId, DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut, StatusCode: http.StatusOK},
+		{Id: getRecordId, DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet, StatusCode: http.StatusOK},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandRecordsByDeviceName", 0, 20, testDeviceName).Return(records, nil)
+	dbClientMock.On("CommandRecordsByDeviceName", 0, 1, testDeviceName).Return(records[:1], nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewCommandRecordController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		limit              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - records of device", testDeviceName, "", 2, http.StatusOK},
+		{"Valid - limit", testDeviceName, "1", 1, http.StatusOK},
+		{"Invalid - empty device name", "", "", 0, http.StatusBadRequest},
+		{"Invalid - limit", testDeviceName, "abc", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiCommandRecordByDeviceNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.CommandRecordsByDeviceName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.MultiCommandRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Len(t, res.Records, testCase.expectedCount)
+		})
+	}
+}
+
+func TestCommandRecordById(t *testing.T) {
+	record := models.CommandRecord{Id: testRecordId, DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut,
+		Settings: buildTestSettings(), Caller: "admin", StatusCode: http.StatusOK, Latency: 12}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandRecordById", testRecordId).Return(record, nil)
+	dbClientMock.On("CommandRecordById", nonExistRecordId).Return(models.CommandRecord{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "command record doesn't exist", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewCommandRecordController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - record found", testRecordId, http.StatusOK},
+		{"Invalid - record not found", nonExistRecordId, http.StatusNotFound},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiCommandRecordByIdRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.CommandRecordById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.CommandRecordResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testRecordId, res.Record.Id)
+				assert.Equal(t, "admin", res.Record.Caller)
+				assert.Equal(t, buildTestSettings(), res.Record.Settings)
+			}
+		})
+	}
+}
+
+func TestReplayCommandRecordById(t *testing.T) {
+	settings := buildTestSettings()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandRecordById", testRecordId).Return(models.CommandRecord{Id: testRecordId, DeviceName: testDeviceName,
+		CommandName: testCommandName, Method: http.MethodPut, QueryParams: testQueryStrings, Settings: settings}, nil)
+	dbClientMock.On("CommandRecordById", getRecordId).Return(models.CommandRecord{Id: getRecordId, DeviceName: testDeviceName,
+		CommandName: testCommandName, Method: http.MethodGet}, nil)
+	dbClientMock.On("CommandRecordById", nonExistRecordId).Return(models.CommandRecord{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "command record doesn't exist", nil))
+	dbClientMock.On("AddCommandRecord", mock.Anything).Return(models.CommandRecord{}, nil)
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(buildDeviceResponse(), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).Return(buildSetCommandProfileResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, testQueryStrings, settings).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{CommandHistory: config.CommandHistoryInfo{Enabled: true}}
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.MetadataDeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.MetadataDeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	rc := NewCommandRecordController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - replay set command", testRecordId, http.StatusOK},
+		{"Invalid - get command", getRecordId, http.StatusBadRequest},
+		{"Invalid - record not found", nonExistRecordId, http.StatusNotFound},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiCommandRecordReplayByIdRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.ReplayCommandRecordById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertCalled(t, "AddCommandRecord", mock.MatchedBy(func(record models.CommandRecord) bool {
+		return record.ReplayOf == testRecordId && record.Method == http.MethodPut && record.StatusCode == http.StatusOK
+	}))
+	dsccMock.AssertNumberOfCalls(t, "SetCommand", 1)
+}
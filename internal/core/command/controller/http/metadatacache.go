@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+type MetadataCacheController struct {
+	dic *di.Container
+}
+
+// NewMetadataCacheController creates and initializes a MetadataCacheController
+func NewMetadataCacheController(dic *di.Container) *MetadataCacheController {
+	return &MetadataCacheController{
+		dic: dic,
+	}
+}
+
+// Invalidate handles the change notifications of core-metadata, whose content is the changed entity
+func (mc *MetadataCacheController) Invalidate(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(mc.dic.Get)
+	ctx := r.Context()
+
+	var change pkgDtos.MetadataChange
+	if err := json.NewDecoder(r.Body).Decode(&change); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the metadata change", err), "")
+		return
+	}
+	if err := common.Validate(change); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid metadata change", err), "")
+		return
+	}
+
+	application.InvalidateMetadataCache(change, mc.dic)
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// Stats returns the hit rate and the size of the metadata cache
+func (mc *MetadataCacheController) Stats(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(mc.dic.Get)
+	ctx := r.Context()
+
+	stats, enabled := application.MetadataCacheStats(mc.dic)
+
+	response := pkgResponses.NewMetadataCacheStatsResponse("", "", http.StatusOK, enabled, stats.Hits, stats.Misses, stats.HitRate(), stats.Entries)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,125 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataCacheInvalidate(t *testing.T) {
+	metadataCache := cache.NewMetadataCache(time.Minute)
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	_, err := metadataCache.DeviceByName(context.Background(), dcMock, testDeviceName)
+	require.NoError(t, err)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.MetadataCacheName: func(get di.Get) interface{} {
+			return metadataCache
+		},
+	})
+	mc := NewMetadataCacheController(dic)
+
+	tests := []struct {
+		name               string
+		body               string
+		expectedEntries    int
+		expectedStatusCode int
+	}{
+		{"Invalid - unknown entity type", `{"action":"UPDATE","entityType":"interval","name":"` + testDeviceName + `"}`, 1, http.StatusBadRequest},
+		{"Invalid - malformed change", `{"action":`, 1, http.StatusBadRequest},
+		{"Valid - device updated", `{"action":"UPDATE","entityType":"device","name":"` + testDeviceName + `"}`, 0, http.StatusOK},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiMetadataCacheInvalidateRoute, bytes.NewBufferString(testCase.body))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(mc.Invalidate)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Equal(t, testCase.expectedEntries, metadataCache.Stats().Entries)
+		})
+	}
+}
+
+func TestMetadataCacheStats(t *testing.T) {
+	metadataCache := cache.NewMetadataCache(time.Minute)
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	for i := 0; i < 4; i++ {
+		_, err := metadataCache.DeviceByName(context.Background(), dcMock, testDeviceName)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name            string
+		metadataCache   *cache.MetadataCache
+		expectedEnabled bool
+		expectedHitRate float64
+	}{
+		{"enabled", metadataCache, true, 0.75},
+		{"disabled", nil, false, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := NewMockDIC()
+			if testCase.metadataCache != nil {
+				dic.Update(di.ServiceConstructorMap{
+					commandContainer.MetadataCacheName: func(get di.Get) interface{} {
+						return testCase.metadataCache
+					},
+				})
+			}
+			mc := NewMetadataCacheController(dic)
+
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiMetadataCacheStatsRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(mc.Stats)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.MetadataCacheStatsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedEnabled, res.Enabled)
+			assert.InDelta(t, testCase.expectedHitRate, res.HitRate, 0.001)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gorilla/mux"
+)
+
+type RecipeController struct {
+	dic *di.Container
+}
+
+// NewRecipeController creates and initializes an RecipeController
+func NewRecipeController(dic *di.Container) *RecipeController {
+	return &RecipeController{
+		dic: dic,
+	}
+}
+
+func (rc *RecipeController) AddRecipe(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(rc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqs []pkgRequests.AddRecipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		edgeXerr, ok := err.(errors.EdgeX)
+		if !ok {
+			edgeXerr = errors.NewCommonEdgeX(errors.KindContractInvalid, "recipe json decoding failed", err)
+		}
+		utils.WriteErrorResponse(w, ctx, lc, edgeXerr, "")
+		return
+	}
+
+	var addResponses []interface{}
+	for _, req := range reqs {
+		var response interface{}
+		reqId := req.RequestId
+		newId, err := application.AddRecipe(pkgDtos.ToRecipeModel(req.Recipe), ctx, rc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				err.Message(),
+				err.Code())
+		} else {
+			response = commonDTO.NewBaseWithIdResponse(
+				reqId,
+				"",
+				http.StatusCreated,
+				newId)
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	pkg.Encode(addResponses, w, lc)
+}
+
+func (rc *RecipeController) AllRecipes(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(rc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	recipes, err := application.AllRecipes(offset, limit, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiRecipesResponse("", "", http.StatusOK, recipes)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (rc *RecipeController) RecipeByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	recipe, err := application.RecipeByName(name, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewRecipeResponse("", "", http.StatusOK, recipe)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (rc *RecipeController) DeleteRecipeByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	err := application.DeleteRecipeByName(name, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// RunRecipeByName starts a run of the recipe, e.g. from an interval action of support-scheduler. The steps are issued
+// in the background so the run is only accepted, its log is queried by the id of the returned run.
+func (rc *RecipeController) RunRecipeByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	run, err := application.RunRecipe(ctx, name, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewRecipeRunResponse("", "", http.StatusAccepted, run)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.Encode(response, w, lc)
+}
+
+func (rc *RecipeController) RecipeRunById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	run, err := application.RecipeRunById(id, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewRecipeRunResponse("", "", http.StatusOK, run)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (rc *RecipeController) RecipeRunsByRecipeName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(rc.dic.Get)
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	runs, err := application.RecipeRunsByRecipeName(offset, limit, name, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiRecipeRunsResponse("", "", http.StatusOK, runs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
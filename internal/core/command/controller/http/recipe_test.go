@@ -0,0 +1,230 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecipeName     = "testRecipe"
+	nonExistRecipeName = "nonExistRecipe"
+	testRecipeId       = "7b6a5f4e-3d2c-4b1a-9f8e-7d6c5b4a3f2e"
+	testRecipeRunId    = "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a"
+	nonExistRunId      = "0e1d2c3b-4a59-4687-9a0b-1c2d3e4f5a6b"
+)
+
+func buildTestRecipe() pkgDtos.Recipe {
+	return pkgDtos.Recipe{
+		Name: testRecipeName,
+		Steps: []pkgDtos.RecipeStep{
+			{Name: "read", DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodGet},
+			{DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut, Settings: buildTestSettings(), Delay: "1s",
+				Condition: &pkgDtos.RecipeCondition{Step: "read", ResourceName: "AHU-TargetTemperature", Operator: models.OperatorGreater, Value: "30"}},
+		},
+	}
+}
+
+func TestAddRecipe(t *testing.T) {
+	valid := pkgRequests.NewAddRecipeRequest(buildTestRecipe())
+	duplicate := valid
+	duplicate.Recipe.Name = "duplicate"
+	unknownStep := pkgRequests.NewAddRecipeRequest(buildTestRecipe())
+	unknownStep.Recipe.Steps[1].Condition = &pkgDtos.RecipeCondition{Step: "unknown", ResourceName: "AHU-TargetTemperature",
+		Operator: models.OperatorEqual, Value: "30"}
+	noSettings := pkgRequests.NewAddRecipeRequest(buildTestRecipe())
+	noSettings.Recipe.Steps[1].Settings = nil
+	invalidDelay := pkgRequests.NewAddRecipeRequest(buildTestRecipe())
+	invalidDelay.Recipe.Steps[1].Delay = "-1s"
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddRecipe", mock.MatchedBy(func(r models.Recipe) bool { return r.Name == testRecipeName })).
+		Return(models.Recipe{Id: testRecipeId, Name: testRecipeName}, nil)
+	dbClientMock.On("AddRecipe", mock.MatchedBy(func(r models.Recipe) bool { return r.Name == "duplicate" })).
+		Return(models.Recipe{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "recipe name duplicate already exists", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewRecipeController(dic)
+
+	tests := []struct {
+		name                   string
+		request                pkgRequests.AddRecipeRequest
+		isValidRequest         bool
+		expectedHttpStatusCode int
+	}{
+		{"Valid", valid, true, http.StatusCreated},
+		{"Valid - duplicate name", duplicate, true, http.StatusConflict},
+		{"Invalid - condition of unknown step", unknownStep, false, http.StatusBadRequest},
+		{"Invalid - PUT step without settings", noSettings, false, http.StatusBadRequest},
+		{"Invalid - negative delay", invalidDelay, false, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]pkgRequests.AddRecipeRequest{testCase.request})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiRecipeRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.AddRecipe)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.isValidRequest {
+				var res []commonDTO.BaseWithIdResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				require.Len(t, res, 1)
+				assert.Equal(t, testCase.expectedHttpStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+				if testCase.expectedHttpStatusCode == http.StatusCreated {
+					assert.Equal(t, testRecipeId, res[0].Id)
+				} else {
+					assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+				}
+			} else {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedHttpStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedHttpStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestRunRecipeByName(t *testing.T) {
+	recipe := pkgDtos.ToRecipeModel(buildTestRecipe())
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("RecipeByName", testRecipeName).Return(recipe, nil)
+	dbClientMock.On("RecipeByName", nonExistRecipeName).Return(models.Recipe{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "recipe doesn't exist", nil))
+	dbClientMock.On("AddRecipeRun", mock.Anything).Return(func(run models.RecipeRun) models.RecipeRun {
+		run.Id = testRecipeRunId
+		return run
+	}, nil)
+	executorMock := &dbMock.RecipeExecutor{}
+	executorMock.On("Submit", recipe, mock.MatchedBy(func(run models.RecipeRun) bool { return run.Id == testRecipeRunId })).Return()
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.RecipeExecutorName: func(get di.Get) interface{} {
+			return executorMock
+		},
+	})
+	rc := NewRecipeController(dic)
+
+	tests := []struct {
+		name               string
+		recipeName         string
+		expectedStatusCode int
+	}{
+		{"Valid - run accepted", testRecipeName, http.StatusAccepted},
+		{"Invalid - recipe not found", nonExistRecipeName, http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiRecipeRunByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.recipeName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.RunRecipeByName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.RecipeRunResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusAccepted {
+				assert.Equal(t, testRecipeRunId, res.Run.Id)
+				assert.Equal(t, string(models.RecipeRunRunning), res.Run.Status)
+				executorMock.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestRecipeRunById(t *testing.T) {
+	run := models.RecipeRun{Id: testRecipeRunId, RecipeName: testRecipeName, Status: models.RecipeRunSucceeded,
+		Steps: []models.RecipeStepLog{{DeviceName: testDeviceName, CommandName: testCommandName, Method: http.MethodPut, StatusCode: http.StatusOK}}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("RecipeRunById", testRecipeRunId).Return(run, nil)
+	dbClientMock.On("RecipeRunById", nonExistRunId).Return(models.RecipeRun{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "recipe run doesn't exist", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewRecipeController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - run found", testRecipeRunId, http.StatusOK},
+		{"Invalid - run not found", nonExistRunId, http.StatusNotFound},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiRecipeRunByIdRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.RecipeRunById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.RecipeRunResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, string(models.RecipeRunSucceeded), res.Run.Status)
+				assert.Len(t, res.Run.Steps, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+type DBClient interface {
+	CloseSession()
+
+	AddCommandJob(job pkgModels.CommandJob) (pkgModels.CommandJob, errors.EdgeX)
+	UpdateCommandJob(job pkgModels.CommandJob) errors.EdgeX
+	CancelCommandJob(job pkgModels.CommandJob) errors.EdgeX
+	CommandJobById(id string) (pkgModels.CommandJob, errors.EdgeX)
+	AllCommandJobs(offset int, limit int) ([]pkgModels.CommandJob, errors.EdgeX)
+	CommandJobsByStatus(offset int, limit int, status string) ([]pkgModels.CommandJob, errors.EdgeX)
+	DeleteCommandJobsByAge(age int64) (int, errors.EdgeX)
+
+	AddCommandRecord(record pkgModels.CommandRecord) (pkgModels.CommandRecord, errors.EdgeX)
+	CommandRecordById(id string) (pkgModels.CommandRecord, errors.EdgeX)
+	CommandRecordsByDeviceName(offset int, limit int, name string) ([]pkgModels.CommandRecord, errors.EdgeX)
+	DeleteCommandRecordsByAge(age int64) (int, errors.EdgeX)
+
+	AddRecipe(r pkgModels.Recipe) (pkgModels.Recipe, errors.EdgeX)
+	RecipeByName(name string) (pkgModels.Recipe, errors.EdgeX)
+	AllRecipes(offset int, limit int) ([]pkgModels.Recipe, errors.EdgeX)
+	DeleteRecipeByName(name string) errors.EdgeX
+
+	AddRecipeRun(run pkgModels.RecipeRun) (pkgModels.RecipeRun, errors.EdgeX)
+	UpdateRecipeRun(run pkgModels.RecipeRun) errors.EdgeX
+	RecipeRunById(id string) (pkgModels.RecipeRun, errors.EdgeX)
+	RecipeRunsByRecipeName(offset int, limit int, name string) ([]pkgModels.RecipeRun, errors.EdgeX)
+	RecipeRunsByStatus(offset int, limit int, status string) ([]pkgModels.RecipeRun, errors.EdgeX)
+	DeleteRecipeRunsByAge(age int64) (int, errors.EdgeX)
+}
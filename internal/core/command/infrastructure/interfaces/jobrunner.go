@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+	"sync"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// JobRunner issues the persisted command jobs to the devices in the background. At most MaxConcurrent jobs are
+// running at the same time, and at most MaxPending other submitted jobs wait in the pending status.
+type JobRunner interface {
+	Start(ctx context.Context, wg *sync.WaitGroup)
+	// Submit runs the job in the background, a ServiceUnavailable error is returned and the job is failed if
+	// MaxPending jobs are waiting already
+	Submit(job pkgModels.CommandJob) errors.EdgeX
+	// Cancel stops the job if it's pending or running in the runner, false is returned if it's not
+	Cancel(id string) bool
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+	"sync"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// RecipeExecutor runs the recipes in the background, the outcome of each step is logged in the persisted run
+type RecipeExecutor interface {
+	Start(ctx context.Context, wg *sync.WaitGroup)
+	// Submit runs the steps of the recipe one after another and completes the run, which has been persisted already
+	Submit(recipe pkgModels.Recipe, run pkgModels.RecipeRun)
+}
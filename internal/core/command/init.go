@@ -19,7 +19,12 @@ package command
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/history"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/job"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/recipe"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
@@ -47,6 +52,39 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	LoadRestRoutes(b.router, dic)
 
 	configuration := container.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if configuration.MetadataCache.TTL != "" {
+		ttl, err := time.ParseDuration(configuration.MetadataCache.TTL)
+		if err != nil || ttl <= 0 {
+			lc.Errorf("invalid MetadataCache TTL '%s'", configuration.MetadataCache.TTL)
+			return false
+		}
+		metadataCache := cache.NewMetadataCache(ttl)
+		dic.Update(di.ServiceConstructorMap{
+			container.MetadataCacheName: func(get di.Get) interface{} {
+				return metadataCache
+			},
+		})
+	}
+
+	if configuration.ResponseCache.MaxEntries > 0 {
+		var defaultMaxAge time.Duration
+		if configuration.ResponseCache.DefaultMaxAge != "" {
+			var err error
+			defaultMaxAge, err = time.ParseDuration(configuration.ResponseCache.DefaultMaxAge)
+			if err != nil || defaultMaxAge < 0 {
+				lc.Errorf("invalid ResponseCache DefaultMaxAge '%s'", configuration.ResponseCache.DefaultMaxAge)
+				return false
+			}
+		}
+		responseCache := cache.NewResponseCache(configuration.ResponseCache.MaxEntries, defaultMaxAge)
+		dic.Update(di.ServiceConstructorMap{
+			container.ResponseCacheName: func(get di.Get) interface{} {
+				return responseCache
+			},
+		})
+	}
 
 	// initialize clients required by the service
 	dic.Update(di.ServiceConstructorMap{
@@ -63,5 +101,38 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 			return clients.NewDeviceServiceCommandClient()
 		},
 	})
+
+	// V2 command jobs issued asynchronously
+	runner, err := job.NewRunner(dic, configuration.CommandJob)
+	if err != nil {
+		lc.Errorf("fail to create the command job runner, err: %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.JobRunnerName: func(get di.Get) interface{} {
+			return runner
+		},
+	})
+	runner.Start(ctx, wg)
+
+	// V2 command history is purged once the records expire
+	if err := history.Start(ctx, wg, dic); err != nil {
+		lc.Errorf("fail to start the command history, err: %v", err)
+		return false
+	}
+
+	// V2 recipes run on demand
+	executor, err := recipe.NewExecutor(dic, configuration.Recipe)
+	if err != nil {
+		lc.Errorf("fail to create the recipe executor, err: %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.RecipeExecutorName: func(get di.Get) interface{} {
+			return executor
+		},
+	})
+	executor.Start(ctx, wg)
+
 	return true
 }
@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	bootstrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v2/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+)
+
+// defaultMaxConcurrent is the number of commands issued at the same time if MessageCommand.MaxConcurrent isn't positive
+const defaultMaxConcurrent = 16
+
+// BootstrapHandler fulfills the BootstrapHandler contract. If the subscription is enabled, it connects to the
+// MessageBus and issues the commands requested on the subscribed topic, the replies are published on the topics under
+// the configured prefix.
+func BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messageBusInfo := container.ConfigurationFrom(dic.Get).MessageQueue
+	if !messageBusInfo.SubscribeEnabled {
+		return true
+	}
+
+	messageBusInfo.AuthMode = strings.ToLower(strings.TrimSpace(messageBusInfo.AuthMode))
+	if len(messageBusInfo.AuthMode) > 0 && messageBusInfo.AuthMode != bootstrapMessaging.AuthModeNone {
+		if err := bootstrapMessaging.SetOptionsAuthData(&messageBusInfo, lc, dic); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	msgClient, err := messaging.NewMessageClient(
+		types.MessageBusConfig{
+			PublishHost: types.HostInfo{
+				Host:     messageBusInfo.Host,
+				Port:     messageBusInfo.Port,
+				Protocol: messageBusInfo.Protocol,
+			},
+			SubscribeHost: types.HostInfo{
+				Host:     messageBusInfo.Host,
+				Port:     messageBusInfo.Port,
+				Protocol: messageBusInfo.Protocol,
+			},
+			Type:     messageBusInfo.Type,
+			Optional: messageBusInfo.Optional,
+		})
+
+	if err != nil {
+		lc.Errorf("Failed to create MessageClient: %v", err)
+		return false
+	}
+
+	for startupTimer.HasNotElapsed() {
+		select {
+		case <-ctx.Done():
+			return false
+		default:
+			err = msgClient.Connect()
+			if err != nil {
+				lc.Warnf("Unable to connect MessageBus: %v", err)
+				startupTimer.SleepForInterval()
+				continue
+			}
+
+			if err := subscribeCommandRequests(ctx, wg, msgClient, dic); err != nil {
+				lc.Errorf("Failed to subscribe to command requests: %v", err)
+				_ = msgClient.Disconnect()
+				return false
+			}
+
+			lc.Infof(
+				"Connected to %s Message Bus @ %s://%s:%d subscribing to '%s' command requests with AuthMode='%s'",
+				messageBusInfo.Type,
+				messageBusInfo.Protocol,
+				messageBusInfo.Host,
+				messageBusInfo.Port,
+				messageBusInfo.SubscribeTopic,
+				messageBusInfo.AuthMode)
+
+			return true
+		}
+	}
+
+	lc.Error("Connecting to MessageBus time out")
+	return false
+}
+
+// subscribeCommandRequests issues each command requested on the subscribed topic in its own goroutine, so that a slow
+// device doesn't hold back the requests of the other ones. At most MessageCommand.MaxConcurrent commands are issued at
+// the same time, the next request isn't received until one of them is replied.
+func subscribeCommandRequests(ctx context.Context, wg *sync.WaitGroup, msgClient messaging.MessageClient, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	subscribeTopic := configuration.MessageQueue.SubscribeTopic
+	maxConcurrent := configuration.MessageCommand.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultMaxConcurrent
+	}
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    subscribeTopic,
+			Messages: messages,
+		},
+	}
+	if err := msgClient.Subscribe(topics, messageErrors); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var requests sync.WaitGroup
+		semaphore := make(chan struct{}, maxConcurrent)
+		for {
+			select {
+			case <-ctx.Done():
+				requests.Wait()
+				_ = msgClient.Disconnect()
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", subscribeTopic)
+				return
+			case e := <-messageErrors:
+				lc.Error(e.Error())
+			case msgEnvelope := <-messages:
+				select {
+				case semaphore <- struct{}{}:
+				case <-ctx.Done():
+					lc.Warnf("the command request received on topic %s is dropped since the service is stopping. Correlation-id: %s",
+						msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+					continue
+				}
+				requests.Add(1)
+				go func(request types.MessageEnvelope) {
+					defer func() {
+						<-semaphore
+						requests.Done()
+					}()
+					replyTopic, reply, err := application.IssueCommandMessage(ctx, request, dic)
+					if err != nil {
+						lc.Errorf("fail to handle the command request, %v", err)
+						return
+					}
+					if err := msgClient.Publish(reply, replyTopic); err != nil {
+						lc.Errorf("fail to publish the command reply to topic %s, %v. Correlation-id: %s", replyTopic, err, reply.CorrelationID)
+					}
+				}(msgEnvelope)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/gorilla/mux"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/access"
 	commandController "github.com/edgexfoundry/edgex-go/internal/core/command/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 )
@@ -32,7 +35,39 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiDeviceByNameRoute, cmd.CommandsByDeviceName).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceNameCommandNameRoute, cmd.IssueGetCommandByName).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceNameCommandNameRoute, cmd.IssueSetCommandByName).Methods(http.MethodPut)
+	r.HandleFunc(pkgCommon.ApiDeviceBatchCommandRoute, cmd.IssueBatchCommand).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiCatalogueByDeviceNameRoute, cmd.CatalogueByDeviceName).Methods(http.MethodGet)
+
+	// Metadata cache
+	mc := commandController.NewMetadataCacheController(dic)
+	r.HandleFunc(pkgCommon.ApiMetadataCacheInvalidateRoute, mc.Invalidate).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiMetadataCacheStatsRoute, mc.Stats).Methods(http.MethodGet)
+
+	// Command job
+	cj := commandController.NewCommandJobController(dic)
+	r.HandleFunc(pkgCommon.ApiAllCommandJobRoute, cj.AllCommandJobs).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCommandJobByIdRoute, cj.CommandJobById).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCommandJobByStatusRoute, cj.CommandJobsByStatus).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCommandJobCancelByIdRoute, cj.CancelCommandJobById).Methods(http.MethodPost)
+
+	// Command history
+	cr := commandController.NewCommandRecordController(dic)
+	r.HandleFunc(pkgCommon.ApiCommandRecordByIdRoute, cr.CommandRecordById).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCommandRecordByDeviceNameRoute, cr.CommandRecordsByDeviceName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCommandRecordReplayByIdRoute, cr.ReplayCommandRecordById).Methods(http.MethodPost)
+
+	// Recipe
+	rc := commandController.NewRecipeController(dic)
+	r.HandleFunc(pkgCommon.ApiRecipeRoute, rc.AddRecipe).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllRecipeRoute, rc.AllRecipes).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiRecipeByNameRoute, rc.RecipeByName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiRecipeByNameRoute, rc.DeleteRecipeByName).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiRecipeRunByNameRoute, rc.RunRecipeByName).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiRecipeRunByIdRoute, rc.RecipeRunById).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiRecipeRunByRecipeNameRoute, rc.RecipeRunsByRecipeName).Methods(http.MethodGet)
 
 	r.Use(correlation.ManageHeader)
+	r.Use(audit.ManageActor)
+	r.Use(access.ManageCaller(dic))
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 }
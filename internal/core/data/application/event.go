@@ -41,6 +41,9 @@ func ValidateEvent(e models.Event, profileName string, deviceName string, source
 // The AddEvent function accepts the new event model from the controller functions
 // and invokes addEvent function in the infrastructure layer
 func AddEvent(e models.Event, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
+	// the device is alive no matter whether the event is persisted
+	container.DeviceHeartbeatReporterFrom(dic.Get).Seen(e.DeviceName)
+
 	configuration := container.ConfigurationFrom(dic.Get)
 	if !configuration.Writable.PersistData {
 		return nil
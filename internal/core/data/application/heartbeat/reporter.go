@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package heartbeat
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces"
+	pkgInterfaces "github.com/edgexfoundry/edgex-go/internal/pkg/clients/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// defaultReportInterval is used when the configured ReportInterval is invalid
+const defaultReportInterval = 10 * time.Second
+
+type reporter struct {
+	lc            logger.LoggingClient
+	configuration *config.ConfigurationStruct
+	client        pkgInterfaces.DeviceHeartbeatClient
+	mutex         sync.Mutex
+	// seen holds the last time each device sent an event since the previous report
+	seen map[string]int64
+}
+
+// NewReporter creates a new reporter which sends the device heartbeats to core-metadata with the client
+func NewReporter(lc logger.LoggingClient, configuration *config.ConfigurationStruct, client pkgInterfaces.DeviceHeartbeatClient) interfaces.DeviceHeartbeatReporter {
+	return &reporter{
+		lc:            lc,
+		configuration: configuration,
+		client:        client,
+		seen:          make(map[string]int64),
+	}
+}
+
+// Seen records the device sent an event just now, nothing is recorded if the reporting is disabled
+func (r *reporter) Seen(deviceName string) {
+	if !r.configuration.Writable.DeviceHeartbeat.Enabled {
+		return
+	}
+	r.mutex.Lock()
+	r.seen[deviceName] = pkgCommon.MakeTimestamp()
+	r.mutex.Unlock()
+}
+
+// Start reports the collected devices periodically in the background until the context is done
+func (r *reporter) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			timer := time.NewTimer(r.reportInterval())
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				r.report()
+			}
+		}
+	}()
+}
+
+// report sends the devices seen since the previous report to core-metadata
+func (r *reporter) report() {
+	r.mutex.Lock()
+	seen := r.seen
+	r.seen = make(map[string]int64)
+	r.mutex.Unlock()
+
+	if len(seen) == 0 {
+		return
+	}
+	reqs := make([]requests.DeviceHeartbeatRequest, 0, len(seen))
+	for name, timestamp := range seen {
+		reqs = append(reqs, requests.NewDeviceHeartbeatRequest(name, timestamp))
+	}
+	responses, err := r.client.Heartbeat(context.Background(), reqs)
+	if err != nil {
+		r.lc.Errorf("fail to report the heartbeats of %d devices to core-metadata, err: %v", len(reqs), err)
+		return
+	}
+	for i, res := range responses {
+		if res.StatusCode != http.StatusOK && i < len(reqs) {
+			r.lc.Debugf("fail to report the heartbeat of device %s, err: %s", reqs[i].DeviceName, res.Message)
+		}
+	}
+}
+
+// reportInterval reads the report interval from the writable configuration, so the changes take effect without restarting
+func (r *reporter) reportInterval() time.Duration {
+	value := r.configuration.Writable.DeviceHeartbeat.ReportInterval
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		r.lc.Warnf("invalid device heartbeat ReportInterval '%s', use the default value %v", value, defaultReportInterval)
+		return defaultReportInterval
+	}
+	return interval
+}
@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package heartbeat
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	clientMocks "github.com/edgexfoundry/edgex-go/internal/pkg/clients/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReporter(enabled bool, interval string, client *clientMocks.DeviceHeartbeatClient) *reporter {
+	configuration := &config.ConfigurationStruct{
+		Writable: config.WritableInfo{
+			DeviceHeartbeat: config.DeviceHeartbeatInfo{Enabled: enabled, ReportInterval: interval},
+		},
+	}
+	return NewReporter(logger.NewMockClient(), configuration, client).(*reporter)
+}
+
+func TestReportThrottling(t *testing.T) {
+	var reported []requests.DeviceHeartbeatRequest
+	clientMock := &clientMocks.DeviceHeartbeatClient{}
+	clientMock.On("Heartbeat", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		reported = args.Get(1).([]requests.DeviceHeartbeatRequest)
+	}).Return([]common.BaseResponse{common.NewBaseResponse("", "", http.StatusOK)}, nil)
+	r := newTestReporter(true, "10s", clientMock)
+
+	// the events of a device between two reports are sent as one heartbeat with the time of the latest event
+	r.Seen("device1")
+	first := r.seen["device1"]
+	time.Sleep(2 * time.Millisecond)
+	r.Seen("device1")
+	latest := r.seen["device1"]
+	require.Greater(t, latest, first)
+	r.Seen("device2")
+
+	r.report()
+	clientMock.AssertNumberOfCalls(t, "Heartbeat", 1)
+	require.Len(t, reported, 2)
+	for _, req := range reported {
+		if req.DeviceName == "device1" {
+			assert.Equal(t, latest, req.Timestamp)
+		}
+	}
+
+	// nothing is sent if no device is seen since the previous report
+	r.report()
+	clientMock.AssertNumberOfCalls(t, "Heartbeat", 1)
+}
+
+func TestReportFailure(t *testing.T) {
+	clientMock := &clientMocks.DeviceHeartbeatClient{}
+	clientMock.On("Heartbeat", mock.Anything, mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata unavailable", nil))
+	r := newTestReporter(true, "10s", clientMock)
+
+	r.Seen("device1")
+	r.report()
+	clientMock.AssertNumberOfCalls(t, "Heartbeat", 1)
+	assert.Empty(t, r.seen, "the heartbeats shall be collected anew after a report")
+}
+
+func TestSeenDisabled(t *testing.T) {
+	clientMock := &clientMocks.DeviceHeartbeatClient{}
+	r := newTestReporter(false, "10s", clientMock)
+
+	r.Seen("device1")
+	r.report()
+	assert.Empty(t, r.seen)
+	clientMock.AssertNotCalled(t, "Heartbeat", mock.Anything, mock.Anything)
+}
+
+func TestReportInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval string
+		expected time.Duration
+	}{
+		{"configured interval", "30s", 30 * time.Second},
+		{"invalid interval", "abc", defaultReportInterval},
+		{"non-positive interval", "0s", defaultReportInterval},
+		{"empty interval", "", defaultReportInterval},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newTestReporter(true, testCase.interval, &clientMocks.DeviceHeartbeatClient{})
+			assert.Equal(t, testCase.expected, r.reportInterval())
+		})
+	}
+}
@@ -31,9 +31,19 @@ type ConfigurationStruct struct {
 type WritableInfo struct {
 	PersistData     bool
 	LogLevel        string
+	DeviceHeartbeat DeviceHeartbeatInfo
 	InsecureSecrets bootstrapConfig.InsecureSecrets
 }
 
+// DeviceHeartbeatInfo provides the settings of reporting the devices which sent events to core-metadata, so that
+// core-metadata can track when the devices were last seen
+type DeviceHeartbeatInfo struct {
+	// Enabled turns on the reporting
+	Enabled bool
+	// ReportInterval is how often the devices seen since the previous report are sent to core-metadata
+	ReportInterval string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// DeviceHeartbeatReporterName contains the name of the interfaces.DeviceHeartbeatReporter implementation in the DIC.
+var DeviceHeartbeatReporterName = di.TypeInstanceToName((*interfaces.DeviceHeartbeatReporter)(nil))
+
+// DeviceHeartbeatReporterFrom helper function queries the DIC and returns the interfaces.DeviceHeartbeatReporter implementation.
+func DeviceHeartbeatReporterFrom(get di.Get) interfaces.DeviceHeartbeatReporter {
+	return get(DeviceHeartbeatReporterName).(interfaces.DeviceHeartbeatReporter)
+}
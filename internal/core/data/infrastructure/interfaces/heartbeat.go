@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+	"sync"
+)
+
+// DeviceHeartbeatReporter collects the devices which sent events and reports them to core-metadata periodically
+type DeviceHeartbeatReporter interface {
+	// Start starts reporting the collected devices in the background until the context is done
+	Start(ctx context.Context, wg *sync.WaitGroup)
+	// Seen records the device sent an event just now
+	Seen(deviceName string)
+}
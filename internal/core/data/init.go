@@ -20,11 +20,14 @@ import (
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application/heartbeat"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgClients "github.com/edgexfoundry/edgex-go/internal/pkg/clients/http"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 
 	"github.com/gorilla/mux"
 )
@@ -48,6 +51,16 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 	configuration := dataContainer.ConfigurationFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
+	// V2 device heartbeats reported to core-metadata
+	reporter := heartbeat.NewReporter(lc, configuration,
+		pkgClients.NewDeviceHeartbeatClient(configuration.Clients[common.CoreMetaDataServiceKey].Url()))
+	dic.Update(di.ServiceConstructorMap{
+		dataContainer.DeviceHeartbeatReporterName: func(get di.Get) interface{} {
+			return reporter
+		},
+	})
+	reporter.Start(ctx, wg)
+
 	if configuration.MessageQueue.SubscribeEnabled {
 		err := application.SubscribeEvents(ctx, dic)
 		if err != nil {
@@ -8,6 +8,7 @@ package mocks
 import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
 	"github.com/edgexfoundry/go-mod-messaging/v2/messaging"
 	msgTypes "github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+	"github.com/stretchr/testify/mock"
 )
 
 // NewMockDIC function returns a mock bootstrap di Container
@@ -45,5 +47,10 @@ func NewMockDIC() *di.Container {
 		dataContainer.MessagingClientName: func(get di.Get) interface{} {
 			return msgClient
 		},
+		dataContainer.DeviceHeartbeatReporterName: func(get di.Get) interface{} {
+			reporterMock := &dbMock.DeviceHeartbeatReporter{}
+			reporterMock.On("Seen", mock.Anything).Return()
+			return reporterMock
+		},
 	})
 }
@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package callback
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	defaultRetryInterval    = time.Second
+	defaultMaxRetryInterval = 5 * time.Minute
+)
+
+type dispatcher struct {
+	dic   *di.Container
+	lc    logger.LoggingClient
+	ctx   context.Context
+	wg    *sync.WaitGroup
+	mutex sync.Mutex
+	// workers holds the wake-up signal of the running delivery worker per device service
+	workers map[string]chan struct{}
+}
+
+// NewDispatcher creates a new dispatcher for delivering the callbacks of the outbox
+func NewDispatcher(dic *di.Container) interfaces.CallbackDispatcher {
+	return &dispatcher{
+		dic:     dic,
+		lc:      bootstrapContainer.LoggingClientFrom(dic.Get),
+		workers: make(map[string]chan struct{}),
+	}
+}
+
+// Start starts the delivery workers for the device services which still have pending callbacks in the outbox
+func (d *dispatcher) Start(ctx context.Context, wg *sync.WaitGroup) {
+	d.mutex.Lock()
+	d.ctx = ctx
+	d.wg = wg
+	d.mutex.Unlock()
+
+	dbClient := container.DBClientFrom(d.dic.Get)
+	callbacks, err := dbClient.DeviceServiceCallbacksByStatus(0, -1, models.CallbackPending)
+	if err != nil {
+		d.lc.Errorf("fail to load the pending device service callbacks, err: %v", err)
+		return
+	}
+	for _, cb := range callbacks {
+		d.Notify(cb.ServiceName)
+	}
+}
+
+// Notify wakes up the delivery worker of the specified device service, the worker is started if it isn't running
+func (d *dispatcher) Notify(serviceName string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.ctx == nil {
+		// the pending callbacks will be loaded once the dispatcher is started
+		return
+	}
+	signal, ok := d.workers[serviceName]
+	if !ok {
+		signal = make(chan struct{}, 1)
+		d.workers[serviceName] = signal
+		d.wg.Add(1)
+		go d.run(serviceName, signal)
+	}
+	select {
+	case signal <- struct{}{}:
+	default:
+		// the worker has been signaled already
+	}
+}
+
+// run delivers the callbacks of the device service until there is no pending callback left
+func (d *dispatcher) run(serviceName string, signal chan struct{}) {
+	defer d.wg.Done()
+
+	for {
+		wait, idle := d.deliver(serviceName)
+		if idle {
+			d.mutex.Lock()
+			if len(signal) == 0 {
+				delete(d.workers, serviceName)
+				d.mutex.Unlock()
+				return
+			}
+			d.mutex.Unlock()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-d.ctx.Done():
+			timer.Stop()
+			return
+		case <-signal:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// deliver sends the pending callbacks of the device service in order. It returns the time to wait before the next
+// delivery attempt, or idle as true when all the pending callbacks have been delivered or the queue is blocked by a
+// failed callback. The callbacks behind a failed one are held back until it is re-triggered or deleted, so that the
+// device service never receives them out of order.
+func (d *dispatcher) deliver(serviceName string) (wait time.Duration, idle bool) {
+	dbClient := container.DBClientFrom(d.dic.Get)
+	configuration := container.ConfigurationFrom(d.dic.Get)
+	maxRetries, retryInterval, maxRetryInterval := d.retryPolicy(configuration)
+
+	callbacks, err := dbClient.DeviceServiceCallbacksByServiceName(0, -1, serviceName)
+	if err != nil {
+		d.lc.Errorf("fail to query the callbacks of device service %s, err: %v", serviceName, err)
+		return retryInterval, false
+	}
+	for _, cb := range callbacks {
+		if cb.Status == models.CallbackFailed {
+			d.lc.Warnf("the callbacks of device service %s are held back by the failed callback %s", serviceName, cb.Id)
+			return 0, true
+		}
+		now := pkgCommon.MakeTimestamp()
+		if cb.NextRetry > now {
+			// keep the order of the callbacks by waiting for the head of the queue
+			return time.Duration(cb.NextRetry-now) * time.Millisecond, false
+		}
+
+		err = send(d.dic, cb)
+		if err == nil {
+			d.lc.Debugf("success to deliver the %s callback of %s to device service %s", cb.Action, cb.EntityName, cb.ServiceName)
+			err = dbClient.DeleteDeviceServiceCallbackById(cb.Id)
+			if err != nil {
+				d.lc.Errorf("fail to remove the delivered callback %s from the outbox, err: %v", cb.Id, err)
+				return retryInterval, false
+			}
+			continue
+		}
+
+		cb.RetryCount = cb.RetryCount + 1
+		cb.LastError = err.Error()
+		if isPermanentFailure(err) || cb.RetryCount > maxRetries {
+			d.lc.Errorf("fail to deliver the %s callback of %s to device service %s after %d attempts, err: %v",
+				cb.Action, cb.EntityName, cb.ServiceName, cb.RetryCount, err)
+			cb.Status = models.CallbackFailed
+			cb.NextRetry = 0
+			err = dbClient.UpdateDeviceServiceCallback(cb)
+			if err != nil {
+				d.lc.Errorf("fail to update the callback %s, err: %v", cb.Id, err)
+				return retryInterval, false
+			}
+			return 0, true
+		}
+
+		backoff := backoffInterval(cb.RetryCount, retryInterval, maxRetryInterval)
+		d.lc.Warnf("fail to deliver the %s callback of %s to device service %s, retry in %v, err: %v",
+			cb.Action, cb.EntityName, cb.ServiceName, backoff, err)
+		cb.NextRetry = now + backoff.Milliseconds()
+		err = dbClient.UpdateDeviceServiceCallback(cb)
+		if err != nil {
+			d.lc.Errorf("fail to update the callback %s, err: %v", cb.Id, err)
+		}
+		return backoff, false
+	}
+	return 0, true
+}
+
+// retryPolicy returns the configured retry policy, the default intervals are used if the configured ones are invalid
+func (d *dispatcher) retryPolicy(configuration *config.ConfigurationStruct) (maxRetries int, retryInterval time.Duration, maxRetryInterval time.Duration) {
+	policy := configuration.Writable.Callback
+	retryInterval, err := time.ParseDuration(policy.RetryInterval)
+	if err != nil || retryInterval <= 0 {
+		d.lc.Warnf("invalid callback RetryInterval '%s', use the default value %v", policy.RetryInterval, defaultRetryInterval)
+		retryInterval = defaultRetryInterval
+	}
+	maxRetryInterval, err = time.ParseDuration(policy.MaxRetryInterval)
+	if err != nil || maxRetryInterval < retryInterval {
+		d.lc.Warnf("invalid callback MaxRetryInterval '%s', use the default value %v", policy.MaxRetryInterval, defaultMaxRetryInterval)
+		maxRetryInterval = defaultMaxRetryInterval
+	}
+	return policy.MaxRetries, retryInterval, maxRetryInterval
+}
+
+// backoffInterval doubles the retry interval for each retry and caps the result with the maximum interval
+func backoffInterval(retryCount int, retryInterval time.Duration, maxRetryInterval time.Duration) time.Duration {
+	backoff := retryInterval
+	for i := 1; i < retryCount; i++ {
+		backoff = backoff * 2
+		if backoff >= maxRetryInterval {
+			return maxRetryInterval
+		}
+	}
+	return backoff
+}
+
+// isPermanentFailure checks whether the failure can't be recovered by retrying, e.g. the device service doesn't exist
+// or the device service rejects the request
+func isPermanentFailure(err errors.EdgeX) bool {
+	switch errors.Kind(err) {
+	case errors.KindEntityDoesNotExist, errors.KindContractInvalid:
+		return true
+	default:
+		return false
+	}
+}
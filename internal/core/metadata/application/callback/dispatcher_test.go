@@ -0,0 +1,150 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package callback
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = "testServiceName"
+	testDeviceName  = "testDeviceName"
+)
+
+func mockDic(dbClient *dbMock.DBClient) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					Callback: config.CallbackInfo{
+						MaxRetries:       2,
+						RetryInterval:    "1s",
+						MaxRetryInterval: "5m",
+					},
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+	})
+}
+
+func mockDeviceService(statusCode int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		res, _ := json.Marshal(commonDTO.NewBaseResponse("", "", statusCode))
+		_, _ = w.Write(res)
+	}))
+}
+
+func TestBackoffInterval(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryCount int
+		expected   time.Duration
+	}{
+		{"first retry", 1, time.Second},
+		{"second retry", 2, 2 * time.Second},
+		{"fifth retry", 5, 16 * time.Second},
+		{"capped with the maximum interval", 20, time.Minute},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, backoffInterval(testCase.retryCount, time.Second, time.Minute))
+		})
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	pending := pkgModels.DeviceServiceCallback{
+		Id:          "pending",
+		ServiceName: testServiceName,
+		Action:      pkgModels.DeleteDeviceAction,
+		EntityName:  testDeviceName,
+		Status:      pkgModels.CallbackPending,
+	}
+	exhausted := pending
+	exhausted.Id = "exhausted"
+	exhausted.RetryCount = 2
+	failed := pending
+	failed.Id = "failed"
+	failed.Status = pkgModels.CallbackFailed
+	waiting := pending
+	waiting.Id = "waiting"
+	waiting.NextRetry = time.Now().Add(time.Hour).UnixNano() / int64(time.Millisecond)
+
+	tests := []struct {
+		name             string
+		statusCode       int
+		callbacks        []pkgModels.DeviceServiceCallback
+		expectedIdle     bool
+		expectedDeleted  bool
+		expectedStatus   string
+		expectedRetryCnt int
+	}{
+		{"delivered and removed", http.StatusOK, []pkgModels.DeviceServiceCallback{pending}, true, true, "", 0},
+		{"held back by the failed callback", http.StatusOK, []pkgModels.DeviceServiceCallback{failed, pending}, true, false, "", 0},
+		{"scheduled for retry", http.StatusInternalServerError, []pkgModels.DeviceServiceCallback{pending}, false, false, pkgModels.CallbackPending, 1},
+		{"marked as failed after the retry limit", http.StatusInternalServerError, []pkgModels.DeviceServiceCallback{exhausted, pending}, true, false, pkgModels.CallbackFailed, 3},
+		{"wait for the head of the queue", http.StatusOK, []pkgModels.DeviceServiceCallback{waiting, pending}, false, false, "", 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := mockDeviceService(testCase.statusCode)
+			defer server.Close()
+
+			var updated pkgModels.DeviceServiceCallback
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceServiceCallbacksByServiceName", 0, -1, testServiceName).Return(testCase.callbacks, nil)
+			dbClientMock.On("DeviceServiceByName", testServiceName).Return(models.DeviceService{BaseAddress: server.URL}, nil)
+			dbClientMock.On("DeleteDeviceServiceCallbackById", mock.Anything).Return(nil)
+			dbClientMock.On("UpdateDeviceServiceCallback", mock.Anything).Run(func(args mock.Arguments) {
+				updated = args.Get(0).(pkgModels.DeviceServiceCallback)
+			}).Return(nil)
+			d := NewDispatcher(mockDic(dbClientMock)).(*dispatcher)
+
+			_, idle := d.deliver(testServiceName)
+
+			assert.Equal(t, testCase.expectedIdle, idle)
+			if testCase.expectedDeleted {
+				dbClientMock.AssertCalled(t, "DeleteDeviceServiceCallbackById", pending.Id)
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteDeviceServiceCallbackById", mock.Anything)
+			}
+			if testCase.expectedStatus == "" {
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceServiceCallback", mock.Anything)
+				return
+			}
+			require.NotEmpty(t, updated.Id)
+			assert.Equal(t, testCase.expectedStatus, string(updated.Status))
+			assert.Equal(t, testCase.expectedRetryCnt, updated.RetryCount)
+			assert.NotEmpty(t, updated.LastError)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// send invokes the device service's callback API according to the action of the callback
+func send(dic *di.Container, cb models.DeviceServiceCallback) errors.EdgeX {
+	baseAddress := cb.BaseAddress
+	if baseAddress == "" {
+		ds, err := container.DBClientFrom(dic.Get).DeviceServiceByName(cb.ServiceName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		baseAddress = ds.BaseAddress
+	}
+	client := clients.NewDeviceServiceCallbackClient(baseAddress)
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, cb.CorrelationId)
+
+	var response commonDTO.BaseResponse
+	var edgeXerr errors.EdgeX
+	switch cb.Action {
+	case models.AddDeviceAction:
+		var device dtos.Device
+		if edgeXerr = unmarshalPayload(cb, &device); edgeXerr != nil {
+			return edgeXerr
+		}
+		response, edgeXerr = client.AddDeviceCallback(ctx, requests.NewAddDeviceRequest(device))
+	case models.UpdateDeviceAction:
+		var device dtos.UpdateDevice
+		if edgeXerr = unmarshalPayload(cb, &device); edgeXerr != nil {
+			return edgeXerr
+		}
+		response, edgeXerr = client.UpdateDeviceCallback(ctx, requests.NewUpdateDeviceRequest(device))
+	case models.DeleteDeviceAction:
+		response, edgeXerr = client.DeleteDeviceCallback(ctx, cb.EntityName)
+	case models.UpdateDeviceProfileAction:
+		var profile dtos.DeviceProfile
+		if edgeXerr = unmarshalPayload(cb, &profile); edgeXerr != nil {
+			return edgeXerr
+		}
+		response, edgeXerr = client.UpdateDeviceProfileCallback(ctx, requests.NewDeviceProfileRequest(profile))
+	case models.AddProvisionWatcherAction:
+		var pw dtos.ProvisionWatcher
+		if edgeXerr = unmarshalPayload(cb, &pw); edgeXerr != nil {
+			return edgeXerr
+		}
+		response, edgeXerr = client.AddProvisionWatcherCallback(ctx, requests.NewAddProvisionWatcherRequest(pw))
+	case models.UpdateProvisionWatcherAction:
+		var pw dtos.UpdateProvisionWatcher
+		if edgeXerr = unmarshalPayload(cb, &pw); edgeXerr != nil {
+			return edgeXerr
+		}
+		response, edgeXerr = client.UpdateProvisionWatcherCallback(ctx, requests.NewUpdateProvisionWatcherRequest(pw))
+	case models.DeleteProvisionWatcherAction:
+		response, edgeXerr = client.DeleteProvisionWatcherCallback(ctx, cb.EntityName)
+	case models.UpdateDeviceServiceAction:
+		var service dtos.UpdateDeviceService
+		if edgeXerr = unmarshalPayload(cb, &service); edgeXerr != nil {
+			return edgeXerr
+		}
+		response, edgeXerr = client.UpdateDeviceServiceCallback(ctx, requests.NewUpdateDeviceServiceRequest(service))
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported callback action %s", cb.Action), nil)
+	}
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if response.StatusCode != http.StatusOK {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("device service responded with status code %d, message: %s", response.StatusCode, response.Message), nil)
+	}
+	return nil
+}
+
+func unmarshalPayload(cb models.DeviceServiceCallback, out interface{}) errors.EdgeX {
+	err := json.Unmarshal(cb.Payload, out)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the payload of the %s callback", cb.Action), err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// CascadeDeleteDeviceServiceByName deletes the device service along with its devices and provision watchers, and
+// returns the names of the deleted devices and provision watchers. The device service shall be at the expected revision.
+// Nothing is deleted if dryRun is true.
+func CascadeDeleteDeviceServiceByName(name string, revision int64, dryRun bool, ctx context.Context, dic *di.Container) (devices []string, provisionWatchers []string, err errors.EdgeX) {
+	if name == "" {
+		return devices, provisionWatchers, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	ds, err := dbClient.DeviceServiceByName(name)
+	if err != nil {
+		return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+	}
+	var dsDevices []models.Device
+	var dsProvisionWatchers []models.ProvisionWatcher
+	if dryRun {
+		err = checkRevision(dbClient, ds.Id, revision)
+		if err != nil {
+			return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+		}
+		dsDevices, err = dbClient.DevicesByServiceName(0, -1, name)
+		if err != nil {
+			return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+		}
+		dsProvisionWatchers, err = dbClient.ProvisionWatchersByServiceName(0, -1, name)
+		if err != nil {
+			return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+		}
+		return deviceNames(dsDevices), provisionWatcherNames(dsProvisionWatchers), nil
+	}
+
+	dsDevices, dsProvisionWatchers, err = dbClient.CascadeDeleteDeviceServiceByName(name, revision)
+	if err != nil {
+		return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("DeviceService %s deleted along with %d devices and %d provision watchers. Correlation-ID: %s ",
+		name, len(dsDevices), len(dsProvisionWatchers), correlation.FromContext(ctx))
+
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceService, ds.Name, dtos.FromDeviceServiceModelToDTO(ds), nil)
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceService, ds.Name)
+	recordDependentsDeletion(ctx, dic, dsDevices, dsProvisionWatchers)
+	deleteDeviceServiceDependentsCallback(ctx, dic, ds, dsDevices, dsProvisionWatchers)
+	return deviceNames(dsDevices), provisionWatcherNames(dsProvisionWatchers), nil
+}
+
+// CascadeDeleteDeviceProfileByName deletes the device profile along with its devices and provision watchers, and
+// returns the names of the deleted devices and provision watchers. The device profile shall be at the expected revision.
+// Nothing is deleted if dryRun is true.
+func CascadeDeleteDeviceProfileByName(name string, revision int64, dryRun bool, ctx context.Context, dic *di.Container) (devices []string, provisionWatchers []string, err errors.EdgeX) {
+	if name == "" {
+		return devices, provisionWatchers, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	dp, err := dbClient.DeviceProfileByName(name)
+	if err != nil {
+		return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+	}
+	var dpDevices []models.Device
+	var dpProvisionWatchers []models.ProvisionWatcher
+	if dryRun {
+		err = checkRevision(dbClient, dp.Id, revision)
+		if err != nil {
+			return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+		}
+		dpDevices, err = dbClient.DevicesByProfileName(0, -1, name)
+		if err != nil {
+			return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+		}
+		dpProvisionWatchers, err = dbClient.ProvisionWatchersByProfileName(0, -1, name)
+		if err != nil {
+			return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+		}
+		return deviceNames(dpDevices), provisionWatcherNames(dpProvisionWatchers), nil
+	}
+
+	dpDevices, dpProvisionWatchers, err = dbClient.CascadeDeleteDeviceProfileByName(name, revision)
+	if err != nil {
+		return devices, provisionWatchers, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("DeviceProfile %s deleted along with %d devices and %d provision watchers. Correlation-ID: %s ",
+		name, len(dpDevices), len(dpProvisionWatchers), correlation.FromContext(ctx))
+
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceProfile, dp.Name, dtos.FromDeviceProfileModelToDTO(dp), nil)
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceProfile, dp.Name)
+	recordDependentsDeletion(ctx, dic, dpDevices, dpProvisionWatchers)
+	for _, d := range dpDevices {
+		deleteDeviceCallback(ctx, dic, d)
+	}
+	for _, pw := range dpProvisionWatchers {
+		deleteProvisionWatcherCallback(ctx, dic, pw)
+	}
+	return deviceNames(dpDevices), provisionWatcherNames(dpProvisionWatchers), nil
+}
+
+// recordDependentsDeletion records the deletion of the devices and provision watchers deleted along with their device
+// service or device profile in the audit trail
+func recordDependentsDeletion(ctx context.Context, dic *di.Container, devices []models.Device, pws []models.ProvisionWatcher) {
+	for _, d := range devices {
+		audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDevice, d.Name, dtos.FromDeviceModelToDTO(d), nil)
+		go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDevice, d.Name)
+	}
+	for _, pw := range pws {
+		audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditProvisionWatcher, pw.Name, dtos.FromProvisionWatcherModelToDTO(pw), nil)
+	}
+}
+
+func deviceNames(devices []models.Device) []string {
+	names := make([]string, len(devices))
+	for i, d := range devices {
+		names[i] = d.Name
+	}
+	return names
+}
+
+func provisionWatcherNames(pws []models.ProvisionWatcher) []string {
+	names := make([]string, len(pws))
+	for i, pw := range pws {
+		names[i] = pw.Name
+	}
+	return names
+}
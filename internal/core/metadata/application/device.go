@@ -11,7 +11,9 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
@@ -29,6 +31,10 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
+	// a location with a latitude or a longitude shall be a valid structured location so it can be indexed
+	if _, _, edgeXerr = pkgModels.ToGeoLocation(d.Location); edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 	exists, edgeXerr := dbClient.DeviceServiceNameExists(d.ServiceName)
 	if edgeXerr != nil {
 		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
@@ -52,12 +58,14 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 		addedDevice.Id,
 		correlation.FromContext(ctx),
 	))
-	go addDeviceCallback(ctx, dic, dtos.FromDeviceModelToDTO(d))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDevice, addedDevice.Name, nil, dtos.FromDeviceModelToDTO(addedDevice))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDevice, addedDevice.Name)
+	addDeviceCallback(ctx, dic, dtos.FromDeviceModelToDTO(d))
 	return addedDevice.Id, nil
 }
 
-// DeleteDeviceByName deletes the device by name
-func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+// DeleteDeviceByName deletes the device by name if it is at the expected revision
+func DeleteDeviceByName(name string, revision int64, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -66,11 +74,13 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	err = dbClient.DeleteDeviceByName(name)
+	err = dbClient.DeleteDeviceByName(name, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	go deleteDeviceCallback(ctx, dic, device)
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDevice, device.Name, dtos.FromDeviceModelToDTO(device), nil)
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDevice, device.Name)
+	deleteDeviceCallback(ctx, dic, device)
 	return nil
 }
 
@@ -105,7 +115,7 @@ func DeviceNameExists(name string, dic *di.Container) (exists bool, err errors.E
 }
 
 // PatchDevice executes the PATCH operation with the device DTO to replace the old data
-func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container) errors.EdgeX {
+func PatchDevice(dto dtos.UpdateDevice, revision int64, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -137,9 +147,13 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 		oldServiceName = device.ServiceName
 	}
 
+	before := dtos.FromDeviceModelToDTO(device)
 	requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
+	if _, _, err = pkgModels.ToGeoLocation(device.Location); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
-	err = dbClient.UpdateDevice(device)
+	err = dbClient.UpdateDevice(device, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -148,11 +162,13 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 		"Device patched on DB successfully. Correlation-ID: %s ",
 		correlation.FromContext(ctx),
 	))
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDevice, device.Name, before, dtos.FromDeviceModelToDTO(device))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDevice, device.Name)
 
 	if oldServiceName != "" {
-		go updateDeviceCallback(ctx, dic, oldServiceName, device)
+		updateDeviceCallback(ctx, dic, oldServiceName, device)
 	}
-	go updateDeviceCallback(ctx, dic, device.ServiceName, device)
+	updateDeviceCallback(ctx, dic, device.ServiceName, device)
 	return nil
 }
 
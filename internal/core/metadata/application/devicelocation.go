@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DevicesWithinRadius queries the devices whose structured location is within the radius in meters of the center
+// with offset and limit, nearest first
+func DevicesWithinRadius(latitude float64, longitude float64, radius float64, offset int, limit int, dic *di.Container) (devices []pkgDtos.NearbyDevice, totalCount uint32, err errors.EdgeX) {
+	if err = validateCoordinates(latitude, longitude); err != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if radius <= 0 {
+		return devices, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("radius %v is not positive", radius), nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	nearby, totalCount, err := dbClient.DevicesWithinRadius(latitude, longitude, radius, offset, limit)
+	if err != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromNearbyDeviceModelsToDTOs(nearby), totalCount, nil
+}
+
+// DevicesWithinBoundingBox queries the devices whose structured location is within the bounding box with offset and
+// limit, nearest to the center of the box first. The box crosses the antimeridian if west is greater than east.
+func DevicesWithinBoundingBox(south float64, west float64, north float64, east float64, offset int, limit int, dic *di.Container) (devices []pkgDtos.NearbyDevice, totalCount uint32, err errors.EdgeX) {
+	if err = validateCoordinates(south, west); err != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateCoordinates(north, east); err != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if south > north {
+		return devices, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("south %v is greater than north %v", south, north), nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	nearby, totalCount, err := dbClient.DevicesWithinBoundingBox(south, west, north, east, offset, limit)
+	if err != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromNearbyDeviceModelsToDTOs(nearby), totalCount, nil
+}
+
+// DevicesBySite queries the devices whose structured location refers to the site with offset and limit
+func DevicesBySite(offset int, limit int, site string, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+	if site == "" {
+		return devices, errors.NewCommonEdgeX(errors.KindContractInvalid, "site is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	deviceModels, err := dbClient.DevicesBySite(offset, limit, site)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, nil
+}
+
+// EnsureDeviceGeoIndex indexes the structured locations of the devices stored before the geo indexes were introduced,
+// it's invoked once the service starts
+func EnsureDeviceGeoIndex(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	indexed, err := dbClient.EnsureDeviceGeoIndex()
+	if err != nil {
+		lc.Errorf("fail to build the device geo index, the devices added before may not be found by location: %v", err)
+		return
+	}
+	if indexed > 0 {
+		lc.Infof("%d device locations are added to the geo index", indexed)
+	}
+}
+
+func validateCoordinates(latitude float64, longitude float64) errors.EdgeX {
+	if latitude < -pkgModels.MaxGeoLatitude || latitude > pkgModels.MaxGeoLatitude {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("latitude %v is not between %v and %v", latitude, -pkgModels.MaxGeoLatitude, pkgModels.MaxGeoLatitude), nil)
+	}
+	if longitude < -pkgModels.MaxGeoLongitude || longitude > pkgModels.MaxGeoLongitude {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("longitude %v is not between %v and %v", longitude, -pkgModels.MaxGeoLongitude, pkgModels.MaxGeoLongitude), nil)
+	}
+	return nil
+}
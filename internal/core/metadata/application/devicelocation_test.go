@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevicesWithinRadius(t *testing.T) {
+	nearby := pkgModels.NearbyDevice{Device: models.Device{Name: "thermostat-1"}, Distance: 12.5}
+
+	tests := []struct {
+		name                string
+		latitude, longitude float64
+		radius              float64
+		errorExpected       bool
+	}{
+		{"valid", 45.5, -73.5, 1000, false},
+		{"invalid - latitude out of range", 89, -73.5, 1000, true},
+		{"invalid - longitude out of range", 45.5, -181, 1000, true},
+		{"invalid - radius not positive", 45.5, -73.5, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DevicesWithinRadius", testCase.latitude, testCase.longitude, testCase.radius, 0, 10).
+				Return([]pkgModels.NearbyDevice{nearby}, uint32(1), nil)
+			dic := mockDic(dbClientMock)
+
+			devices, totalCount, err := DevicesWithinRadius(testCase.latitude, testCase.longitude, testCase.radius, 0, 10, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "DevicesWithinRadius", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint32(1), totalCount)
+			require.Len(t, devices, 1)
+			assert.Equal(t, nearby.Device.Name, devices[0].Device.Name)
+			assert.Equal(t, nearby.Distance, devices[0].Distance)
+		})
+	}
+}
+
+func TestDevicesWithinBoundingBox(t *testing.T) {
+	tests := []struct {
+		name          string
+		south, west   float64
+		north, east   float64
+		errorExpected bool
+	}{
+		{"valid", 45, -74, 46, -73, false},
+		{"valid - crossing the antimeridian", -20, 170, -10, -170, false},
+		{"invalid - south greater than north", 46, -74, 45, -73, true},
+		{"invalid - north out of range", 45, -74, 86, -73, true},
+		{"invalid - west out of range", 45, -190, 46, -73, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DevicesWithinBoundingBox", testCase.south, testCase.west, testCase.north, testCase.east, 0, 10).
+				Return([]pkgModels.NearbyDevice{}, uint32(0), nil)
+			dic := mockDic(dbClientMock)
+
+			_, _, err := DevicesWithinBoundingBox(testCase.south, testCase.west, testCase.north, testCase.east, 0, 10, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			dbClientMock.AssertCalled(t, "DevicesWithinBoundingBox", testCase.south, testCase.west, testCase.north, testCase.east, 0, 10)
+		})
+	}
+}
+
+func TestToGeoLocation(t *testing.T) {
+	tests := []struct {
+		name          string
+		location      interface{}
+		expectedOk    bool
+		expectedSite  string
+		errorExpected bool
+	}{
+		{"structured", map[string]interface{}{"latitude": 45.5, "longitude": -73.5, "altitude": 35.0, "site": "plant-2"}, true, "plant-2", false},
+		{"free-form object", map[string]interface{}{"building": "HQ"}, false, "", false},
+		{"free-form string", "lobby", false, "", false},
+		{"no location", nil, false, "", false},
+		{"invalid - longitude missing", map[string]interface{}{"latitude": 45.5}, false, "", true},
+		{"invalid - latitude out of range", map[string]interface{}{"latitude": 89.0, "longitude": -73.5}, false, "", true},
+		{"invalid - latitude not a number", map[string]interface{}{"latitude": "45.5", "longitude": -73.5}, false, "", true},
+		{"invalid - site not a string", map[string]interface{}{"latitude": 45.5, "longitude": -73.5, "site": 2.0}, false, "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			l, ok, err := pkgModels.ToGeoLocation(testCase.location)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOk, ok)
+			assert.Equal(t, testCase.expectedSite, l.Site)
+		})
+	}
+}
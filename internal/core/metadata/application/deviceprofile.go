@@ -10,7 +10,9 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
@@ -36,17 +38,24 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 		addedDeviceProfile.Id,
 		correlationId,
 	))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDeviceProfile, addedDeviceProfile.Name, nil, dtos.FromDeviceProfileModelToDTO(addedDeviceProfile))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDeviceProfile, addedDeviceProfile.Name)
 
 	return addedDeviceProfile.Id, nil
 }
 
 // The UpdateDeviceProfile function accepts the device profile model from the controller functions
-// and invokes updateDeviceProfile function in the infrastructure layer
-func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
+// and invokes updateDeviceProfile function in the infrastructure layer, the profile is updated only if it is at the
+// expected revision
+func UpdateDeviceProfile(d models.DeviceProfile, revision int64, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	err = dbClient.UpdateDeviceProfile(d)
+	old, err := dbClient.DeviceProfileByName(d.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpdateDeviceProfile(d, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -55,7 +64,9 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 		"DeviceProfile updated on DB successfully. Correlation-id: %s ",
 		correlation.FromContext(ctx),
 	))
-	go updateDeviceProfileCallback(ctx, dic, dtos.FromDeviceProfileModelToDTO(d))
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDeviceProfile, d.Name, dtos.FromDeviceProfileModelToDTO(old), dtos.FromDeviceProfileModelToDTO(d))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDeviceProfile, d.Name)
+	updateDeviceProfileCallback(ctx, dic, dtos.FromDeviceProfileModelToDTO(d))
 	return nil
 }
 
@@ -73,8 +84,8 @@ func DeviceProfileByName(name string, ctx context.Context, dic *di.Container) (d
 	return deviceProfile, nil
 }
 
-// DeleteDeviceProfileByName delete the device profile by name
-func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+// DeleteDeviceProfileByName delete the device profile by name if it is at the expected revision
+func DeleteDeviceProfileByName(name string, revision int64, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -96,10 +107,16 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the device profile when associated provisionWatcher exists", nil)
 	}
 
-	err = dbClient.DeleteDeviceProfileByName(name)
+	dp, err := dbClient.DeviceProfileByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteDeviceProfileByName(name, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceProfile, name, dtos.FromDeviceProfileModelToDTO(dp), nil)
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceProfile, name)
 	return nil
 }
 
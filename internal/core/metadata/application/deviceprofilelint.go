@@ -0,0 +1,461 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"gopkg.in/yaml.v3"
+)
+
+// nodeSchema describes the expected shape of a YAML node, fields is nil for the mappings which accept any key
+type nodeSchema struct {
+	kind   yaml.Kind
+	fields map[string]*nodeSchema
+	items  *nodeSchema
+}
+
+var (
+	scalarNode    = &nodeSchema{kind: yaml.ScalarNode}
+	freeMapping   = &nodeSchema{kind: yaml.MappingNode}
+	profileSchema = &nodeSchema{kind: yaml.MappingNode, fields: map[string]*nodeSchema{
+		"id":              scalarNode,
+		"dbtimestamp":     freeMapping,
+		"name":            scalarNode,
+		"manufacturer":    scalarNode,
+		"description":     scalarNode,
+		"model":           scalarNode,
+		"labels":          {kind: yaml.SequenceNode, items: scalarNode},
+		"deviceResources": {kind: yaml.SequenceNode, items: resourceSchema},
+		"deviceCommands":  {kind: yaml.SequenceNode, items: commandSchema},
+	}}
+	resourceSchema = &nodeSchema{kind: yaml.MappingNode, fields: map[string]*nodeSchema{
+		"description": scalarNode,
+		"name":        scalarNode,
+		"isHidden":    scalarNode,
+		"tag":         scalarNode,
+		"attributes":  freeMapping,
+		"properties": {kind: yaml.MappingNode, fields: map[string]*nodeSchema{
+			"valueType":    scalarNode,
+			"readWrite":    scalarNode,
+			"units":        scalarNode,
+			"minimum":      scalarNode,
+			"maximum":      scalarNode,
+			"defaultValue": scalarNode,
+			"mask":         scalarNode,
+			"shift":        scalarNode,
+			"scale":        scalarNode,
+			"offset":       scalarNode,
+			"base":         scalarNode,
+			"assertion":    scalarNode,
+			"mediaType":    scalarNode,
+		}},
+	}}
+	commandSchema = &nodeSchema{kind: yaml.MappingNode, fields: map[string]*nodeSchema{
+		"name":      scalarNode,
+		"isHidden":  scalarNode,
+		"readWrite": scalarNode,
+		"resourceOperations": {kind: yaml.SequenceNode, items: &nodeSchema{kind: yaml.MappingNode, fields: map[string]*nodeSchema{
+			"deviceResource": scalarNode,
+			"defaultValue":   scalarNode,
+			"mappings":       freeMapping,
+		}}},
+	}}
+)
+
+var (
+	yamlErrorLine = regexp.MustCompile(`line (\d+): (.*)`)
+	// unitsFormat accepts the unit symbols and names such as "°C", "m/s^2", "kWh" or "degrees Fahrenheit"
+	unitsFormat = regexp.MustCompile(`^[\pL\pN%°'"/*^.·_()\-]+( [\pL\pN%°'"/*^.·_()\-]+)*$`)
+)
+
+// integerRanges holds the value range of the integer value types
+var integerRanges = map[string][2]float64{
+	common.ValueTypeUint8:  {0, math.MaxUint8},
+	common.ValueTypeUint16: {0, math.MaxUint16},
+	common.ValueTypeUint32: {0, math.MaxUint32},
+	common.ValueTypeUint64: {0, math.MaxUint64},
+	common.ValueTypeInt8:   {math.MinInt8, math.MaxInt8},
+	common.ValueTypeInt16:  {math.MinInt16, math.MaxInt16},
+	common.ValueTypeInt32:  {math.MinInt32, math.MaxInt32},
+	common.ValueTypeInt64:  {math.MinInt64, math.MaxInt64},
+}
+
+// lintProfile holds the linted fields of the device profile, dtos.DeviceProfile is not used because its YAML
+// unmarshaler stops at the first validation error
+type lintProfile struct {
+	Name            string                `yaml:"name"`
+	DeviceResources []dtos.DeviceResource `yaml:"deviceResources"`
+	DeviceCommands  []dtos.DeviceCommand  `yaml:"deviceCommands"`
+}
+
+type profileLinter struct {
+	// nodes holds the YAML node of each path for looking up the line numbers
+	nodes    map[string]*yaml.Node
+	problems []pkgDtos.DeviceProfileProblem
+}
+
+// LintDeviceProfileYaml checks the device profile YAML and returns all the problems found, sorted by the line number.
+// Besides the structure of the document, it checks the resource references of the device commands, the consistency
+// of the value type with the numeric properties, the duplicated names and the units format.
+func LintDeviceProfileYaml(data []byte) []pkgDtos.DeviceProfileProblem {
+	l := &profileLinter{nodes: make(map[string]*yaml.Node)}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		l.yamlError(err)
+		return l.problems
+	}
+	if len(root.Content) == 0 {
+		l.errorf("", "yaml file is empty")
+		return l.problems
+	}
+	doc := root.Content[0]
+	l.walk(doc, profileSchema, "")
+	if HasLintErrors(l.problems) {
+		// the shape of the document is wrong, decoding would only report the same problems again
+		return l.sorted()
+	}
+
+	var profile lintProfile
+	if err := doc.Decode(&profile); err != nil {
+		l.yamlError(err)
+		return l.sorted()
+	}
+	l.lintProfile(profile)
+	return l.sorted()
+}
+
+// HasLintErrors returns true if any of the problems is an error
+func HasLintErrors(problems []pkgDtos.DeviceProfileProblem) bool {
+	for _, p := range problems {
+		if p.Severity == pkgDtos.ProblemSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// walk checks the node against the schema and records the node of each path
+func (l *profileLinter) walk(n *yaml.Node, schema *nodeSchema, path string) {
+	if n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	l.nodes[path] = n
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+	if n.Kind != schema.kind {
+		l.errorf(path, "%s should be %s but got %s", fieldName(path), kindName(schema.kind), kindName(n.Kind))
+		return
+	}
+	switch n.Kind {
+	case yaml.MappingNode:
+		if schema.fields == nil {
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i], n.Content[i+1]
+			fieldPath := joinPath(path, key.Value)
+			fieldSchema, ok := schema.fields[key.Value]
+			if !ok {
+				l.nodes[fieldPath] = key
+				l.warnf(fieldPath, "unknown field %s is ignored", key.Value)
+				continue
+			}
+			l.walk(value, fieldSchema, fieldPath)
+		}
+	case yaml.SequenceNode:
+		for i, item := range n.Content {
+			l.walk(item, schema.items, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+func (l *profileLinter) lintProfile(profile lintProfile) {
+	if strings.TrimSpace(profile.Name) == "" {
+		l.errorf("name", "device profile name is required")
+	}
+	if len(profile.DeviceResources) == 0 {
+		l.errorf("deviceResources", "at least one device resource is required")
+	}
+
+	resources := make(map[string]dtos.DeviceResource)
+	resourcePaths := make(map[string]string)
+	for i, r := range profile.DeviceResources {
+		path := fmt.Sprintf("deviceResources[%d]", i)
+		l.lintResource(r, path)
+		if r.Name == "" {
+			continue
+		}
+		if first, ok := resourcePaths[r.Name]; ok {
+			l.errorf(joinPath(path, "name"), "device resource %s is duplicated, it is first defined%s", r.Name, l.atLine(joinPath(first, "name")))
+			continue
+		}
+		resources[r.Name] = r
+		resourcePaths[r.Name] = path
+	}
+
+	commandPaths := make(map[string]string)
+	for i, c := range profile.DeviceCommands {
+		path := fmt.Sprintf("deviceCommands[%d]", i)
+		l.lintCommand(c, path, resources)
+		if c.Name == "" {
+			continue
+		}
+		if first, ok := commandPaths[c.Name]; ok {
+			l.errorf(joinPath(path, "name"), "device command %s is duplicated, it is first defined%s", c.Name, l.atLine(joinPath(first, "name")))
+			continue
+		}
+		commandPaths[c.Name] = path
+		if _, ok := resources[c.Name]; ok {
+			l.warnf(joinPath(path, "name"), "device command %s hides the device resource with the same name from the core commands", c.Name)
+		}
+	}
+}
+
+func (l *profileLinter) lintResource(r dtos.DeviceResource, path string) {
+	if strings.TrimSpace(r.Name) == "" {
+		l.errorf(joinPath(path, "name"), "device resource name is required")
+	}
+	path = joinPath(path, "properties")
+	p := r.Properties
+	l.lintReadWrite(p.ReadWrite, joinPath(path, "readWrite"))
+
+	if p.ValueType == "" {
+		l.errorf(joinPath(path, "valueType"), "valueType is required")
+		return
+	}
+	valueType, err := common.NormalizeValueType(p.ValueType)
+	if err != nil {
+		l.errorf(joinPath(path, "valueType"), "unknown valueType %s", p.ValueType)
+		return
+	}
+
+	if p.Units != "" && !unitsFormat.MatchString(p.Units) {
+		l.warnf(joinPath(path, "units"), "units '%s' is not a valid unit symbol or name", p.Units)
+	}
+
+	numericFields := map[string]string{"minimum": p.Minimum, "maximum": p.Maximum, "scale": p.Scale, "offset": p.Offset, "base": p.Base}
+	bitFields := map[string]string{"mask": p.Mask, "shift": p.Shift}
+	integerRange, isInteger := integerRanges[valueType]
+	isNumeric := isInteger || valueType == common.ValueTypeFloat32 || valueType == common.ValueTypeFloat64
+	if !isNumeric {
+		for _, fields := range []map[string]string{numericFields, bitFields} {
+			for _, field := range sortedKeys(fields) {
+				if fields[field] != "" {
+					l.warnf(joinPath(path, field), "%s is ignored for valueType %s", field, valueType)
+				}
+			}
+		}
+		if valueType == common.ValueTypeBool && p.DefaultValue != "" {
+			if _, err := strconv.ParseBool(p.DefaultValue); err != nil {
+				l.errorf(joinPath(path, "defaultValue"), "defaultValue '%s' is not a Bool", p.DefaultValue)
+			}
+		}
+		return
+	}
+
+	numbers := make(map[string]float64)
+	for _, field := range sortedKeys(numericFields) {
+		if numericFields[field] == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(numericFields[field], 64)
+		if err != nil {
+			l.errorf(joinPath(path, field), "%s '%s' is not a number", field, numericFields[field])
+			continue
+		}
+		numbers[field] = n
+	}
+	for _, field := range []string{"minimum", "maximum"} {
+		n, ok := numbers[field]
+		if ok && isInteger && (n < integerRange[0] || n > integerRange[1]) {
+			l.errorf(joinPath(path, field), "%s %s is out of the range of valueType %s", field, numericFields[field], valueType)
+		}
+	}
+	minimum, hasMinimum := numbers["minimum"]
+	maximum, hasMaximum := numbers["maximum"]
+	if hasMinimum && hasMaximum && minimum > maximum {
+		l.errorf(joinPath(path, "minimum"), "minimum %s is greater than maximum %s", p.Minimum, p.Maximum)
+	}
+	if scale, ok := numbers["scale"]; ok && scale == 0 {
+		l.errorf(joinPath(path, "scale"), "scale must not be zero")
+	}
+
+	if p.DefaultValue != "" {
+		n, err := strconv.ParseFloat(p.DefaultValue, 64)
+		switch {
+		case err != nil:
+			l.errorf(joinPath(path, "defaultValue"), "defaultValue '%s' is not a number", p.DefaultValue)
+		case hasMinimum && n < minimum, hasMaximum && n > maximum:
+			l.errorf(joinPath(path, "defaultValue"), "defaultValue %s is out of the range between minimum and maximum", p.DefaultValue)
+		case isInteger && (n < integerRange[0] || n > integerRange[1] || n != math.Trunc(n)):
+			l.errorf(joinPath(path, "defaultValue"), "defaultValue %s is not a valid %s", p.DefaultValue, valueType)
+		}
+	}
+
+	for _, field := range sortedKeys(bitFields) {
+		value := bitFields[field]
+		if value == "" {
+			continue
+		}
+		if !isInteger {
+			l.warnf(joinPath(path, field), "%s is ignored for valueType %s", field, valueType)
+			continue
+		}
+		if _, err := strconv.ParseInt(value, 0, 64); err != nil {
+			l.errorf(joinPath(path, field), "%s '%s' is not an integer", field, value)
+		}
+	}
+}
+
+func (l *profileLinter) lintCommand(c dtos.DeviceCommand, path string, resources map[string]dtos.DeviceResource) {
+	if strings.TrimSpace(c.Name) == "" {
+		l.errorf(joinPath(path, "name"), "device command name is required")
+	}
+	validReadWrite := l.lintReadWrite(c.ReadWrite, joinPath(path, "readWrite"))
+	if len(c.ResourceOperations) == 0 {
+		l.errorf(joinPath(path, "resourceOperations"), "at least one resource operation is required")
+	}
+	for i, ro := range c.ResourceOperations {
+		roPath := joinPath(fmt.Sprintf("%s[%d]", joinPath(path, "resourceOperations"), i), "deviceResource")
+		if ro.DeviceResource == "" {
+			l.errorf(roPath, "deviceResource is required")
+			continue
+		}
+		r, ok := resources[ro.DeviceResource]
+		if !ok {
+			l.errorf(roPath, "device command's resource %s doesn't match any device resource", ro.DeviceResource)
+			continue
+		}
+		if validReadWrite && r.Properties.ReadWrite != common.ReadWrite_RW && r.Properties.ReadWrite != c.ReadWrite {
+			l.errorf(roPath, "device command's readWrite %s doesn't align with the readWrite %s of device resource %s",
+				c.ReadWrite, r.Properties.ReadWrite, r.Name)
+		}
+	}
+}
+
+func (l *profileLinter) lintReadWrite(readWrite string, path string) bool {
+	switch readWrite {
+	case common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW:
+		return true
+	case "":
+		l.errorf(path, "readWrite is required")
+	default:
+		l.errorf(path, "readWrite should be one of %s, %s and %s but got %s", common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW, readWrite)
+	}
+	return false
+}
+
+func (l *profileLinter) errorf(path string, format string, args ...interface{}) {
+	l.add(path, pkgDtos.ProblemSeverityError, fmt.Sprintf(format, args...))
+}
+
+func (l *profileLinter) warnf(path string, format string, args ...interface{}) {
+	l.add(path, pkgDtos.ProblemSeverityWarning, fmt.Sprintf(format, args...))
+}
+
+func (l *profileLinter) add(path string, severity string, message string) {
+	l.problems = append(l.problems, pkgDtos.DeviceProfileProblem{
+		Line:     l.line(path),
+		Path:     path,
+		Severity: severity,
+		Message:  message,
+	})
+}
+
+// line returns the line number of the path, the line of the closest parent is used if the path is absent
+func (l *profileLinter) line(path string) int {
+	for {
+		if n, ok := l.nodes[path]; ok {
+			return n.Line
+		}
+		if path == "" {
+			return 0
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			path = ""
+		} else {
+			path = path[:i]
+		}
+	}
+}
+
+func (l *profileLinter) atLine(path string) string {
+	if line := l.line(path); line > 0 {
+		return fmt.Sprintf(" at line %d", line)
+	}
+	return ""
+}
+
+// yamlError records the YAML syntax or decoding error, a decoding error may hold several problems each with its line
+func (l *profileLinter) yamlError(err error) {
+	messages := []string{err.Error()}
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		messages = typeErr.Errors
+	}
+	for _, m := range messages {
+		problem := pkgDtos.DeviceProfileProblem{Severity: pkgDtos.ProblemSeverityError, Message: m}
+		if match := yamlErrorLine.FindStringSubmatch(m); match != nil {
+			problem.Line, _ = strconv.Atoi(match[1])
+			problem.Message = match[2]
+		}
+		l.problems = append(l.problems, problem)
+	}
+}
+
+func (l *profileLinter) sorted() []pkgDtos.DeviceProfileProblem {
+	sort.SliceStable(l.problems, func(i, j int) bool {
+		return l.problems[i].Line < l.problems[j].Line
+	})
+	return l.problems
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "device profile"
+	}
+	return path
+}
+
+func kindName(kind yaml.Kind) string {
+	switch kind {
+	case yaml.MappingNode:
+		return "a mapping"
+	case yaml.SequenceNode:
+		return "a sequence"
+	default:
+		return "a scalar"
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
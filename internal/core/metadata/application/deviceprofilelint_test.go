@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validProfileYaml = `name: "Thermostat"
+manufacturer: "IOTech"
+labels: [ "hvac" ]
+deviceResources:
+  - name: "Temperature"
+    properties:
+      valueType: "Int16"
+      readWrite: "R"
+      units: "°C"
+      minimum: "-40"
+      maximum: "125"
+  - name: "SetPoint"
+    properties:
+      valueType: "Float32"
+      readWrite: "RW"
+      units: "degrees Celsius"
+      scale: "0.1"
+deviceCommands:
+  - name: "Climate"
+    readWrite: "R"
+    resourceOperations:
+      - { deviceResource: "Temperature" }
+      - { deviceResource: "SetPoint" }
+`
+
+const invalidProfileYaml = `name: "Thermostat"
+deviceResources:
+  - name: "Temperature"
+    properties:
+      valueType: "Uint8"
+      readWrite: "R"
+      minimum: "-1"
+      maximum: "abc"
+  - name: "Temperature"
+    properties:
+      valueType: "String"
+      readWrite: "RW"
+      scale: "10"
+      units: " C"
+  - name: "Humidity"
+    properties:
+      valueType: "Float64"
+      readWrite: "R"
+      minimum: "100"
+      maximum: "0"
+      scale: "0"
+      valuetype: "Float64"
+deviceCommands:
+  - name: "Climate"
+    readWrite: "W"
+    resourceOperations:
+      - { deviceResource: "Humidity" }
+      - { deviceResource: "Pressure" }
+  - name: "Climate"
+    readWrite: "R"
+    resourceOperations:
+      - { deviceResource: "Humidity" }
+`
+
+func TestLintDeviceProfileYaml(t *testing.T) {
+	problems := LintDeviceProfileYaml([]byte(validProfileYaml))
+	assert.Empty(t, problems)
+	assert.False(t, HasLintErrors(problems))
+
+	problems = LintDeviceProfileYaml([]byte(invalidProfileYaml))
+	require.True(t, HasLintErrors(problems))
+	expected := []pkgDtos.DeviceProfileProblem{
+		{Line: 7, Path: "deviceResources[0].properties.minimum", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 8, Path: "deviceResources[0].properties.maximum", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 9, Path: "deviceResources[1].name", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 13, Path: "deviceResources[1].properties.scale", Severity: pkgDtos.ProblemSeverityWarning},
+		{Line: 14, Path: "deviceResources[1].properties.units", Severity: pkgDtos.ProblemSeverityWarning},
+		{Line: 19, Path: "deviceResources[2].properties.minimum", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 21, Path: "deviceResources[2].properties.scale", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 22, Path: "deviceResources[2].properties.valuetype", Severity: pkgDtos.ProblemSeverityWarning},
+		{Line: 27, Path: "deviceCommands[0].resourceOperations[0].deviceResource", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 28, Path: "deviceCommands[0].resourceOperations[1].deviceResource", Severity: pkgDtos.ProblemSeverityError},
+		{Line: 29, Path: "deviceCommands[1].name", Severity: pkgDtos.ProblemSeverityError},
+	}
+	require.Len(t, problems, len(expected))
+	for i, p := range problems {
+		assert.Equal(t, expected[i].Line, p.Line, "line of problem %d not as expected", i)
+		assert.Equal(t, expected[i].Path, p.Path, "path of problem %d not as expected", i)
+		assert.Equal(t, expected[i].Severity, p.Severity, "severity of problem %d not as expected", i)
+		assert.NotEmpty(t, p.Message)
+	}
+	assert.Contains(t, problems[2].Message, "first defined at line 3")
+}
+
+func TestLintDeviceProfileYaml_MalformedDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		yaml         string
+		expectedLine int
+	}{
+		{"syntax error", "name: \"Thermostat\"\ndeviceResources:\n  - name: [\n", 3},
+		{"resources is not a sequence", "name: \"Thermostat\"\ndeviceResources:\n  name: \"Temperature\"\n", 3},
+		{"properties is not a mapping", "name: \"Thermostat\"\ndeviceResources:\n  - name: \"Temperature\"\n    properties: \"Int16\"\n", 4},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			problems := LintDeviceProfileYaml([]byte(testCase.yaml))
+			require.Len(t, problems, 1)
+			assert.Equal(t, pkgDtos.ProblemSeverityError, problems[0].Severity)
+			assert.Equal(t, testCase.expectedLine, problems[0].Line)
+		})
+	}
+}
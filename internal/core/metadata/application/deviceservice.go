@@ -10,7 +10,9 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
@@ -37,6 +39,8 @@ func AddDeviceService(d models.DeviceService, ctx context.Context, dic *di.Conta
 		addedDeviceService.Id,
 		correlationId,
 	)
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDeviceService, addedDeviceService.Name, nil, dtos.FromDeviceServiceModelToDTO(addedDeviceService))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDeviceService, addedDeviceService.Name)
 
 	return addedDeviceService.Id, nil
 }
@@ -56,7 +60,7 @@ func DeviceServiceByName(name string, ctx context.Context, dic *di.Container) (d
 }
 
 // PatchDeviceService executes the PATCH operation with the device service DTO to replace the old data
-func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *di.Container) errors.EdgeX {
+func PatchDeviceService(dto dtos.UpdateDeviceService, revision int64, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -77,9 +81,10 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device service name '%s' not match the exsting '%s' ", *dto.Name, deviceService.Name), nil)
 	}
 
+	before := dtos.FromDeviceServiceModelToDTO(deviceService)
 	requests.ReplaceDeviceServiceModelFieldsWithDTO(&deviceService, dto)
 
-	edgeXerr = dbClient.UpdateDeviceService(deviceService)
+	edgeXerr = dbClient.UpdateDeviceService(deviceService, revision)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -88,12 +93,14 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 		"DeviceService patched on DB successfully. Correlation-ID: %s ",
 		correlation.FromContext(ctx),
 	)
-	go updateDeviceServiceCallback(ctx, dic, deviceService)
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDeviceService, deviceService.Name, before, dtos.FromDeviceServiceModelToDTO(deviceService))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDeviceService, deviceService.Name)
+	updateDeviceServiceCallback(ctx, dic, deviceService)
 	return nil
 }
 
-// DeleteDeviceServiceByName delete the device service by name
-func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+// DeleteDeviceServiceByName delete the device service by name if it is at the expected revision
+func DeleteDeviceServiceByName(name string, revision int64, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -115,10 +122,16 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the device service when associated provisionWatcher exists", nil)
 	}
 
-	err = dbClient.DeleteDeviceServiceByName(name)
+	ds, err := dbClient.DeviceServiceByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteDeviceServiceByName(name, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceService, name, dtos.FromDeviceServiceModelToDTO(ds), nil)
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditDeviceService, name)
 	return nil
 }
 
@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AllDeviceServiceCallbacks query the callbacks of the outbox with offset and limit
+func AllDeviceServiceCallbacks(offset int, limit int, dic *di.Container) (callbacks []pkgDtos.DeviceServiceCallback, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	cbs, err := dbClient.AllDeviceServiceCallbacks(offset, limit)
+	if err != nil {
+		return callbacks, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceServiceCallbackModelsToDTOs(cbs), nil
+}
+
+// DeviceServiceCallbacksByStatus query the callbacks of the outbox with offset, limit, and status
+func DeviceServiceCallbacksByStatus(offset int, limit int, status string, dic *di.Container) (callbacks []pkgDtos.DeviceServiceCallback, err errors.EdgeX) {
+	if status != pkgModels.CallbackPending && status != pkgModels.CallbackFailed {
+		return callbacks, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid callback status '%s', the status should be %s or %s", status, pkgModels.CallbackPending, pkgModels.CallbackFailed), nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	cbs, err := dbClient.DeviceServiceCallbacksByStatus(offset, limit, status)
+	if err != nil {
+		return callbacks, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceServiceCallbackModelsToDTOs(cbs), nil
+}
+
+// DeviceServiceCallbacksByServiceName query the callbacks of the outbox with offset, limit, and device service name
+func DeviceServiceCallbacksByServiceName(offset int, limit int, name string, dic *di.Container) (callbacks []pkgDtos.DeviceServiceCallback, err errors.EdgeX) {
+	if name == "" {
+		return callbacks, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	cbs, err := dbClient.DeviceServiceCallbacksByServiceName(offset, limit, name)
+	if err != nil {
+		return callbacks, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceServiceCallbackModelsToDTOs(cbs), nil
+}
+
+// DeviceServiceCallbackById query the callback of the outbox by id
+func DeviceServiceCallbackById(id string, dic *di.Container) (callback pkgDtos.DeviceServiceCallback, err errors.EdgeX) {
+	if id == "" {
+		return callback, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	cb, err := dbClient.DeviceServiceCallbackById(id)
+	if err != nil {
+		return callback, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceServiceCallbackModelToDTO(cb), nil
+}
+
+// RetriggerDeviceServiceCallbackById resets the retry state of the callback and wakes up the delivery of its device service
+func RetriggerDeviceServiceCallbackById(id string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	cb, err := dbClient.DeviceServiceCallbackById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = resetDeviceServiceCallback(cb, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	container.CallbackDispatcherFrom(dic.Get).Notify(cb.ServiceName)
+
+	lc.Debugf("DeviceServiceCallback re-triggered successfully. Callback ID: %s, Correlation-ID: %s ", id, correlation.FromContext(ctx))
+	return nil
+}
+
+// RetriggerDeviceServiceCallbacksByServiceName resets the retry state of the failed callbacks of the device service
+// and wakes up the delivery of the device service
+func RetriggerDeviceServiceCallbacksByServiceName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	cbs, err := dbClient.DeviceServiceCallbacksByServiceName(0, -1, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, cb := range cbs {
+		if cb.Status != pkgModels.CallbackFailed {
+			continue
+		}
+		err = resetDeviceServiceCallback(cb, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	container.CallbackDispatcherFrom(dic.Get).Notify(name)
+
+	lc.Debugf("DeviceServiceCallbacks of device service %s re-triggered successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+func resetDeviceServiceCallback(cb pkgModels.DeviceServiceCallback, dic *di.Container) errors.EdgeX {
+	cb.Status = pkgModels.CallbackPending
+	cb.RetryCount = 0
+	cb.NextRetry = 0
+	return container.DBClientFrom(dic.Get).UpdateDeviceServiceCallback(cb)
+}
+
+// DeleteDeviceServiceCallbackById removes the callback from the outbox, the callback won't be delivered anymore. The
+// delivery of its device service is woken up since the callbacks held back by a failed one can be delivered now.
+func DeleteDeviceServiceCallbackById(id string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	cb, err := dbClient.DeviceServiceCallbackById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteDeviceServiceCallbackById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	container.CallbackDispatcherFrom(dic.Get).Notify(cb.ServiceName)
+	lc.Debugf("DeviceServiceCallback deleted successfully. Callback ID: %s, Correlation-ID: %s ", id, correlation.FromContext(ctx))
+	return nil
+}
@@ -0,0 +1,140 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// AllDeviceServiceHealth query the health records of the device services with offset and limit
+func AllDeviceServiceHealth(offset int, limit int, dic *di.Container) (health []pkgDtos.DeviceServiceHealth, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	hs, err := dbClient.AllDeviceServiceHealth(offset, limit)
+	if err != nil {
+		return health, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceServiceHealthModelsToDTOs(hs), nil
+}
+
+// DeviceServiceHealthByName query the health record of the device service by name
+func DeviceServiceHealthByName(name string, dic *di.Container) (health pkgDtos.DeviceServiceHealth, err errors.EdgeX) {
+	if name == "" {
+		return health, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	h, err := dbClient.DeviceServiceHealthByName(name)
+	if err != nil {
+		return health, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceServiceHealthModelToDTO(h), nil
+}
+
+// RecordDeviceServicePing records the result of pinging the device service. The devices of the device service are
+// marked DOWN once the consecutive failures reach the threshold, and they are brought UP again by the first
+// successful ping afterwards.
+func RecordDeviceServicePing(name string, pingErr error, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	liveness := container.ConfigurationFrom(dic.Get).Writable.ServiceLiveness
+
+	health, err := dbClient.DeviceServiceHealthByName(name)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		health = pkgModels.DeviceServiceHealth{ServiceName: name, Status: pkgModels.ServiceReachable}
+	} else if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	now := pkgCommon.MakeTimestamp()
+	health.LastChecked = now
+	if pingErr == nil {
+		health.ConsecutiveFailures = 0
+		health.LastError = ""
+		err = dbClient.UpdateDeviceServiceLastConnected(name, now)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if health.Status == pkgModels.ServiceUnreachable {
+			lc.Infof("Device service %s is reachable again. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+			health.Status = pkgModels.ServiceReachable
+			restoreDevicesUp(health.DownDevices, name, liveness.NotifyOnTransition, ctx, dic)
+			health.DownDevices = nil
+		}
+	} else {
+		health.ConsecutiveFailures = health.ConsecutiveFailures + 1
+		health.LastError = pingErr.Error()
+		threshold := liveness.FailureThreshold
+		if threshold < 1 {
+			threshold = 1
+		}
+		if health.Status != pkgModels.ServiceUnreachable && health.ConsecutiveFailures >= threshold {
+			lc.Warnf("Device service %s is unreachable after %d failed pings, err: %v. Correlation-ID: %s ",
+				name, health.ConsecutiveFailures, pingErr, correlation.FromContext(ctx))
+			health.Status = pkgModels.ServiceUnreachable
+			health.DownDevices, err = markServiceDevicesDown(name, liveness.NotifyOnTransition, ctx, dic)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+	}
+
+	err = dbClient.UpdateDeviceServiceHealth(health)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// markServiceDevicesDown marks the UP devices of the device service DOWN and returns their names, the devices which
+// are already DOWN are not returned since they must not be brought UP when the device service recovers
+func markServiceDevicesDown(serviceName string, notify bool, ctx context.Context, dic *di.Container) ([]string, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	devices, err := dbClient.DevicesByServiceName(0, -1, serviceName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	reason := fmt.Sprintf("the device service %s is unreachable", serviceName)
+	var names []string
+	for _, d := range devices {
+		if d.OperatingState != models.Up {
+			continue
+		}
+		err = UpdateDeviceOperatingState(d.Name, models.Down, reason, notify, ctx, dic)
+		if err != nil {
+			lc.Errorf("fail to mark the device %s DOWN, err: %v", d.Name, err)
+			continue
+		}
+		names = append(names, d.Name)
+	}
+	return names, nil
+}
+
+// restoreDevicesUp brings the devices marked DOWN by the device service liveness monitoring UP again
+func restoreDevicesUp(names []string, serviceName string, notify bool, ctx context.Context, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	reason := fmt.Sprintf("the device service %s is reachable again", serviceName)
+	for _, name := range names {
+		err := UpdateDeviceOperatingState(name, models.Up, reason, notify, ctx, dic)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			continue
+		} else if err != nil {
+			lc.Errorf("fail to bring the device %s UP, err: %v", name, err)
+		}
+	}
+}
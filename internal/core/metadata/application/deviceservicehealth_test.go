@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceName = "testServiceName"
+
+func mockDic(dbClient *dbMock.DBClient) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					ServiceLiveness: config.ServiceLivenessInfo{
+						FailureThreshold: 2,
+					},
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		container.CallbackDispatcherName: func(get di.Get) interface{} {
+			dispatcherMock := &dbMock.CallbackDispatcher{}
+			dispatcherMock.On("Notify", mock.Anything).Return()
+			return dispatcherMock
+		},
+	})
+}
+
+func TestRecordDeviceServicePing(t *testing.T) {
+	upDevice := models.Device{Name: "upDevice", ServiceName: testServiceName, OperatingState: models.Up}
+	downDevice := models.Device{Name: "downDevice", ServiceName: testServiceName, OperatingState: models.Down}
+	markedDevice := models.Device{Name: "markedDevice", ServiceName: testServiceName, OperatingState: models.Down}
+	pingErr := fmt.Errorf("connection refused")
+
+	reachable := pkgModels.DeviceServiceHealth{ServiceName: testServiceName, Status: pkgModels.ServiceReachable}
+	failedOnce := reachable
+	failedOnce.ConsecutiveFailures = 1
+	unreachable := pkgModels.DeviceServiceHealth{
+		ServiceName:         testServiceName,
+		Status:              pkgModels.ServiceUnreachable,
+		ConsecutiveFailures: 5,
+		DownDevices:         []string{markedDevice.Name},
+	}
+
+	tests := []struct {
+		name                string
+		health              *pkgModels.DeviceServiceHealth
+		pingErr             error
+		expectedStatus      string
+		expectedFailures    int
+		expectedDownDevices []string
+		expectedUpdated     []models.Device
+	}{
+		{"first success", nil, nil, pkgModels.ServiceReachable, 0, nil, nil},
+		{"failure below the threshold", &reachable, pingErr, pkgModels.ServiceReachable, 1, nil, nil},
+		{"failure reaches the threshold", &failedOnce, pingErr, pkgModels.ServiceUnreachable, 2, []string{upDevice.Name},
+			[]models.Device{{Name: upDevice.Name, ServiceName: testServiceName, OperatingState: models.Down}}},
+		{"failure while unreachable", &unreachable, pingErr, pkgModels.ServiceUnreachable, 6, []string{markedDevice.Name}, nil},
+		{"recovered", &unreachable, nil, pkgModels.ServiceReachable, 0, nil,
+			[]models.Device{{Name: markedDevice.Name, ServiceName: testServiceName, OperatingState: models.Up}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var recorded pkgModels.DeviceServiceHealth
+			dbClientMock := &dbMock.DBClient{}
+			if testCase.health == nil {
+				dbClientMock.On("DeviceServiceHealthByName", testServiceName).Return(pkgModels.DeviceServiceHealth{},
+					errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+			} else {
+				dbClientMock.On("DeviceServiceHealthByName", testServiceName).Return(*testCase.health, nil)
+			}
+			dbClientMock.On("UpdateDeviceServiceHealth", mock.Anything).Run(func(args mock.Arguments) {
+				recorded = args.Get(0).(pkgModels.DeviceServiceHealth)
+			}).Return(nil)
+			dbClientMock.On("UpdateDeviceServiceLastConnected", testServiceName, mock.Anything).Return(nil)
+			dbClientMock.On("DevicesByServiceName", 0, -1, testServiceName).Return([]models.Device{upDevice, downDevice}, nil)
+			dbClientMock.On("DeviceByName", upDevice.Name).Return(upDevice, nil)
+			dbClientMock.On("DeviceByName", markedDevice.Name).Return(markedDevice, nil)
+			dbClientMock.On("Revision", mock.Anything).Return(int64(1), nil)
+			dbClientMock.On("UpdateDevice", mock.Anything, int64(1)).Return(nil)
+			dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+
+			err := RecordDeviceServicePing(testServiceName, testCase.pingErr, context.Background(), mockDic(dbClientMock))
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedStatus, string(recorded.Status))
+			assert.Equal(t, testCase.expectedFailures, recorded.ConsecutiveFailures)
+			assert.Equal(t, testCase.expectedDownDevices, recorded.DownDevices)
+			assert.NotZero(t, recorded.LastChecked)
+			if testCase.pingErr == nil {
+				assert.Empty(t, recorded.LastError)
+				dbClientMock.AssertCalled(t, "UpdateDeviceServiceLastConnected", testServiceName, mock.Anything)
+			} else {
+				assert.NotEmpty(t, recorded.LastError)
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceServiceLastConnected", testServiceName, mock.Anything)
+			}
+			dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", len(testCase.expectedUpdated))
+			for _, d := range testCase.expectedUpdated {
+				dbClientMock.AssertCalled(t, "UpdateDevice", d, int64(1))
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// The columns of the variables CSV which set the device fields directly, every other column is only used to replace
+// the placeholders of the template
+const (
+	templateNameColumn        = "name"
+	templateDescriptionColumn = "description"
+)
+
+var templatePlaceholderRegex = regexp.MustCompile(`{{\s*([A-Za-z0-9_.-]+)\s*}}`)
+
+// AddDeviceTemplate adds a new device template after checking the device service, the device profile and the
+// placeholders of the protocol properties
+func AddDeviceTemplate(t pkgModels.DeviceTemplate, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, err := templatePlaceholders(t); err != nil {
+		return id, errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := checkTemplateReferences(t, dic); err != nil {
+		return id, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	addedTemplate, err := dbClient.AddDeviceTemplate(t)
+	if err != nil {
+		return id, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceTemplate created on DB successfully. DeviceTemplate ID: %s, Correlation-ID: %s ",
+		addedTemplate.Id,
+		correlation.FromContext(ctx),
+	)
+	return addedTemplate.Id, nil
+}
+
+// DeviceTemplateByName queries the device template by name
+func DeviceTemplateByName(name string, dic *di.Container) (template pkgDtos.DeviceTemplate, err errors.EdgeX) {
+	if name == "" {
+		return template, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	t, err := dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		return template, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceTemplateModelToDTO(t), nil
+}
+
+// AllDeviceTemplates queries the device templates with offset and limit
+func AllDeviceTemplates(offset int, limit int, dic *di.Container) (templates []pkgDtos.DeviceTemplate, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	ts, err := dbClient.AllDeviceTemplates(offset, limit)
+	if err != nil {
+		return templates, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceTemplateModelsToDTOs(ts), nil
+}
+
+// DeleteDeviceTemplateByName deletes the device template by name, the devices instantiated from it are kept
+func DeleteDeviceTemplateByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	err := dbClient.DeleteDeviceTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// InstantiateDeviceTemplate creates a device from the template for each record of the variables CSV, the first record
+// is the header naming the variables. All the devices are validated before any of them is added, and the devices
+// already added are removed again if adding one of them fails, so either all the devices are created or none. The
+// callbacks are queued only once all the devices are added, a callback which can't be queued is logged without
+// affecting the callbacks of the other devices. In a dry run the devices are only validated and returned.
+func InstantiateDeviceTemplate(name string, records [][]string, dryRun bool, ctx context.Context, dic *di.Container) ([]dtos.Device, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	t, err := dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = checkTemplateReferences(t, dic); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	devices, err := devicesFromTemplate(t, records, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !dryRun {
+		for i, d := range devices {
+			added, err := dbClient.AddDevice(d)
+			if err != nil {
+				rollbackTemplateDevices(devices[:i], dic)
+				return nil, errors.NewCommonEdgeX(errors.Kind(err),
+					fmt.Sprintf("fail to add device %s of row %d, the %d devices already added are removed", d.Name, i+2, i), err)
+			}
+			devices[i] = added
+		}
+		lc.Debugf("%d devices instantiated from DeviceTemplate %s. Correlation-ID: %s ",
+			len(devices),
+			name,
+			correlation.FromContext(ctx),
+		)
+	}
+
+	deviceDTOs := make([]dtos.Device, len(devices))
+	for i, d := range devices {
+		deviceDTOs[i] = dtos.FromDeviceModelToDTO(d)
+		if !dryRun {
+			audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDevice, d.Name, nil, deviceDTOs[i])
+			go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditDevice, d.Name)
+			addDeviceCallback(ctx, dic, deviceDTOs[i])
+		}
+	}
+	return deviceDTOs, nil
+}
+
+// devicesFromTemplate builds and validates a device for each record of the variables CSV, the problems of all the
+// records are reported together with their row numbers
+func devicesFromTemplate(t pkgModels.DeviceTemplate, records [][]string, dic *di.Container) ([]models.Device, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	placeholders, err := templatePlaceholders(t)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(records) < 2 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "csv file has no device record after the header", nil)
+	}
+	header := records[0]
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if _, ok := columns[column]; ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("csv header has duplicate column %s", column), nil)
+		}
+		columns[column] = i
+	}
+	if _, ok := columns[templateNameColumn]; !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("csv header has no %s column", templateNameColumn), nil)
+	}
+	var missing []string
+	for _, p := range placeholders {
+		if _, ok := columns[p]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("csv header has no column for the placeholders %s of device template %s", strings.Join(missing, ", "), t.Name), nil)
+	}
+
+	var problems []string
+	devices := make([]models.Device, 0, len(records)-1)
+	rowOfName := make(map[string]int, len(records)-1)
+	for i, record := range records[1:] {
+		row := i + 2
+		vars := make(map[string]string, len(columns))
+		for column, index := range columns {
+			vars[column] = strings.TrimSpace(record[index])
+		}
+
+		d, err := deviceFromTemplate(t, vars)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("row %d: %s", row, err.Error()))
+			continue
+		}
+		if first, ok := rowOfName[d.Name]; ok {
+			problems = append(problems, fmt.Sprintf("row %d: device name %s is already used by row %d", row, d.Name, first))
+			continue
+		}
+		rowOfName[d.Name] = row
+		exists, err := dbClient.DeviceNameExists(d.Name)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		} else if exists {
+			problems = append(problems, fmt.Sprintf("row %d: device %s already exists", row, d.Name))
+			continue
+		}
+		devices = append(devices, d)
+	}
+	if len(problems) > 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("%d of %d devices are invalid: %s", len(problems), len(records)-1, strings.Join(problems, "; ")), nil)
+	}
+	return devices, nil
+}
+
+// deviceFromTemplate builds a device from the template by replacing the placeholders with the variables of the device
+func deviceFromTemplate(t pkgModels.DeviceTemplate, vars map[string]string) (models.Device, errors.EdgeX) {
+	d := models.Device{
+		Name:           vars[templateNameColumn],
+		Description:    t.Description,
+		AdminState:     t.AdminState,
+		OperatingState: models.Up,
+		Labels:         t.Labels,
+		ServiceName:    t.ServiceName,
+		ProfileName:    t.ProfileName,
+		AutoEvents:     t.AutoEvents,
+		Protocols:      make(map[string]models.ProtocolProperties, len(t.Protocols)),
+	}
+	if description, ok := vars[templateDescriptionColumn]; ok && description != "" {
+		d.Description = description
+	}
+
+	for protocol, properties := range t.Protocols {
+		rendered := make(models.ProtocolProperties, len(properties))
+		for key, value := range properties {
+			var empty []string
+			rendered[key] = templatePlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+				variable := templatePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+				if vars[variable] == "" {
+					empty = append(empty, variable)
+				}
+				return vars[variable]
+			})
+			if len(empty) > 0 {
+				return d, errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("variable %s of protocol property %s.%s is empty", strings.Join(empty, ", "), protocol, key), nil)
+			}
+		}
+		d.Protocols[protocol] = rendered
+	}
+
+	if err := common.Validate(dtos.FromDeviceModelToDTO(d)); err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device", err)
+	}
+	return d, nil
+}
+
+// templatePlaceholders returns the sorted names of the placeholders used by the protocol properties of the template,
+// a value with a malformed placeholder is reported as an error
+func templatePlaceholders(t pkgModels.DeviceTemplate) ([]string, errors.EdgeX) {
+	names := make(map[string]struct{})
+	for protocol, properties := range t.Protocols {
+		for key, value := range properties {
+			rest := templatePlaceholderRegex.ReplaceAllString(value, "")
+			if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("protocol property %s.%s has a malformed placeholder in %q", protocol, key, value), nil)
+			}
+			for _, match := range templatePlaceholderRegex.FindAllStringSubmatch(value, -1) {
+				names[match[1]] = struct{}{}
+			}
+		}
+	}
+	placeholders := make([]string, 0, len(names))
+	for name := range names {
+		placeholders = append(placeholders, name)
+	}
+	sort.Strings(placeholders)
+	return placeholders, nil
+}
+
+// checkTemplateReferences checks that the device service and the device profile of the template exist
+func checkTemplateReferences(t pkgModels.DeviceTemplate, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	exists, err := dbClient.DeviceServiceNameExists(t.ServiceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service '%s' does not exists", t.ServiceName), nil)
+	}
+	exists, err = dbClient.DeviceProfileNameExists(t.ProfileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exists", t.ProfileName), nil)
+	}
+	return nil
+}
+
+// rollbackTemplateDevices removes the devices added before the instantiation failed, the device service is not
+// notified of them since the callbacks are only sent once all the devices are added
+func rollbackTemplateDevices(devices []models.Device, dic *di.Container) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	for _, d := range devices {
+		if err := dbClient.DeleteDeviceById(d.Id); err != nil {
+			lc.Errorf("fail to remove device %s while rolling back the device template instantiation, err: %v", d.Name, err)
+		}
+	}
+}
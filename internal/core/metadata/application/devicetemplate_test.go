@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testTemplateName = "modbusThermostat"
+
+func testDeviceTemplate() pkgModels.DeviceTemplate {
+	return pkgModels.DeviceTemplate{
+		Name:        testTemplateName,
+		Description: "Modbus thermostat",
+		ServiceName: testServiceName,
+		ProfileName: testProfileName,
+		Labels:      []string{"hvac"},
+		AdminState:  models.Unlocked,
+		Protocols: map[string]models.ProtocolProperties{
+			"modbus-tcp": {"Address": "{{ address }}", "Port": "502", "UnitID": "{{unit}}"},
+		},
+		AutoEvents: []models.AutoEvent{{Interval: "10s", SourceName: "Temperature"}},
+	}
+}
+
+func TestTemplatePlaceholders(t *testing.T) {
+	template := testDeviceTemplate()
+	placeholders, err := templatePlaceholders(template)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"address", "unit"}, placeholders)
+
+	template.Protocols["modbus-tcp"]["Port"] = "{{port"
+	_, err = templatePlaceholders(template)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestInstantiateDeviceTemplate(t *testing.T) {
+	records := [][]string{
+		{"name", "address", "unit", "description"},
+		{"thermostat-1", "10.0.0.1", "1", ""},
+		{"thermostat-2", "10.0.0.2", "2", "Second floor"},
+	}
+
+	tests := []struct {
+		name          string
+		records       [][]string
+		existingName  string
+		failingName   string
+		dryRun        bool
+		errorExpected bool
+		errorContains string
+	}{
+		{"instantiated", records, "", "", false, false, ""},
+		{"dry run", records, "", "", true, false, ""},
+		{"no name column", [][]string{{"address", "unit"}, {"10.0.0.1", "1"}}, "", "", false, true, "no name column"},
+		{"no placeholder column", [][]string{{"name", "address"}, {"thermostat-1", "10.0.0.1"}}, "", "", false, true, "placeholders unit"},
+		{"no device record", records[:1], "", "", false, true, "no device record"},
+		{"empty variable", [][]string{records[0], {"thermostat-1", "", "1", ""}}, "", "", false, true, "row 2: variable address"},
+		{"duplicate name", [][]string{records[0], records[1], records[1]}, "", "", false, true, "row 3: device name thermostat-1 is already used by row 2"},
+		{"existing device", records, "thermostat-2", "", false, true, "row 3: device thermostat-2 already exists"},
+		{"rollback", records, "", "thermostat-2", false, true, "the 1 devices already added are removed"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceTemplateByName", testTemplateName).Return(testDeviceTemplate(), nil)
+			dbClientMock.On("DeviceServiceNameExists", testServiceName).Return(true, nil)
+			dbClientMock.On("DeviceProfileNameExists", testProfileName).Return(true, nil)
+			dbClientMock.On("DeviceNameExists", testCase.existingName).Return(true, nil)
+			dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("AddDevice", mock.MatchedBy(func(d models.Device) bool { return d.Name == testCase.failingName })).
+				Return(models.Device{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "device name exists", nil))
+			dbClientMock.On("AddDevice", mock.Anything).Return(func(d models.Device) models.Device {
+				d.Id = d.Name + "-id"
+				return d
+			}, nil)
+			dbClientMock.On("DeleteDeviceById", mock.Anything).Return(nil)
+			dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+			dic := mockDic(dbClientMock)
+
+			devices, err := InstantiateDeviceTemplate(testTemplateName, testCase.records, testCase.dryRun, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.errorContains)
+				if testCase.failingName != "" {
+					dbClientMock.AssertCalled(t, "DeleteDeviceById", "thermostat-1-id")
+				} else {
+					dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+				}
+				dbClientMock.AssertNotCalled(t, "AddDeviceServiceCallback", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, devices, 2)
+			assert.Equal(t, "thermostat-1", devices[0].Name)
+			assert.Equal(t, "Modbus thermostat", devices[0].Description)
+			assert.Equal(t, "Second floor", devices[1].Description)
+			assert.Equal(t, "10.0.0.2", devices[1].Protocols["modbus-tcp"]["Address"])
+			assert.Equal(t, "2", devices[1].Protocols["modbus-tcp"]["UnitID"])
+			assert.Equal(t, "502", devices[1].Protocols["modbus-tcp"]["Port"])
+			assert.Equal(t, models.Up, devices[1].OperatingState)
+			assert.Len(t, devices[1].AutoEvents, 1)
+			if testCase.dryRun {
+				assert.Empty(t, devices[0].Id)
+				dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+				dbClientMock.AssertNotCalled(t, "AddDeviceServiceCallback", mock.Anything)
+			} else {
+				assert.Equal(t, "thermostat-1-id", devices[0].Id)
+				dbClientMock.AssertNumberOfCalls(t, "AddDeviceServiceCallback", 2)
+			}
+		})
+	}
+}
+
+func TestInstantiateDeviceTemplateOutboxFailure(t *testing.T) {
+	records := [][]string{
+		{"name", "address", "unit"},
+		{"thermostat-1", "10.0.0.1", "1"},
+		{"thermostat-2", "10.0.0.2", "2"},
+		{"thermostat-3", "10.0.0.3", "3"},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", testTemplateName).Return(testDeviceTemplate(), nil)
+	dbClientMock.On("DeviceServiceNameExists", testServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", testProfileName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+	dbClientMock.On("AddDevice", mock.Anything).Return(func(d models.Device) models.Device {
+		d.Id = d.Name + "-id"
+		return d
+	}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.MatchedBy(func(cb pkgModels.DeviceServiceCallback) bool { return cb.EntityName == "thermostat-2" })).
+		Return(pkgModels.DeviceServiceCallback{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "outbox unavailable", nil))
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dic := mockDic(dbClientMock)
+
+	// the devices are kept and the device after the failed callback is still called back
+	devices, err := InstantiateDeviceTemplate(testTemplateName, records, false, context.Background(), dic)
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceServiceCallback", 3)
+	dbClientMock.AssertNotCalled(t, "DeleteDeviceById", mock.Anything)
+}
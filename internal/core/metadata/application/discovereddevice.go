@@ -0,0 +1,294 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// SubmitDiscoveredDevice matches the discovered device against the provision watchers of its device service. A
+// matched device is either added right away or parked in the approval queue, depending on the Writable.Discovery
+// configuration. The returned id is the id of the added device or the queued discovered device.
+func SubmitDiscoveredDevice(d pkgModels.DiscoveredDevice, ctx context.Context, dic *di.Container) (id string, result pkgModels.DiscoveryResult, watcherName string, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	exists, err := dbClient.DeviceNameExists(d.Name)
+	if err != nil {
+		return id, result, watcherName, errors.NewCommonEdgeXWrapper(err)
+	} else if exists {
+		return id, pkgModels.DiscoveryExists, watcherName, nil
+	}
+
+	queued, err := dbClient.DiscoveredDeviceByName(d.Name)
+	isQueued := err == nil
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return id, result, watcherName, errors.NewCommonEdgeXWrapper(err)
+	}
+	if isQueued && queued.Status == pkgModels.DiscoveredDeviceRejected {
+		return queued.Id, pkgModels.DiscoveryRejected, queued.ProvisionWatcherName, nil
+	}
+
+	watchers, err := dbClient.ProvisionWatchersByServiceName(0, -1, d.ServiceName)
+	if err != nil {
+		return id, result, watcherName, errors.NewCommonEdgeXWrapper(err)
+	}
+	pw, result := matchProvisionWatchers(d.Protocols, watchers, lc)
+	if result != "" {
+		return id, result, watcherName, nil
+	}
+	d.ProvisionWatcherName = pw.Name
+	d.ProfileName = pw.ProfileName
+
+	if !requireApproval(pw.Name, dic) {
+		id, err = AddDevice(deviceFromDiscovery(d, pw), ctx, dic)
+		if err != nil {
+			return id, result, pw.Name, errors.NewCommonEdgeXWrapper(err)
+		}
+		if isQueued {
+			if err = dbClient.DeleteDiscoveredDeviceById(queued.Id); err != nil {
+				lc.Errorf("fail to remove the discovered device %s from the approval queue, err: %v", d.Name, err)
+			}
+		}
+		return id, pkgModels.DiscoveryCreated, pw.Name, nil
+	}
+
+	d.Status = pkgModels.DiscoveredDevicePending
+	if isQueued {
+		d.Id = queued.Id
+		err = dbClient.UpdateDiscoveredDevice(d)
+	} else {
+		d, err = dbClient.AddDiscoveredDevice(d)
+	}
+	if err != nil {
+		return id, result, pw.Name, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("Discovered device %s matching provision watcher %s is waiting for the approval. Correlation-ID: %s ",
+		d.Name, pw.Name, correlation.FromContext(ctx))
+	return d.Id, pkgModels.DiscoveryPending, pw.Name, nil
+}
+
+// ApproveDiscoveredDevice adds the pending discovered device as a device and removes it from the approval queue
+func ApproveDiscoveredDevice(id string, ctx context.Context, dic *di.Container) (deviceId string, err errors.EdgeX) {
+	if id == "" {
+		return deviceId, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	d, err := dbClient.DiscoveredDeviceById(id)
+	if err != nil {
+		return deviceId, errors.NewCommonEdgeXWrapper(err)
+	}
+	if d.Status != pkgModels.DiscoveredDevicePending {
+		return deviceId, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("discovered device %s is %s and can't be approved", d.Name, d.Status), nil)
+	}
+	// the provision watcher may be changed or deleted after the device was discovered, so it is only used for the
+	// settings which are not recorded with the discovered device
+	pw, err := dbClient.ProvisionWatcherByName(d.ProvisionWatcherName)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return deviceId, errors.NewCommonEdgeXWrapper(err)
+	}
+	pw.ServiceName = d.ServiceName
+	pw.ProfileName = d.ProfileName
+
+	deviceId, err = AddDevice(deviceFromDiscovery(d, pw), ctx, dic)
+	if err != nil {
+		return deviceId, errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = dbClient.DeleteDiscoveredDeviceById(d.Id); err != nil {
+		lc.Errorf("fail to remove the approved device %s from the approval queue, err: %v", d.Name, err)
+	}
+	return deviceId, nil
+}
+
+// RejectDiscoveredDevice marks the pending discovered device as rejected, so it is ignored when it is discovered again
+// until it is deleted from the approval queue
+func RejectDiscoveredDevice(id string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	d, err := dbClient.DiscoveredDeviceById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if d.Status != pkgModels.DiscoveredDevicePending {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("discovered device %s is %s and can't be rejected", d.Name, d.Status), nil)
+	}
+	d.Status = pkgModels.DiscoveredDeviceRejected
+	if err = dbClient.UpdateDiscoveredDevice(d); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("Discovered device %s is rejected. Correlation-ID: %s ", d.Name, correlation.FromContext(ctx))
+	return nil
+}
+
+// DeleteDiscoveredDeviceById removes the discovered device from the approval queue
+func DeleteDiscoveredDeviceById(id string, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	err := dbClient.DeleteDiscoveredDeviceById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// DiscoveredDeviceById query the discovered device by id
+func DiscoveredDeviceById(id string, dic *di.Container) (device pkgDtos.DiscoveredDevice, err errors.EdgeX) {
+	if id == "" {
+		return device, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	d, err := dbClient.DiscoveredDeviceById(id)
+	if err != nil {
+		return device, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDiscoveredDeviceModelToDTO(d), nil
+}
+
+// AllDiscoveredDevices query the discovered devices of the approval queue with offset and limit
+func AllDiscoveredDevices(offset int, limit int, dic *di.Container) (devices []pkgDtos.DiscoveredDevice, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	ds, err := dbClient.AllDiscoveredDevices(offset, limit)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDiscoveredDeviceModelsToDTOs(ds), nil
+}
+
+// DiscoveredDevicesByStatus query the discovered devices of the approval queue with offset, limit and status
+func DiscoveredDevicesByStatus(offset int, limit int, status string, dic *di.Container) (devices []pkgDtos.DiscoveredDevice, err errors.EdgeX) {
+	if status != pkgModels.DiscoveredDevicePending && status != pkgModels.DiscoveredDeviceRejected {
+		return devices, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid discovered device status '%s', the status should be %s or %s", status, pkgModels.DiscoveredDevicePending, pkgModels.DiscoveredDeviceRejected), nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	ds, err := dbClient.DiscoveredDevicesByStatus(offset, limit, status)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDiscoveredDeviceModelsToDTOs(ds), nil
+}
+
+// matchProvisionWatchers returns the first unlocked provision watcher matching the protocols. The result is set to
+// BLOCKED or NOT_MATCHED if there is no such provision watcher.
+func matchProvisionWatchers(protocols map[string]models.ProtocolProperties, watchers []models.ProvisionWatcher, lc logger.LoggingClient) (models.ProvisionWatcher, pkgModels.DiscoveryResult) {
+	var result pkgModels.DiscoveryResult = pkgModels.DiscoveryNotMatched
+	for _, pw := range watchers {
+		if pw.AdminState != models.Unlocked {
+			continue
+		}
+		identifiers, err := compileIdentifiers(pw.Identifiers)
+		if err != nil {
+			lc.Errorf("invalid identifiers of provision watcher %s, err: %v", pw.Name, err)
+			continue
+		}
+		if !identifiersMatch(protocols, identifiers) {
+			continue
+		}
+		if blockingIdentifiersMatch(protocols, pw.BlockingIdentifiers) {
+			result = pkgModels.DiscoveryBlocked
+			continue
+		}
+		return pw, ""
+	}
+	return models.ProvisionWatcher{}, result
+}
+
+// compileIdentifiers compiles the regular expressions of the identifiers, so each of them is compiled once rather than
+// for every protocol it's matched against
+func compileIdentifiers(identifiers map[string]string) (map[string]*regexp.Regexp, error) {
+	compiled := make(map[string]*regexp.Regexp, len(identifiers))
+	for name, expr := range identifiers {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		compiled[name] = re
+	}
+	return compiled, nil
+}
+
+// identifiersMatch checks whether one of the protocols has all the identifiers, and each of their values matches the
+// regular expression of the identifier. The matching is the same as the one done by the device service SDK, except
+// that no identifiers match no device rather than every device of the device service.
+func identifiersMatch(protocols map[string]models.ProtocolProperties, identifiers map[string]*regexp.Regexp) bool {
+	if len(identifiers) == 0 {
+		return false
+	}
+	for _, protocol := range protocols {
+		matchedCount := 0
+		for name, re := range identifiers {
+			value, ok := protocol[name]
+			if !ok || !re.MatchString(value) {
+				break
+			}
+			matchedCount++
+		}
+		if matchedCount == len(identifiers) {
+			return true
+		}
+	}
+	return false
+}
+
+// blockingIdentifiersMatch checks whether any protocol property equals one of the blocked values
+func blockingIdentifiersMatch(protocols map[string]models.ProtocolProperties, blockingIdentifiers map[string][]string) bool {
+	for _, protocol := range protocols {
+		for name, blocked := range blockingIdentifiers {
+			value, ok := protocol[name]
+			if !ok {
+				continue
+			}
+			for _, v := range blocked {
+				if value == v {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func requireApproval(watcherName string, dic *di.Container) bool {
+	discovery := container.ConfigurationFrom(dic.Get).Writable.Discovery
+	if required, ok := discovery.ProvisionWatcherRequireApproval[watcherName]; ok {
+		return required
+	}
+	return discovery.RequireApproval
+}
+
+func deviceFromDiscovery(d pkgModels.DiscoveredDevice, pw models.ProvisionWatcher) models.Device {
+	return models.Device{
+		Name:           d.Name,
+		Description:    d.Description,
+		Labels:         d.Labels,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Protocols:      d.Protocols,
+		ServiceName:    pw.ServiceName,
+		ProfileName:    pw.ProfileName,
+		AutoEvents:     pw.AutoEvents,
+	}
+}
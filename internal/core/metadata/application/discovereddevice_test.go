@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDiscoveredDeviceName = "testDiscoveredDevice"
+	testDiscoveredDeviceId   = "testDiscoveredDeviceId"
+	testWatcherName          = "testWatcher"
+	testProfileName          = "testProfile"
+)
+
+func TestSubmitDiscoveredDevice(t *testing.T) {
+	watcher := models.ProvisionWatcher{
+		Name:                testWatcherName,
+		Identifiers:         map[string]string{"Address": "^10\\.0\\.0\\.", "Port": "502"},
+		BlockingIdentifiers: map[string][]string{"Address": {"10.0.0.99"}},
+		ProfileName:         testProfileName,
+		ServiceName:         testServiceName,
+		AdminState:          models.Unlocked,
+		AutoEvents:          []models.AutoEvent{{Interval: "10s", SourceName: "Temperature"}},
+	}
+	lockedWatcher := watcher
+	lockedWatcher.Name = "lockedWatcher"
+	lockedWatcher.AdminState = models.Locked
+	lockedWatcher.Identifiers = map[string]string{}
+	// a watcher without identifiers matches no device
+	emptyWatcher := watcher
+	emptyWatcher.Name = "emptyWatcher"
+	emptyWatcher.Identifiers = map[string]string{}
+
+	protocols := func(address string) map[string]models.ProtocolProperties {
+		return map[string]models.ProtocolProperties{"modbus-tcp": {"Address": address, "Port": "502"}}
+	}
+	rejected := pkgModels.DiscoveredDevice{Id: testDiscoveredDeviceId, Name: testDiscoveredDeviceName, Status: pkgModels.DiscoveredDeviceRejected}
+	pending := pkgModels.DiscoveredDevice{Id: testDiscoveredDeviceId, Name: testDiscoveredDeviceName, Status: pkgModels.DiscoveredDevicePending}
+
+	tests := []struct {
+		name            string
+		address         string
+		deviceExists    bool
+		queued          *pkgModels.DiscoveredDevice
+		requireApproval bool
+		expectedResult  pkgModels.DiscoveryResult
+		expectedId      string
+	}{
+		{"device created", "10.0.0.1", false, nil, false, pkgModels.DiscoveryCreated, "deviceId"},
+		{"pending device created", "10.0.0.1", false, &pending, false, pkgModels.DiscoveryCreated, "deviceId"},
+		{"device queued", "10.0.0.1", false, nil, true, pkgModels.DiscoveryPending, testDiscoveredDeviceId},
+		{"pending device updated", "10.0.0.1", false, &pending, true, pkgModels.DiscoveryPending, testDiscoveredDeviceId},
+		{"device blocked", "10.0.0.99", false, nil, false, pkgModels.DiscoveryBlocked, ""},
+		{"device not matched", "192.168.0.1", false, nil, false, pkgModels.DiscoveryNotMatched, ""},
+		{"device exists", "10.0.0.1", true, nil, false, pkgModels.DiscoveryExists, ""},
+		{"device rejected", "10.0.0.1", false, &rejected, false, pkgModels.DiscoveryRejected, testDiscoveredDeviceId},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceNameExists", testDiscoveredDeviceName).Return(testCase.deviceExists, nil)
+			if testCase.queued == nil {
+				dbClientMock.On("DiscoveredDeviceByName", testDiscoveredDeviceName).Return(pkgModels.DiscoveredDevice{},
+					errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+			} else {
+				dbClientMock.On("DiscoveredDeviceByName", testDiscoveredDeviceName).Return(*testCase.queued, nil)
+			}
+			dbClientMock.On("ProvisionWatchersByServiceName", 0, -1, testServiceName).Return([]models.ProvisionWatcher{lockedWatcher, emptyWatcher, watcher}, nil)
+			dbClientMock.On("DeviceServiceNameExists", testServiceName).Return(true, nil)
+			dbClientMock.On("DeviceProfileNameExists", testProfileName).Return(true, nil)
+			dbClientMock.On("AddDevice", mock.Anything).Return(models.Device{Id: "deviceId"}, nil)
+			dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+			dbClientMock.On("AddDiscoveredDevice", mock.Anything).Return(pkgModels.DiscoveredDevice{Id: testDiscoveredDeviceId}, nil)
+			dbClientMock.On("UpdateDiscoveredDevice", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteDiscoveredDeviceById", testDiscoveredDeviceId).Return(nil)
+			dic := mockDic(dbClientMock)
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{
+						Writable: config.WritableInfo{
+							Discovery: config.DiscoveryInfo{RequireApproval: testCase.requireApproval},
+						},
+					}
+				},
+			})
+
+			discovered := pkgModels.DiscoveredDevice{Name: testDiscoveredDeviceName, ServiceName: testServiceName, Protocols: protocols(testCase.address)}
+			id, result, _, err := SubmitDiscoveredDevice(discovered, context.Background(), dic)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedResult, result)
+			assert.Equal(t, testCase.expectedId, id)
+			switch testCase.expectedResult {
+			case pkgModels.DiscoveryCreated:
+				dbClientMock.AssertCalled(t, "AddDevice", mock.MatchedBy(func(d models.Device) bool {
+					return d.Name == testDiscoveredDeviceName && d.ProfileName == testProfileName && len(d.AutoEvents) == 1
+				}))
+				if testCase.queued != nil {
+					dbClientMock.AssertCalled(t, "DeleteDiscoveredDeviceById", testDiscoveredDeviceId)
+				}
+			case pkgModels.DiscoveryPending:
+				dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+				matchPending := mock.MatchedBy(func(d pkgModels.DiscoveredDevice) bool {
+					return d.Status == pkgModels.DiscoveredDevicePending && d.ProvisionWatcherName == testWatcherName && d.ProfileName == testProfileName
+				})
+				if testCase.queued != nil {
+					dbClientMock.AssertCalled(t, "UpdateDiscoveredDevice", matchPending)
+				} else {
+					dbClientMock.AssertCalled(t, "AddDiscoveredDevice", matchPending)
+				}
+			default:
+				dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+				dbClientMock.AssertNotCalled(t, "AddDiscoveredDevice", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestApproveDiscoveredDevice(t *testing.T) {
+	pending := pkgModels.DiscoveredDevice{
+		Id:                   testDiscoveredDeviceId,
+		Name:                 testDiscoveredDeviceName,
+		ServiceName:          testServiceName,
+		ProvisionWatcherName: testWatcherName,
+		ProfileName:          testProfileName,
+		Status:               pkgModels.DiscoveredDevicePending,
+	}
+	rejected := pending
+	rejected.Id = "rejectedId"
+	rejected.Status = pkgModels.DiscoveredDeviceRejected
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DiscoveredDeviceById", pending.Id).Return(pending, nil)
+	dbClientMock.On("DiscoveredDeviceById", rejected.Id).Return(rejected, nil)
+	dbClientMock.On("ProvisionWatcherByName", testWatcherName).Return(models.ProvisionWatcher{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeviceServiceNameExists", testServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", testProfileName).Return(true, nil)
+	dbClientMock.On("AddDevice", mock.Anything).Return(models.Device{Id: "deviceId"}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dbClientMock.On("DeleteDiscoveredDeviceById", pending.Id).Return(nil)
+	dic := mockDic(dbClientMock)
+
+	deviceId, err := ApproveDiscoveredDevice(pending.Id, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, "deviceId", deviceId)
+	dbClientMock.AssertCalled(t, "AddDevice", mock.MatchedBy(func(d models.Device) bool {
+		return d.Name == pending.Name && d.ServiceName == testServiceName && d.ProfileName == testProfileName
+	}))
+	dbClientMock.AssertCalled(t, "DeleteDiscoveredDeviceById", pending.Id)
+
+	_, err = ApproveDiscoveredDevice(rejected.Id, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+}
@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DeviceHeartbeat records the time the device was seen, and brings the device UP if it was marked DOWN
+func DeviceHeartbeat(name string, timestamp int64, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if timestamp == 0 {
+		timestamp = pkgCommon.MakeTimestamp()
+	}
+	err := dbClient.UpdateDeviceLastReported(name, timestamp)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("Device %s heartbeat recorded. Timestamp: %d, Correlation-ID: %s ", name, timestamp, correlation.FromContext(ctx))
+
+	device, err := dbClient.DeviceByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if device.OperatingState == models.Down {
+		err = UpdateDeviceOperatingState(name, models.Up, "the device is seen again",
+			container.ConfigurationFrom(dic.Get).Writable.DeviceLiveness.NotifyOnTransition, ctx, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// maxOperatingStateAttempts is how many times the operating state change is tried when the device is updated concurrently
+const maxOperatingStateAttempts = 3
+
+// UpdateDeviceOperatingState changes the operating state of the device, informs the device service of the change and
+// optionally sends a notification with the reason of the change. Nothing is done if the state is not changed. The device
+// is updated at the revision it was read, and read again if it is updated concurrently, so no other change is lost.
+func UpdateDeviceOperatingState(name string, state models.OperatingState, reason string, notify bool, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	device, err := dbClient.DeviceByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	var before dtos.Device
+	for attempt := 1; ; attempt++ {
+		// the revision is read ahead of the device, so a device changed in between is never written back at the newer revision
+		revision, err := dbClient.Revision(device.Id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		device, err = dbClient.DeviceByName(name)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if device.OperatingState == state {
+			return nil
+		}
+		before = dtos.FromDeviceModelToDTO(device)
+		device.OperatingState = state
+		err = dbClient.UpdateDevice(device, revision)
+		if err == nil {
+			break
+		} else if errors.Kind(err) != pkgCommon.KindRevisionMismatch || attempt >= maxOperatingStateAttempts {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		lc.Debugf("Device %s is updated concurrently, retry the operating state change", name)
+	}
+	lc.Infof("Device %s operating state changed to %s, %s. Correlation-ID: %s ", name, state, reason, correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDevice, device.Name, before, dtos.FromDeviceModelToDTO(device))
+	go sendMetadataChangeNotification(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditDevice, device.Name)
+
+	updateDeviceCallback(ctx, dic, device.ServiceName, device)
+	if notify {
+		go sendDeviceStateNotification(ctx, dic, device, reason)
+	}
+	return nil
+}
+
+// MarkSilentDevicesDown marks the UP devices DOWN if they are not seen within the silence timeout of their profile.
+// The devices which have never been seen are left untouched since their liveness is unknown.
+func MarkSilentDevicesDown(ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	liveness := container.ConfigurationFrom(dic.Get).Writable.DeviceLiveness
+
+	devices, err := dbClient.AllDevices(0, -1, nil)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	now := pkgCommon.MakeTimestamp()
+	for _, d := range devices {
+		if d.OperatingState != models.Up || d.LastReported == 0 {
+			continue
+		}
+		timeout, err := silenceTimeout(liveness, d.ProfileName)
+		if err != nil {
+			lc.Errorf("fail to check the liveness of device %s, err: %v", d.Name, err)
+			continue
+		}
+		if timeout == 0 || now-d.LastReported <= timeout.Milliseconds() {
+			continue
+		}
+		reason := fmt.Sprintf("the device is not seen for %v", time.Duration(now-d.LastReported)*time.Millisecond)
+		err = UpdateDeviceOperatingState(d.Name, models.Down, reason, liveness.NotifyOnTransition, ctx, dic)
+		if err != nil {
+			lc.Errorf("fail to mark the silent device %s DOWN, err: %v", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// silenceTimeout returns the silence timeout of the devices with the specified profile, zero means the devices of the
+// profile are not tracked
+func silenceTimeout(liveness config.DeviceLivenessInfo, profileName string) (time.Duration, errors.EdgeX) {
+	value, ok := liveness.ProfileSilenceTimeouts[profileName]
+	if !ok {
+		value = liveness.SilenceTimeout
+	}
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid silence timeout '%s' of profile %s", value, profileName), err)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package liveness
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// defaultCheckInterval is used to look for the configuration changes when the check is disabled or misconfigured
+const defaultCheckInterval = 30 * time.Second
+
+// Monitor periodically marks the devices DOWN when they are not seen within the configured silence timeout
+type Monitor struct {
+	dic *di.Container
+}
+
+// NewMonitor creates a new device liveness monitor
+func NewMonitor(dic *di.Container) *Monitor {
+	return &Monitor{dic: dic}
+}
+
+// Start runs the monitor in the background until the context is done
+func (m *Monitor) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			interval, enabled := m.checkInterval()
+			timer := time.NewTimer(interval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			if !enabled {
+				continue
+			}
+			err := application.MarkSilentDevicesDown(context.Background(), m.dic)
+			if err != nil {
+				bootstrapContainer.LoggingClientFrom(m.dic.Get).Errorf("fail to check the device liveness, err: %v", err)
+			}
+		}
+	}()
+}
+
+// checkInterval reads the check interval from the writable configuration, so the changes take effect without restarting
+func (m *Monitor) checkInterval() (time.Duration, bool) {
+	value := container.ConfigurationFrom(m.dic.Get).Writable.DeviceLiveness.CheckInterval
+	if value == "" {
+		return defaultCheckInterval, false
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		bootstrapContainer.LoggingClientFrom(m.dic.Get).Errorf("invalid device liveness CheckInterval '%s'", value)
+		return defaultCheckInterval, false
+	}
+	return interval, true
+}
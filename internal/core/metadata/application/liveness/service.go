@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package liveness
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+)
+
+const (
+	// scheduleResolution is how often the monitor looks for the device services which are due to be pinged
+	scheduleResolution = time.Second
+	defaultPingTimeout = 5 * time.Second
+)
+
+// ServiceMonitor periodically pings the device services and records their health
+type ServiceMonitor struct {
+	dic   *di.Container
+	lc    logger.LoggingClient
+	mutex sync.Mutex
+	// nextPing holds the time when the device service is due to be pinged
+	nextPing map[string]time.Time
+	// pinging holds the device services whose ping is in progress
+	pinging map[string]bool
+}
+
+// NewServiceMonitor creates a new device service liveness monitor
+func NewServiceMonitor(dic *di.Container) *ServiceMonitor {
+	return &ServiceMonitor{
+		dic:      dic,
+		lc:       bootstrapContainer.LoggingClientFrom(dic.Get),
+		nextPing: make(map[string]time.Time),
+		pinging:  make(map[string]bool),
+	}
+}
+
+// Start runs the monitor in the background until the context is done
+func (m *ServiceMonitor) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(scheduleResolution)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			m.schedule(ctx, wg)
+		}
+	}()
+}
+
+// schedule pings the device services which are due, the configuration is read on every round so the changes take
+// effect without restarting
+func (m *ServiceMonitor) schedule(ctx context.Context, wg *sync.WaitGroup) {
+	liveness := container.ConfigurationFrom(m.dic.Get).Writable.ServiceLiveness
+	if liveness.PingInterval == "" && len(liveness.ServicePingIntervals) == 0 {
+		m.mutex.Lock()
+		m.prune(nil)
+		m.mutex.Unlock()
+		return
+	}
+	services, err := container.DBClientFrom(m.dic.Get).AllDeviceServices(0, -1, nil)
+	if err != nil {
+		m.lc.Errorf("fail to query the device services for the liveness monitoring, err: %v", err)
+		return
+	}
+	timeout := pingTimeout(liveness)
+
+	now := time.Now()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	listed := make(map[string]bool, len(services))
+	for _, ds := range services {
+		listed[ds.Name] = true
+		interval, err := pingInterval(liveness, ds.Name)
+		if err != nil {
+			m.lc.Errorf("fail to monitor the liveness of device service %s, err: %v", ds.Name, err)
+			continue
+		}
+		if interval == 0 || m.pinging[ds.Name] || now.Before(m.nextPing[ds.Name]) {
+			continue
+		}
+		m.nextPing[ds.Name] = now.Add(interval)
+		m.pinging[ds.Name] = true
+
+		wg.Add(1)
+		go func(name string, baseAddress string) {
+			defer wg.Done()
+			err := application.RecordDeviceServicePing(name, ping(baseAddress, timeout), context.Background(), m.dic)
+			if err != nil {
+				m.lc.Errorf("fail to record the ping result of device service %s, err: %v", name, err)
+			}
+			m.mutex.Lock()
+			delete(m.pinging, name)
+			m.mutex.Unlock()
+		}(ds.Name, ds.BaseAddress)
+	}
+	m.prune(listed)
+}
+
+// prune drops the schedule of the device services which are no longer listed, so the entries of the removed device
+// services don't pile up. A ping still in progress only removes its own entry once it's recorded.
+func (m *ServiceMonitor) prune(listed map[string]bool) {
+	for name := range m.nextPing {
+		if !listed[name] {
+			delete(m.nextPing, name)
+		}
+	}
+	for name := range m.pinging {
+		if !listed[name] {
+			delete(m.pinging, name)
+		}
+	}
+}
+
+// ping invokes the ping API of the device service, any response other than 200 is treated as a failure
+func ping(baseAddress string, timeout time.Duration) error {
+	client := http.Client{Timeout: timeout}
+	resp, err := client.Get(baseAddress + common.ApiPingRoute)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ping responded with status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// pingInterval returns the ping interval of the device service, zero means the device service is not monitored
+func pingInterval(liveness config.ServiceLivenessInfo, serviceName string) (time.Duration, error) {
+	value, ok := liveness.ServicePingIntervals[serviceName]
+	if !ok {
+		value = liveness.PingInterval
+	}
+	if value == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("invalid ping interval '%s'", value)
+	}
+	return interval, nil
+}
+
+func pingTimeout(liveness config.ServiceLivenessInfo) time.Duration {
+	timeout, err := time.ParseDuration(liveness.PingTimeout)
+	if err != nil || timeout <= 0 {
+		return defaultPingTimeout
+	}
+	return timeout
+}
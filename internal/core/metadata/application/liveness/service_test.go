@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package liveness
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPing(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusCode    int
+		delay         time.Duration
+		errorExpected bool
+	}{
+		{"reachable", http.StatusOK, 0, false},
+		{"error status code", http.StatusServiceUnavailable, 0, true},
+		{"timeout", http.StatusOK, 200 * time.Millisecond, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, common.ApiPingRoute, r.URL.Path)
+				time.Sleep(testCase.delay)
+				w.WriteHeader(testCase.statusCode)
+			}))
+			defer server.Close()
+
+			err := ping(server.URL, 100*time.Millisecond)
+
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPingInterval(t *testing.T) {
+	liveness := config.ServiceLivenessInfo{
+		PingInterval: "30s",
+		ServicePingIntervals: map[string]string{
+			"fast":     "5s",
+			"disabled": "",
+			"invalid":  "abc",
+		},
+	}
+	tests := []struct {
+		name          string
+		serviceName   string
+		expected      time.Duration
+		errorExpected bool
+	}{
+		{"default interval", "other", 30 * time.Second, false},
+		{"overridden interval", "fast", 5 * time.Second, false},
+		{"disabled service", "disabled", 0, false},
+		{"invalid interval", "invalid", 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			interval, err := pingInterval(liveness, testCase.serviceName)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, interval)
+		})
+	}
+}
+
+func TestSchedulePrunesRemovedServices(t *testing.T) {
+	configuration := &config.ConfigurationStruct{
+		Writable: config.WritableInfo{ServiceLiveness: config.ServiceLivenessInfo{PingInterval: "30s"}},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return([]models.DeviceService{{Name: "kept"}}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	monitor := NewServiceMonitor(dic)
+	// the pings are not due, so the round only prunes the schedule
+	due := time.Now().Add(time.Minute)
+	monitor.nextPing["kept"] = due
+	monitor.nextPing["removed"] = due
+	monitor.pinging["removed"] = true
+
+	var wg sync.WaitGroup
+	monitor.schedule(context.Background(), &wg)
+	wg.Wait()
+	assert.Equal(t, map[string]time.Time{"kept": due}, monitor.nextPing)
+	assert.Empty(t, monitor.pinging)
+
+	// nothing is kept once the monitoring is disabled
+	configuration.Writable.ServiceLiveness.PingInterval = ""
+	monitor.schedule(context.Background(), &wg)
+	wg.Wait()
+	assert.Empty(t, monitor.nextPing)
+}
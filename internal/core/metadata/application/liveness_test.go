@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSlowProfileName = "slowProfile"
+
+func livenessDic(dbClientMock *dbMock.DBClient) *di.Container {
+	dic := mockDic(dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					DeviceLiveness: config.DeviceLivenessInfo{
+						SilenceTimeout:         "1m",
+						ProfileSilenceTimeouts: map[string]string{testSlowProfileName: "1h", "untracked": "", "invalid": "abc"},
+					},
+				},
+			}
+		},
+	})
+	return dic
+}
+
+func TestSilenceTimeout(t *testing.T) {
+	liveness := config.DeviceLivenessInfo{
+		SilenceTimeout:         "1m",
+		ProfileSilenceTimeouts: map[string]string{testSlowProfileName: "1h", "untracked": "", "invalid": "abc"},
+	}
+	tests := []struct {
+		name          string
+		liveness      config.DeviceLivenessInfo
+		profileName   string
+		expected      time.Duration
+		errorExpected bool
+	}{
+		{"default timeout", liveness, "anyProfile", time.Minute, false},
+		{"timeout of the profile", liveness, testSlowProfileName, time.Hour, false},
+		{"profile not tracked", liveness, "untracked", 0, false},
+		{"no timeout configured", config.DeviceLivenessInfo{}, "anyProfile", 0, false},
+		{"invalid timeout", liveness, "invalid", 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			timeout, err := silenceTimeout(testCase.liveness, testCase.profileName)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, timeout)
+		})
+	}
+}
+
+func TestMarkSilentDevicesDown(t *testing.T) {
+	now := pkgCommon.MakeTimestamp()
+	silent := models.Device{Id: "silent", Name: "silent", ProfileName: "anyProfile", OperatingState: models.Up, LastReported: now - time.Hour.Milliseconds()}
+	seen := models.Device{Id: "seen", Name: "seen", ProfileName: "anyProfile", OperatingState: models.Up, LastReported: now}
+	slow := models.Device{Id: "slow", Name: "slow", ProfileName: testSlowProfileName, OperatingState: models.Up, LastReported: now - time.Minute.Milliseconds()*2}
+	neverSeen := models.Device{Id: "neverSeen", Name: "neverSeen", ProfileName: "anyProfile", OperatingState: models.Up}
+	down := models.Device{Id: "down", Name: "down", ProfileName: "anyProfile", OperatingState: models.Down, LastReported: now - time.Hour.Milliseconds()}
+	untracked := models.Device{Id: "untracked", Name: "untracked", ProfileName: "untracked", OperatingState: models.Up, LastReported: 1}
+	invalid := models.Device{Id: "invalid", Name: "invalid", ProfileName: "invalid", OperatingState: models.Up, LastReported: 1}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return([]models.Device{silent, seen, slow, neverSeen, down, untracked, invalid}, nil)
+	dbClientMock.On("DeviceByName", silent.Name).Return(silent, nil)
+	dbClientMock.On("Revision", silent.Id).Return(int64(1), nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, int64(1)).Return(nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+
+	err := MarkSilentDevicesDown(context.Background(), livenessDic(dbClientMock))
+	require.NoError(t, err)
+
+	// only the device silent longer than the timeout of its profile is marked DOWN
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", 1)
+	dbClientMock.AssertCalled(t, "UpdateDevice", mock.MatchedBy(func(d models.Device) bool {
+		return d.Name == silent.Name && d.OperatingState == models.Down
+	}), int64(1))
+}
+
+func TestUpdateDeviceOperatingState(t *testing.T) {
+	device := models.Device{Id: "deviceId", Name: "device", ServiceName: testServiceName, OperatingState: models.Down}
+	upDevice := device
+	upDevice.OperatingState = models.Up
+	revisionMismatch := errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch, "device is at revision 2", nil)
+
+	tests := []struct {
+		name             string
+		current          models.Device
+		state            models.OperatingState
+		mismatches       int
+		errorExpected    bool
+		expectedUpdates  int
+		expectedCallback bool
+	}{
+		{"DOWN device brought UP", device, models.Up, 0, false, 1, true},
+		{"UP device marked DOWN", upDevice, models.Down, 0, false, 1, true},
+		{"state not changed", upDevice, models.Up, 0, false, 0, false},
+		{"retried on concurrent update", device, models.Up, 1, false, 2, true},
+		{"concurrent updates exceed the attempts", device, models.Up, maxOperatingStateAttempts, true, maxOperatingStateAttempts, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceByName", device.Name).Return(testCase.current, nil)
+			dbClientMock.On("Revision", device.Id).Return(int64(1), nil).Once()
+			dbClientMock.On("Revision", device.Id).Return(int64(2), nil)
+			if testCase.mismatches > 0 {
+				dbClientMock.On("UpdateDevice", mock.Anything, int64(1)).Return(revisionMismatch).Once()
+			}
+			if testCase.mismatches > 1 {
+				dbClientMock.On("UpdateDevice", mock.Anything, int64(2)).Return(revisionMismatch).Times(testCase.mismatches - 1)
+			}
+			dbClientMock.On("UpdateDevice", mock.Anything, mock.Anything).Return(nil)
+			dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+
+			err := UpdateDeviceOperatingState(device.Name, testCase.state, "test", false, context.Background(), mockDic(dbClientMock))
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, pkgCommon.KindRevisionMismatch, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+			dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", testCase.expectedUpdates)
+			if testCase.expectedUpdates > 0 {
+				dbClientMock.AssertCalled(t, "UpdateDevice", mock.MatchedBy(func(d models.Device) bool {
+					return d.OperatingState == testCase.state
+				}), mock.Anything)
+			}
+			if testCase.expectedCallback {
+				dbClientMock.AssertCalled(t, "AddDeviceServiceCallback", mock.MatchedBy(func(cb pkgModels.DeviceServiceCallback) bool {
+					return cb.Action == pkgModels.UpdateDeviceAction && cb.EntityName == device.Name
+				}))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddDeviceServiceCallback", mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DeviceStateCategory is the category of the notifications sent when the operating state of a device is changed
+const DeviceStateCategory = "device-state"
+
+// MetadataChangeCategory is the category of the notifications sent when a device, device profile or device service
+// is added, updated or deleted, their content is a pkgDtos.MetadataChange in JSON
+const MetadataChangeCategory = "metadata-change"
+
+// sendDeviceStateNotification sends a notification about the operating state change of the device to support-notifications
+func sendDeviceStateNotification(ctx context.Context, dic *di.Container, device models.Device, reason string) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	info := container.ConfigurationFrom(dic.Get).Notifications
+
+	severity := models.Normal
+	if device.OperatingState == models.Down {
+		severity = models.Critical
+	}
+	content := fmt.Sprintf("%sdevice %s is %s, %s", info.Content, device.Name, device.OperatingState, reason)
+	notification := dtos.NewNotification([]string{info.Label, device.Name}, DeviceStateCategory, content, info.Sender, severity)
+	notification.Description = info.Description
+
+	responses, err := container.NotificationClientFrom(dic.Get).SendNotification(ctx, []requests.AddNotificationRequest{requests.NewAddNotificationRequest(notification)})
+	if err != nil {
+		lc.Errorf("fail to send the operating state notification of device %s, err: %v", device.Name, err)
+		return
+	}
+	for _, res := range responses {
+		if res.StatusCode >= 300 {
+			lc.Errorf("fail to send the operating state notification of device %s, err: %s", device.Name, res.Message)
+		}
+	}
+	lc.Debugf("operating state notification of device %s sent. Correlation-ID: %s", device.Name, correlation.FromContext(ctx))
+}
+
+// sendMetadataChangeNotification sends a notification about the added, updated or deleted entity to
+// support-notifications if Notifications.PostDeviceChanges is enabled
+func sendMetadataChangeNotification(ctx context.Context, dic *di.Container, action pkgModels.AuditAction, entityType pkgModels.AuditEntityType, name string) {
+	info := container.ConfigurationFrom(dic.Get).Notifications
+	if !info.PostDeviceChanges {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	content, err := json.Marshal(pkgDtos.MetadataChange{Action: string(action), EntityType: string(entityType), Name: name})
+	if err != nil {
+		lc.Errorf("fail to encode the change notification of %s %s, err: %v", entityType, name, err)
+		return
+	}
+	notification := dtos.NewNotification([]string{info.Label, string(entityType)}, MetadataChangeCategory, string(content), info.Sender, models.Normal)
+	notification.ContentType = common.ContentTypeJSON
+	notification.Description = info.Description
+
+	responses, edgeXerr := container.NotificationClientFrom(dic.Get).SendNotification(ctx, []requests.AddNotificationRequest{requests.NewAddNotificationRequest(notification)})
+	if edgeXerr != nil {
+		lc.Errorf("fail to send the change notification of %s %s, err: %v", entityType, name, edgeXerr)
+		return
+	}
+	for _, res := range responses {
+		if res.StatusCode >= 300 {
+			lc.Errorf("fail to send the change notification of %s %s, err: %s", entityType, name, res.Message)
+		}
+	}
+	lc.Debugf("change notification of %s %s sent. Correlation-ID: %s", entityType, name, correlation.FromContext(ctx))
+}
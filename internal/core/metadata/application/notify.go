@@ -7,194 +7,111 @@ package application
 
 import (
 	"context"
-	"net/http"
+	"encoding/json"
 
-	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
-	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
-	clients "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
-	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
-	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
-	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 )
 
-func newDeviceServiceCallbackClient(ctx context.Context, dic *di.Container, deviceServiceName string) (interfaces.DeviceServiceCallbackClient, errors.EdgeX) {
-	ds, err := DeviceServiceByName(deviceServiceName, ctx, dic)
-	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
-	}
-	return clients.NewDeviceServiceCallbackClient(ds.BaseAddress), nil
+// enqueueCallback persists the callback into the outbox and notifies the dispatcher to deliver it to the device service.
+// The payload is nil for the callbacks which only need the entity name. The entity change is committed already, so a
+// failure is only logged rather than failing the request of a change which was applied.
+func enqueueCallback(ctx context.Context, dic *di.Container, serviceName string, action pkgModels.CallbackAction, entityName string, payload interface{}) {
+	enqueue(ctx, dic, pkgModels.DeviceServiceCallback{ServiceName: serviceName, Action: action, EntityName: entityName}, payload)
 }
 
-// addDeviceCallback invoke device service's callback function for adding new device
-func addDeviceCallback(ctx context.Context, dic *di.Container, device dtos.Device) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, device.ServiceName)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", device.ServiceName, err)
-		return
+func enqueue(ctx context.Context, dic *di.Container, cb pkgModels.DeviceServiceCallback, payload interface{}) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	cb.Status = pkgModels.CallbackPending
+	cb.CorrelationId = correlation.FromContext(ctx)
+	if payload != nil {
+		bytes, err := json.Marshal(payload)
+		if err != nil {
+			lc.Errorf("fail to encode the %s callback payload of %s, err: %v", cb.Action, cb.EntityName, err)
+			return
+		}
+		cb.Payload = bytes
 	}
 
-	request := requests.NewAddDeviceRequest(device)
-	response, err := deviceServiceCallbackClient.AddDeviceCallback(ctx, request)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for adding device %s, err: %v", device.Name, err)
+	_, edgeXerr := dbClient.AddDeviceServiceCallback(cb)
+	if edgeXerr != nil {
+		lc.Errorf("fail to add the %s callback of %s for device service %s into the outbox, err: %v", cb.Action, cb.EntityName, cb.ServiceName, edgeXerr)
 		return
 	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for adding device %s, err: %s", device.Name, response.Message)
-	}
+	container.CallbackDispatcherFrom(dic.Get).Notify(cb.ServiceName)
 }
 
-// updateDeviceCallback invoke device service's callback function for updating device
-func updateDeviceCallback(ctx context.Context, dic *di.Container, serviceName string, device models.Device) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, serviceName)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", serviceName, err)
-		return
-	}
+// addDeviceCallback enqueues the device service's callback for adding new device
+func addDeviceCallback(ctx context.Context, dic *di.Container, device dtos.Device) {
+	enqueueCallback(ctx, dic, device.ServiceName, pkgModels.AddDeviceAction, device.Name, device)
+}
 
-	request := requests.NewUpdateDeviceRequest(dtos.FromDeviceModelToUpdateDTO(device))
-	response, err := deviceServiceCallbackClient.UpdateDeviceCallback(ctx, request)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for updating device %s, err: %v", device.Name, err)
-		return
-	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for updating device %s, err: %s", device.Name, response.Message)
-	}
+// updateDeviceCallback enqueues the device service's callback for updating device
+func updateDeviceCallback(ctx context.Context, dic *di.Container, serviceName string, device models.Device) {
+	enqueueCallback(ctx, dic, serviceName, pkgModels.UpdateDeviceAction, device.Name, dtos.FromDeviceModelToUpdateDTO(device))
 }
 
-// deleteDeviceCallback invoke device service's callback function for deleting device
+// deleteDeviceCallback enqueues the device service's callback for deleting device
 func deleteDeviceCallback(ctx context.Context, dic *di.Container, device models.Device) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, device.ServiceName)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", device.ServiceName, err)
-		return
-	}
-	response, err := deviceServiceCallbackClient.DeleteDeviceCallback(ctx, device.Name)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for deleting device %s, err: %v", device.Name, err)
-		return
-	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for deleting device %s, err: %s", device.Name, response.Message)
-	}
+	enqueueCallback(ctx, dic, device.ServiceName, pkgModels.DeleteDeviceAction, device.Name, nil)
 }
 
-// updateDeviceProfileCallback invoke device service's callback function for updating device profile
+// updateDeviceProfileCallback enqueues the callback for updating device profile to each device service which has
+// devices associated with the profile
 func updateDeviceProfileCallback(ctx context.Context, dic *di.Container, deviceProfile dtos.DeviceProfile) {
-	lc := container.LoggingClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	devices, err := DevicesByProfileName(0, -1, deviceProfile.Name, dic)
 	if err != nil {
 		lc.Errorf("fail to query associated devices by deviceProfile name %s, err: %v", deviceProfile.Name, err)
 		return
 	}
-	// Invoke callback for each device service
 	dsMap := make(map[string]bool)
 	for _, d := range devices {
 		if _, ok := dsMap[d.ServiceName]; ok {
-			// skip the invoked device service
+			// skip the enqueued device service
 			continue
 		}
 		dsMap[d.ServiceName] = true
-
-		deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, d.ServiceName)
-		if err != nil {
-			lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", d.ServiceName, err)
-			continue
-		}
-
-		request := requests.NewDeviceProfileRequest(deviceProfile)
-		response, err := deviceServiceCallbackClient.UpdateDeviceProfileCallback(ctx, request)
-		if err != nil {
-			lc.Errorf("fail to invoke device service callback for updating device profile %s, err: %v", deviceProfile.Name, err)
-			continue
-		}
-		if response.StatusCode != http.StatusOK {
-			lc.Errorf("fail to invoke device service callback for updating device profile %s, err: %s", deviceProfile.Name, response.Message)
-		}
+		enqueueCallback(ctx, dic, d.ServiceName, pkgModels.UpdateDeviceProfileAction, deviceProfile.Name, deviceProfile)
 	}
 }
 
-// addProvisionWatcherCallback invoke device service's callback function for adding new provision watcher
+// addProvisionWatcherCallback enqueues the device service's callback for adding new provision watcher
 func addProvisionWatcherCallback(ctx context.Context, dic *di.Container, pw dtos.ProvisionWatcher) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, pw.ServiceName)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", pw.ServiceName, err)
-		return
-	}
-
-	request := requests.NewAddProvisionWatcherRequest(pw)
-	response, err := deviceServiceCallbackClient.AddProvisionWatcherCallback(ctx, request)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for adding  provision watcher %s, err: %v", pw.Name, err)
-		return
-	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for adding  provision watcher %s, err: %s", pw.Name, response.Message)
-	}
+	enqueueCallback(ctx, dic, pw.ServiceName, pkgModels.AddProvisionWatcherAction, pw.Name, pw)
 }
 
-// updateProvisionWatcherCallback invoke device service's callback function for updating provision watcher
+// updateProvisionWatcherCallback enqueues the device service's callback for updating provision watcher
 func updateProvisionWatcherCallback(ctx context.Context, dic *di.Container, serviceName string, pw models.ProvisionWatcher) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, serviceName)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", serviceName, err)
-		return
-	}
-
-	request := requests.NewUpdateProvisionWatcherRequest(dtos.FromProvisionWatcherModelToUpdateDTO(pw))
-	response, err := deviceServiceCallbackClient.UpdateProvisionWatcherCallback(ctx, request)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for updating provision watcher %s, err: %v", pw.Name, err)
-		return
-	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for updating provision watcher %s, err: %s", pw.Name, response.Message)
-	}
+	enqueueCallback(ctx, dic, serviceName, pkgModels.UpdateProvisionWatcherAction, pw.Name, dtos.FromProvisionWatcherModelToUpdateDTO(pw))
 }
 
-// deleteProvisionWatcherCallback invoke device service's callback function for deleting provision watcher
+// deleteProvisionWatcherCallback enqueues the device service's callback for deleting provision watcher
 func deleteProvisionWatcherCallback(ctx context.Context, dic *di.Container, pw models.ProvisionWatcher) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, pw.ServiceName)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", pw.ServiceName, err)
-		return
-	}
-	response, err := deviceServiceCallbackClient.DeleteProvisionWatcherCallback(ctx, pw.Name)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for deleting provision watcher %s, err: %v", pw.Name, err)
-		return
-	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for deleting provision watcher %s, err: %s", pw.Name, response.Message)
-	}
+	enqueueCallback(ctx, dic, pw.ServiceName, pkgModels.DeleteProvisionWatcherAction, pw.Name, nil)
 }
 
-// updateDeviceServiceCallback invoke device service's callback function for updating device service
+// updateDeviceServiceCallback enqueues the device service's callback for updating device service
 func updateDeviceServiceCallback(ctx context.Context, dic *di.Container, ds models.DeviceService) {
-	lc := container.LoggingClientFrom(dic.Get)
-	deviceServiceCallbackClient, err := newDeviceServiceCallbackClient(ctx, dic, ds.Name)
-	if err != nil {
-		lc.Errorf("fail to new a device service callback client by serviceName %s, err: %v", ds.Name, err)
-		return
-	}
+	enqueueCallback(ctx, dic, ds.Name, pkgModels.UpdateDeviceServiceAction, ds.Name, dtos.FromDeviceServiceModelToUpdateDTO(ds))
+}
 
-	request := requests.NewUpdateDeviceServiceRequest(dtos.FromDeviceServiceModelToUpdateDTO(ds))
-	response, err := deviceServiceCallbackClient.UpdateDeviceServiceCallback(ctx, request)
-	if err != nil {
-		lc.Errorf("fail to invoke device service callback for updating device service %s, err: %v", ds.Name, err)
-		return
+// deleteDeviceServiceDependentsCallback enqueues the callbacks for deleting the devices and provision watchers of the
+// device service which is deleted along with them. The callbacks carry the address of the device service since it is
+// no longer registered when they are delivered.
+func deleteDeviceServiceDependentsCallback(ctx context.Context, dic *di.Container, ds models.DeviceService, devices []models.Device, pws []models.ProvisionWatcher) {
+	for _, d := range devices {
+		enqueue(ctx, dic, pkgModels.DeviceServiceCallback{ServiceName: ds.Name, Action: pkgModels.DeleteDeviceAction, EntityName: d.Name, BaseAddress: ds.BaseAddress}, nil)
 	}
-	if response.StatusCode != http.StatusOK {
-		lc.Errorf("fail to invoke device service callback for updating device service %s, err: %s", ds.Name, response.Message)
+	for _, pw := range pws {
+		enqueue(ctx, dic, pkgModels.DeviceServiceCallback{ServiceName: ds.Name, Action: pkgModels.DeleteProvisionWatcherAction, EntityName: pw.Name, BaseAddress: ds.BaseAddress}, nil)
 	}
 }
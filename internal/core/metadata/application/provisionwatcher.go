@@ -11,7 +11,9 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
@@ -38,7 +40,8 @@ func AddProvisionWatcher(pw models.ProvisionWatcher, ctx context.Context, dic *d
 		addProvisionWatcher.Id,
 		correlationId,
 	)
-	go addProvisionWatcherCallback(ctx, dic, dtos.FromProvisionWatcherModelToDTO(pw))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditProvisionWatcher, addProvisionWatcher.Name, nil, dtos.FromProvisionWatcherModelToDTO(addProvisionWatcher))
+	addProvisionWatcherCallback(ctx, dic, dtos.FromProvisionWatcherModelToDTO(pw))
 	return addProvisionWatcher.Id, nil
 }
 
@@ -114,8 +117,8 @@ func AllProvisionWatchers(offset int, limit int, labels []string, dic *di.Contai
 	return
 }
 
-// DeleteProvisionWatcherByName deletes the provision watcher by name
-func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+// DeleteProvisionWatcherByName deletes the provision watcher by name if it is at the expected revision
+func DeleteProvisionWatcherByName(ctx context.Context, name string, revision int64, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -124,16 +127,17 @@ func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Cont
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	err = dbClient.DeleteProvisionWatcherByName(pw.Name)
+	err = dbClient.DeleteProvisionWatcherByName(pw.Name, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	go deleteProvisionWatcherCallback(ctx, dic, pw)
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditProvisionWatcher, pw.Name, dtos.FromProvisionWatcherModelToDTO(pw), nil)
+	deleteProvisionWatcherCallback(ctx, dic, pw)
 	return nil
 }
 
 // PatchProvisionWatcher executes the PATCH operation with the provisionWatcher DTO to replace the old data
-func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher, dic *di.Container) errors.EdgeX {
+func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher, revision int64, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -165,19 +169,21 @@ func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher,
 		oldServiceName = pw.ServiceName
 	}
 
+	before := dtos.FromProvisionWatcherModelToDTO(pw)
 	requests.ReplaceProvisionWatcherModelFieldsWithDTO(&pw, dto)
 
-	err = dbClient.UpdateProvisionWatcher(pw)
+	err = dbClient.UpdateProvisionWatcher(pw, revision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf("ProvisionWatcher patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditProvisionWatcher, pw.Name, before, dtos.FromProvisionWatcherModelToDTO(pw))
 
 	if oldServiceName != "" {
-		go updateProvisionWatcherCallback(ctx, dic, oldServiceName, pw)
+		updateProvisionWatcherCallback(ctx, dic, oldServiceName, pw)
 	}
-	go updateProvisionWatcherCallback(ctx, dic, pw.ServiceName, pw)
+	updateProvisionWatcherCallback(ctx, dic, pw.ServiceName, pw)
 	return nil
 }
 
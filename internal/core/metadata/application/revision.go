@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// EntityRevision returns the current revision of the metadata entity by id, the revision is exposed as the ETag of the
+// entity and expected back in the If-Match header of the updates and deletions
+func EntityRevision(id string, dic *di.Container) (int64, errors.EdgeX) {
+	if id == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	revision, err := dbClient.Revision(id)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return revision, nil
+}
+
+// checkRevision checks the current revision of the entity against the expected revision without modifying anything,
+// e.g. for a dry run
+func checkRevision(dbClient interfaces.DBClient, id string, expected int64) errors.EdgeX {
+	if expected == pkgCommon.AnyRevision {
+		return nil
+	}
+	current, err := dbClient.Revision(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if current != expected {
+		return errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch,
+			fmt.Sprintf("entity %s is at revision %d rather than the expected revision %d", id, current, expected), nil)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Search queries the devices, device profiles and device services of the given types which match every term of the
+// query, a term matches an entity whose name starts with it or whose description, labels or other indexed attributes
+// contain it as a word. An entity whose name starts with the whole query is matched as well, so that a name is found by
+// a prefix containing separators such as modbus-dev. All the types are searched if none is given.
+func Search(query string, types []string, offset int, limit int, dic *di.Container) (hits []pkgDtos.SearchHit, totalCount uint32, err errors.EdgeX) {
+	terms := pkgCommon.SearchTerms(query)
+	if len(terms) == 0 {
+		return hits, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "search query contains no term", nil)
+	}
+	entityTypes := make([]pkgModels.SearchEntityType, len(types))
+	for i, t := range types {
+		entityTypes[i] = pkgModels.SearchEntityType(t)
+		switch entityTypes[i] {
+		case pkgModels.SearchDevice, pkgModels.SearchDeviceProfile, pkgModels.SearchDeviceService:
+		default:
+			return hits, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported search type %s", t), nil)
+		}
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	matched, totalCount, err := dbClient.SearchMetadata(strings.ToLower(strings.TrimSpace(query)), terms, entityTypes, offset, limit)
+	if err != nil {
+		return hits, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromSearchHitModelsToDTOs(matched), totalCount, nil
+}
+
+// EnsureSearchIndex indexes the metadata entities stored before the search was introduced, it's invoked once the
+// service starts
+func EnsureSearchIndex(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	indexed, err := dbClient.EnsureSearchIndex()
+	if err != nil {
+		lc.Errorf("fail to build the search index, the entities added before may not be found: %v", err)
+		return
+	}
+	if indexed > 0 {
+		lc.Infof("%d metadata entities are added to the search index", indexed)
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	hit := pkgModels.SearchHit{Type: pkgModels.SearchDevice, Id: "thermostat-1-id", Name: "thermostat-1", Labels: []string{"hvac"}}
+
+	tests := []struct {
+		name          string
+		query         string
+		types         []string
+		expectedName  string
+		expectedTerms []string
+		expectedTypes []pkgModels.SearchEntityType
+		errorExpected bool
+	}{
+		{"all types", "Thermostat HVAC", nil, "thermostat hvac", []string{"thermostat", "hvac"}, []pkgModels.SearchEntityType{}, false},
+		{"device type", "thermostat", []string{"device"}, "thermostat", []string{"thermostat"}, []pkgModels.SearchEntityType{pkgModels.SearchDevice}, false},
+		{"no term", " ,- ", nil, "", nil, nil, true},
+		{"separated name prefix", " Modbus-Dev ", nil, "modbus-dev", []string{"modbus", "dev"}, []pkgModels.SearchEntityType{}, false},
+		{"unsupported type", "thermostat", []string{"interval"}, "", nil, nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("SearchMetadata", testCase.expectedName, testCase.expectedTerms, testCase.expectedTypes, 0, 10).
+				Return([]pkgModels.SearchHit{hit}, uint32(1), nil)
+			dic := mockDic(dbClientMock)
+
+			hits, totalCount, err := Search(testCase.query, testCase.types, 0, 10, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "SearchMetadata", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint32(1), totalCount)
+			require.Len(t, hits, 1)
+			assert.Equal(t, "device", hits[0].Type)
+			assert.Equal(t, hit.Name, hits[0].Name)
+		})
+	}
+}
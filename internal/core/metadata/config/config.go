@@ -15,6 +15,8 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
 )
 
@@ -27,13 +29,68 @@ type ConfigurationStruct struct {
 	Registry      bootstrapConfig.RegistryInfo
 	Service       bootstrapConfig.ServiceInfo
 	SecretStore   bootstrapConfig.SecretStoreInfo
+	Audit         audit.TrailInfo
 }
 
 type WritableInfo struct {
 	LogLevel        string
+	Callback        CallbackInfo
+	DeviceLiveness  DeviceLivenessInfo
+	ServiceLiveness ServiceLivenessInfo
+	Discovery       DiscoveryInfo
 	InsecureSecrets bootstrapConfig.InsecureSecrets
 }
 
+// CallbackInfo provides the retry policy of the device service callbacks which are failed to deliver
+type CallbackInfo struct {
+	// MaxRetries is the number of retries before the callback is marked as FAILED
+	MaxRetries int
+	// RetryInterval is the time to wait before the first retry, and it is doubled for each following retry
+	RetryInterval string
+	// MaxRetryInterval is the upper bound of the time to wait between two retries
+	MaxRetryInterval string
+}
+
+// DeviceLivenessInfo provides the settings of marking the devices DOWN when they are not seen for a while. A device is
+// seen when core-data receives its event or a heartbeat is reported for it.
+type DeviceLivenessInfo struct {
+	// CheckInterval is how often the silent devices are looked for, the check is disabled if it is empty
+	CheckInterval string
+	// SilenceTimeout is the time without being seen after which a device is marked DOWN, the devices are never
+	// marked DOWN if it is empty unless their profile is listed in ProfileSilenceTimeouts
+	SilenceTimeout string
+	// ProfileSilenceTimeouts overrides SilenceTimeout for the devices of the listed device profiles
+	ProfileSilenceTimeouts map[string]string
+	// NotifyOnTransition sends a notification to support-notifications when the operating state of a device is
+	// changed by the liveness tracking
+	NotifyOnTransition bool
+}
+
+// ServiceLivenessInfo provides the settings of pinging the device services and marking their devices DOWN while
+// they are unreachable
+type ServiceLivenessInfo struct {
+	// PingInterval is how often a device service is pinged, the device services are not pinged if it is empty unless
+	// they are listed in ServicePingIntervals
+	PingInterval string
+	// ServicePingIntervals overrides PingInterval for the listed device services
+	ServicePingIntervals map[string]string
+	// PingTimeout is the time to wait for the response of the ping
+	PingTimeout string
+	// FailureThreshold is the number of consecutive failed pings after which the device service is unreachable
+	FailureThreshold int
+	// NotifyOnTransition sends a notification to support-notifications when the operating state of a device is
+	// changed by the device service liveness monitoring
+	NotifyOnTransition bool
+}
+
+// DiscoveryInfo provides the settings of handling the discovered devices which match a provision watcher
+type DiscoveryInfo struct {
+	// RequireApproval parks the matched devices in the approval queue instead of adding them right away
+	RequireApproval bool
+	// ProvisionWatcherRequireApproval overrides RequireApproval for the devices matching the listed provision watchers
+	ProvisionWatcherRequireApproval map[string]bool
+}
+
 // Notification Info provides properties related to the assembly of notification content
 type NotificationInfo struct {
 	Content           string
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+)
+
+// CallbackDispatcherName contains the name of the interfaces.CallbackDispatcher implementation in the DIC.
+var CallbackDispatcherName = di.TypeInstanceToName((*interfaces.CallbackDispatcher)(nil))
+
+// CallbackDispatcherFrom helper function queries the DIC and returns the interfaces.CallbackDispatcher implementation.
+func CallbackDispatcherFrom(get di.Get) interfaces.CallbackDispatcher {
+	return get(CallbackDispatcherName).(interfaces.CallbackDispatcher)
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+)
+
+// NotificationClientName contains the name of the interfaces.NotificationClient implementation in the DIC.
+var NotificationClientName = di.TypeInstanceToName((*interfaces.NotificationClient)(nil))
+
+// NotificationClientFrom helper function queries the DIC and returns the interfaces.NotificationClient implementation.
+func NotificationClientFrom(get di.Get) interfaces.NotificationClient {
+	return get(NotificationClientName).(interfaces.NotificationClient)
+}
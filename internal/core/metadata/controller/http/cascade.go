@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// parseCascadeQueryString parses the query strings which control the cascade deletion, the dry run is only allowed
+// along with the cascade deletion
+func parseCascadeQueryString(r *http.Request) (cascade bool, dryRun bool, err errors.EdgeX) {
+	cascade, err = utils.ParseQueryStringToBool(r, pkgCommon.Cascade, false)
+	if err != nil {
+		return false, false, errors.NewCommonEdgeXWrapper(err)
+	}
+	dryRun, err = utils.ParseQueryStringToBool(r, pkgCommon.DryRun, false)
+	if err != nil {
+		return false, false, errors.NewCommonEdgeXWrapper(err)
+	}
+	if dryRun && !cascade {
+		return false, false, errors.NewCommonEdgeX(errors.KindContractInvalid, "dryRun is only supported along with cascade", nil)
+	}
+	return cascade, dryRun, nil
+}
@@ -13,7 +13,9 @@ import (
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -22,6 +24,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 
 	"github.com/gorilla/mux"
 )
@@ -90,7 +93,12 @@ func (dc *DeviceController) DeleteDeviceByName(w http.ResponseWriter, r *http.Re
 	vars := mux.Vars(r)
 	name := vars[common.Name]
 
-	err := application.DeleteDeviceByName(name, ctx, dc.dic)
+	revision, err := utils.ParseIfMatchHeader(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	err = application.DeleteDeviceByName(name, revision, ctx, dc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -169,13 +177,22 @@ func (dc *DeviceController) PatchDevice(w http.ResponseWriter, r *http.Request)
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	revision, err := parseIfMatchHeader(r, len(updateDeviceDTOs))
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	var updateResponses []interface{}
 	for _, dto := range updateDeviceDTOs {
 		var response interface{}
 		reqId := dto.RequestId
-		err := application.PatchDevice(dto.Device, ctx, dc.dic)
+		err := application.PatchDevice(dto.Device, revision, ctx, dc.dic)
 		if err != nil {
+			if errors.Kind(err) == pkgCommon.KindRevisionMismatch {
+				utils.WriteErrorResponse(w, ctx, lc, err, reqId)
+				return
+			}
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
 			response = commonDTO.NewBaseResponse(
@@ -230,6 +247,11 @@ func (dc *DeviceController) DeviceByName(w http.ResponseWriter, r *http.Request)
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	err = writeETagHeader(w, device.Id, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	response := responseDTO.NewDeviceResponse("", "", http.StatusOK, device)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
@@ -260,3 +282,133 @@ func (dc *DeviceController) DevicesByProfileName(w http.ResponseWriter, r *http.
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.Encode(response, w, lc)
 }
+
+func (dc *DeviceController) DevicesWithinRadius(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var coordinates [3]float64
+	for i, key := range []string{pkgCommon.Latitude, pkgCommon.Longitude, pkgCommon.Radius} {
+		value, err := utils.ParsePathParamToFloat(r, key)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		coordinates[i] = value
+	}
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	devices, totalCount, err := application.DevicesWithinRadius(coordinates[0], coordinates[1], coordinates[2], offset, limit, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiNearbyDevicesResponse("", "", http.StatusOK, totalCount, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceController) DevicesWithinBoundingBox(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var bounds [4]float64
+	for i, key := range []string{pkgCommon.South, pkgCommon.West, pkgCommon.North, pkgCommon.East} {
+		value, err := utils.ParsePathParamToFloat(r, key)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		bounds[i] = value
+	}
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	devices, totalCount, err := application.DevicesWithinBoundingBox(bounds[0], bounds[1], bounds[2], bounds[3], offset, limit, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiNearbyDevicesResponse("", "", http.StatusOK, totalCount, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceController) DevicesBySite(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	vars := mux.Vars(r)
+	site := vars[pkgCommon.Site]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	devices, err := application.DevicesBySite(offset, limit, site, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceController) DeviceHeartbeat(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	heartbeatDTOs, err := dc.reader.ReadDeviceHeartbeatRequest(r.Body)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	var responses []interface{}
+	for _, dto := range heartbeatDTOs {
+		var response interface{}
+		reqId := dto.RequestId
+		err := application.DeviceHeartbeat(dto.DeviceName, dto.Timestamp, ctx, dc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				err.Message(),
+				err.Code())
+		} else {
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				"",
+				http.StatusOK)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	pkg.Encode(responses, w, lc)
+}
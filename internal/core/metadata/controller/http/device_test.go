@@ -15,6 +15,10 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
@@ -124,7 +128,7 @@ func TestAddDevice(t *testing.T) {
 	dbClientMock.On("DeviceServiceNameExists", deviceModel.ServiceName).Return(true, nil)
 	dbClientMock.On("DeviceProfileNameExists", deviceModel.ProfileName).Return(true, nil)
 	dbClientMock.On("AddDevice", deviceModel).Return(deviceModel, nil)
-	dbClientMock.On("DeviceServiceByName", deviceModel.ServiceName).Return(models.DeviceService{BaseAddress: testBaseAddress}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
 
 	notFoundService := testDevice
 	notFoundService.Device.ServiceName = "notFoundService"
@@ -224,11 +228,20 @@ func TestDeleteDeviceByName(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
-	dbClientMock.On("DeleteDeviceByName", device.Name).Return(nil)
-	dbClientMock.On("DeleteDeviceByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceByName", device.Name, pkgCommon.AnyRevision).Return(nil)
+	dbClientMock.On("DeleteDeviceByName", device.Name, int64(2)).Return(nil)
+	dbClientMock.On("DeleteDeviceByName", device.Name, int64(1)).Return(errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch, "device is at revision 2", nil))
+	dbClientMock.On("DeleteDeviceByName", notFoundName, pkgCommon.AnyRevision).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeviceByName", notFoundName).Return(device, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
-	dbClientMock.On("DeviceServiceByName", device.ServiceName).Return(models.DeviceService{BaseAddress: testBaseAddress}, nil)
+	outboxDown := device
+	outboxDown.Name = "outboxDown"
+	dbClientMock.On("DeleteDeviceByName", outboxDown.Name, pkgCommon.AnyRevision).Return(nil)
+	dbClientMock.On("DeviceByName", outboxDown.Name).Return(outboxDown, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.MatchedBy(func(cb pkgModels.DeviceServiceCallback) bool {
+		return cb.EntityName == outboxDown.Name
+	})).Return(pkgModels.DeviceServiceCallback{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "outbox unavailable", nil))
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -241,11 +254,16 @@ func TestDeleteDeviceByName(t *testing.T) {
 	tests := []struct {
 		name               string
 		deviceName         string
+		ifMatch            string
 		expectedStatusCode int
 	}{
-		{"Valid - delete device by name", device.Name, http.StatusOK},
-		{"Invalid - name parameter is empty", noName, http.StatusBadRequest},
-		{"Invalid - device not found by name", notFoundName, http.StatusNotFound},
+		{"Valid - delete device by name", device.Name, "", http.StatusOK},
+		{"Valid - device deleted although its callback is not added into the outbox", outboxDown.Name, "", http.StatusOK},
+		{"Valid - delete device by name at the expected revision", device.Name, `"2"`, http.StatusOK},
+		{"Invalid - name parameter is empty", noName, "", http.StatusBadRequest},
+		{"Invalid - device not found by name", notFoundName, "", http.StatusNotFound},
+		{"Invalid - malformed If-Match header", device.Name, "abc", http.StatusBadRequest},
+		{"Invalid - device not at the expected revision", device.Name, `"1"`, http.StatusPreconditionFailed},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -253,6 +271,9 @@ func TestDeleteDeviceByName(t *testing.T) {
 			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
 			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceName})
 			require.NoError(t, err)
+			if testCase.ifMatch != "" {
+				req.Header.Set(pkgCommon.IfMatch, testCase.ifMatch)
+			}
 
 			// Act
 			recorder := httptest.NewRecorder()
@@ -435,8 +456,8 @@ func TestPatchDevice(t *testing.T) {
 	dbClientMock.On("DeviceServiceNameExists", *valid.Device.ServiceName).Return(true, nil)
 	dbClientMock.On("DeviceProfileNameExists", *valid.Device.ProfileName).Return(true, nil)
 	dbClientMock.On("DeviceById", *valid.Device.Id).Return(dsModels, nil)
-	dbClientMock.On("UpdateDevice", mock.Anything).Return(nil)
-	dbClientMock.On("DeviceServiceByName", *valid.Device.ServiceName).Return(models.DeviceService{BaseAddress: testBaseAddress}, nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, pkgCommon.AnyRevision).Return(nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
 
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
@@ -558,6 +579,76 @@ func TestPatchDevice(t *testing.T) {
 
 }
 
+func TestPatchDeviceWithIfMatch(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	testReq := buildTestUpdateDeviceRequest()
+	device := models.Device{
+		Id:          *testReq.Device.Id,
+		Name:        *testReq.Device.Name,
+		ServiceName: *testReq.Device.ServiceName,
+		ProfileName: *testReq.Device.ProfileName,
+	}
+	dbClientMock.On("DeviceServiceNameExists", *testReq.Device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", *testReq.Device.ProfileName).Return(true, nil)
+	dbClientMock.On("DeviceById", *testReq.Device.Id).Return(device, nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, int64(2)).Return(nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, int64(1)).Return(errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch, "device is at revision 2", nil))
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            []requests.UpdateDeviceRequest
+		ifMatch            string
+		expectedStatusCode int
+	}{
+		{"Valid - device at the expected revision", []requests.UpdateDeviceRequest{testReq}, `"2"`, http.StatusMultiStatus},
+		{"Invalid - device not at the expected revision", []requests.UpdateDeviceRequest{testReq}, `"1"`, http.StatusPreconditionFailed},
+		{"Invalid - malformed If-Match header", []requests.UpdateDeviceRequest{testReq}, "abc", http.StatusBadRequest},
+		{"Invalid - If-Match header along with multiple devices", []requests.UpdateDeviceRequest{testReq, testReq}, `"2"`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPatch, common.ApiDeviceRoute, reader)
+			require.NoError(t, err)
+			req.Header.Set(pkgCommon.IfMatch, testCase.ifMatch)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.PatchDevice)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusMultiStatus {
+				var res []commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				assert.Equal(t, http.StatusOK, res[0].StatusCode, "BaseResponse status code not as expected")
+				return
+			}
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+			assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", 2)
+}
+
 func TestAllDevices(t *testing.T) {
 	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
 	devices := []models.Device{device, device, device}
@@ -639,6 +730,7 @@ func TestDeviceByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
 	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("Revision", device.Id).Return(int64(3), nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -687,6 +779,7 @@ func TestDeviceByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.deviceName, res.Device.Name, "Name not as expected")
+				assert.Equal(t, `"3"`, recorder.Header().Get(pkgCommon.ETag), "ETag not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
 			}
 		})
@@ -770,3 +863,145 @@ func TestDevicesByProfileName(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceHeartbeat(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	downDevice := device
+	downDevice.Name = "downDevice"
+	downDevice.OperatingState = models.Down
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateDeviceLastReported", device.Name, mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceLastReported", downDevice.Name, mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceLastReported", notFoundName, mock.Anything).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceByName", downDevice.Name).Return(downDevice, nil)
+	dbClientMock.On("Revision", downDevice.Id).Return(int64(3), nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, int64(3)).Return(nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+		expectedUp         bool
+	}{
+		{"Valid - heartbeat of UP device", device.Name, http.StatusOK, false},
+		{"Valid - heartbeat brings DOWN device UP", downDevice.Name, http.StatusOK, true},
+		{"Invalid - device not found by name", notFoundName, http.StatusNotFound, false},
+		{"Invalid - empty device name", "", http.StatusBadRequest, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqs := []pkgRequests.DeviceHeartbeatRequest{pkgRequests.NewDeviceHeartbeatRequest(testCase.deviceName, 0)}
+			jsonData, err := json.Marshal(reqs)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceHeartbeatRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceHeartbeat)
+			handler.ServeHTTP(recorder, req)
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				return
+			}
+			var res []commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, int(res[0].StatusCode), "BaseResponse status code not as expected")
+			if testCase.expectedUp {
+				dbClientMock.AssertCalled(t, "UpdateDevice", mock.MatchedBy(func(d models.Device) bool {
+					return d.Name == downDevice.Name && d.OperatingState == models.Up
+				}), int64(3))
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", 1)
+}
+
+func TestDevicesWithinRadius(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	nearby := []pkgModels.NearbyDevice{{Device: device, Distance: 12.5}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesWithinRadius", 45.5, -73.5, 1000.0, 0, 20).Return(nearby, uint32(1), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		latitude           string
+		longitude          string
+		radius             string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get devices within radius", "45.5", "-73.5", "1000", false, 1, http.StatusOK},
+		{"Invalid - latitude not a number", "north", "-73.5", "1000", true, 0, http.StatusBadRequest},
+		{"Invalid - latitude out of range", "90", "-73.5", "1000", true, 0, http.StatusBadRequest},
+		{"Invalid - negative radius", "45.5", "-73.5", "-1", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceWithinRadiusRoute, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{
+				pkgCommon.Latitude:  testCase.latitude,
+				pkgCommon.Longitude: testCase.longitude,
+				pkgCommon.Radius:    testCase.radius,
+			})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesWithinRadius)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res pkgResponses.MultiNearbyDevicesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, uint32(testCase.expectedCount), res.TotalCount, "Total count not as expected")
+				require.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+				assert.Equal(t, device.Name, res.Devices[0].Device.Name)
+				assert.Equal(t, 12.5, res.Devices[0].Distance)
+			}
+		})
+	}
+}
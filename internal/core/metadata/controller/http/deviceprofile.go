@@ -13,7 +13,9 @@ import (
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -23,6 +25,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 
 	"github.com/gorilla/mux"
 )
@@ -99,14 +102,23 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(w http.ResponseWriter, r
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	revision, err := parseIfMatchHeader(r, len(updateDeviceProfileReq))
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 	deviceProfiles := requestDTO.DeviceProfileReqToDeviceProfileModels(updateDeviceProfileReq)
 
 	var responses []interface{}
 	for i, d := range deviceProfiles {
 		var response interface{}
 		reqId := updateDeviceProfileReq[i].RequestId
-		err := application.UpdateDeviceProfile(d, ctx, dc.dic)
+		err := application.UpdateDeviceProfile(d, revision, ctx, dc.dic)
 		if err != nil {
+			if errors.Kind(err) == pkgCommon.KindRevisionMismatch {
+				utils.WriteErrorResponse(w, ctx, lc, err, reqId)
+				return
+			}
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
 			response = commonDTO.NewBaseResponse(
@@ -134,9 +146,8 @@ func (dc *DeviceProfileController) AddDeviceProfileByYaml(w http.ResponseWriter,
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	ctx := r.Context()
 
-	deviceProfileDTO, err := dc.reader.ReadDeviceProfileYaml(r)
-	if err != nil {
-		utils.WriteErrorResponse(w, ctx, lc, err, "")
+	deviceProfileDTO, ok := dc.readDeviceProfileYaml(w, r)
+	if !ok {
 		return
 	}
 	deviceProfile := dtos.ToDeviceProfileModel(deviceProfileDTO)
@@ -161,14 +172,18 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	ctx := r.Context()
 
-	deviceProfileDTO, err := dc.reader.ReadDeviceProfileYaml(r)
+	deviceProfileDTO, ok := dc.readDeviceProfileYaml(w, r)
+	if !ok {
+		return
+	}
+
+	revision, err := utils.ParseIfMatchHeader(r)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-
 	deviceProfile := dtos.ToDeviceProfileModel(deviceProfileDTO)
-	err = application.UpdateDeviceProfile(deviceProfile, ctx, dc.dic)
+	err = application.UpdateDeviceProfile(deviceProfile, revision, ctx, dc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -179,6 +194,55 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 	pkg.Encode(response, w, lc)
 }
 
+// ValidateDeviceProfileByYaml lints the uploaded device profile YAML and responds all the problems found without
+// adding the device profile
+func (dc *DeviceProfileController) ValidateDeviceProfileByYaml(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	data, err := dc.reader.ReadDeviceProfileYamlFile(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	problems := application.LintDeviceProfileYaml(data)
+
+	response := pkgResponses.NewDeviceProfileValidationResponse("", "", http.StatusOK, !application.HasLintErrors(problems), problems)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// readDeviceProfileYaml reads the uploaded device profile YAML and lints it before decoding. If the device profile is
+// invalid, the problems found are responded and false is returned.
+func (dc *DeviceProfileController) readDeviceProfileYaml(w http.ResponseWriter, r *http.Request) (dtos.DeviceProfile, bool) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	data, err := dc.reader.ReadDeviceProfileYamlFile(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return dtos.DeviceProfile{}, false
+	}
+	problems := application.LintDeviceProfileYaml(data)
+	if application.HasLintErrors(problems) {
+		response := pkgResponses.NewDeviceProfileValidationResponse("", "device profile yaml is invalid", http.StatusBadRequest, false, problems)
+		utils.WriteHttpHeader(w, ctx, http.StatusBadRequest)
+		pkg.Encode(response, w, lc)
+		return dtos.DeviceProfile{}, false
+	}
+
+	deviceProfileDTO, err := dc.reader.DecodeDeviceProfileYaml(data)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return dtos.DeviceProfile{}, false
+	}
+	return deviceProfileDTO, true
+}
+
 func (dc *DeviceProfileController) DeviceProfileByName(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	ctx := r.Context()
@@ -192,6 +256,11 @@ func (dc *DeviceProfileController) DeviceProfileByName(w http.ResponseWriter, r
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	err = writeETagHeader(w, deviceProfile.Id, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	response := responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, deviceProfile)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
@@ -206,7 +275,29 @@ func (dc *DeviceProfileController) DeleteDeviceProfileByName(w http.ResponseWrit
 	vars := mux.Vars(r)
 	name := vars[common.Name]
 
-	err := application.DeleteDeviceProfileByName(name, ctx, dc.dic)
+	cascade, dryRun, err := parseCascadeQueryString(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	revision, err := utils.ParseIfMatchHeader(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	if cascade {
+		devices, provisionWatchers, err := application.CascadeDeleteDeviceProfileByName(name, revision, dryRun, ctx, dc.dic)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		response := pkgResponses.NewCascadeDeleteResponse("", "", http.StatusOK, dryRun, devices, provisionWatchers)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		pkg.Encode(response, w, lc)
+		return
+	}
+
+	err = application.DeleteDeviceProfileByName(name, revision, ctx, dc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -19,6 +19,9 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
@@ -34,6 +37,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +102,11 @@ func mockDic() *di.Container {
 		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
 			return logger.NewMockClient()
 		},
+		container.CallbackDispatcherName: func(get di.Get) interface{} {
+			dispatcherMock := &dbMock.CallbackDispatcher{}
+			dispatcherMock.On("Notify", mock.Anything).Return()
+			return dispatcherMock
+		},
 	})
 }
 
@@ -364,10 +373,12 @@ func TestUpdateDeviceProfile(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("DeviceProfileByName", deviceProfileModel.Name).Return(deviceProfileModel, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundDeviceProfileModel.Name).Return(models.DeviceProfile{}, notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel, pkgCommon.AnyRevision).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel, pkgCommon.AnyRevision).Return(notFoundDBError)
 	dbClientMock.On("DevicesByProfileName", 0, -1, deviceProfileModel.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
-	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -653,10 +664,12 @@ func TestUpdateDeviceProfileByYaml(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("DeviceProfileByName", validDeviceProfileModel.Name).Return(validDeviceProfileModel, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundDeviceProfileModel.Name).Return(models.DeviceProfile{}, notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel, pkgCommon.AnyRevision).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel, pkgCommon.AnyRevision).Return(notFoundDBError)
 	dbClientMock.On("DevicesByProfileName", 0, -1, validDeviceProfileModel.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
-	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -703,6 +716,83 @@ func TestUpdateDeviceProfileByYaml(t *testing.T) {
 	}
 }
 
+func TestValidateDeviceProfileByYaml(t *testing.T) {
+	dic := mockDic()
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	valid, err := yaml.Marshal(buildTestDeviceProfileRequest().Profile)
+	require.NoError(t, err)
+	unknownResource := buildTestDeviceProfileRequest().Profile
+	unknownResource.DeviceCommands[0].ResourceOperations[0].DeviceResource = "unknown"
+	invalid, err := yaml.Marshal(unknownResource)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		fileContents       []byte
+		expectedStatusCode int
+		expectedValid      bool
+	}{
+		{"Valid - valid device profile", valid, http.StatusOK, true},
+		{"Valid - invalid device profile", invalid, http.StatusOK, false},
+		{"Invalid - empty file", []byte{}, http.StatusBadRequest, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := createDeviceProfileRequestWithFile(testCase.fileContents)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ValidateDeviceProfileByYaml)
+			handler.ServeHTTP(recorder, req)
+			var res pkgResponses.DeviceProfileValidationResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedValid, res.Valid, "Valid not as expected")
+			if testCase.expectedStatusCode == http.StatusOK && !testCase.expectedValid {
+				require.NotEmpty(t, res.Problems, "Problems should not be empty for the invalid device profile")
+				assert.NotZero(t, res.Problems[0].Line, "Problem line number not as expected")
+			}
+		})
+	}
+}
+
+func TestAddDeviceProfileByYaml_LintErrors(t *testing.T) {
+	dic := mockDic()
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	deviceProfile := buildTestDeviceProfileRequest().Profile
+	deviceProfile.DeviceResources[0].Properties.Minimum = "100"
+	deviceProfile.DeviceResources[0].Properties.Maximum = "10"
+	deviceProfile.DeviceCommands[0].ResourceOperations[0].DeviceResource = "unknown"
+	invalid, err := yaml.Marshal(deviceProfile)
+	require.NoError(t, err)
+	req, err := createDeviceProfileRequestWithFile(invalid)
+	require.NoError(t, err)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.AddDeviceProfileByYaml)
+	handler.ServeHTTP(recorder, req)
+	var res pkgResponses.DeviceProfileValidationResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode, "BaseResponse status code not as expected")
+	assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+	assert.False(t, res.Valid)
+	assert.Len(t, res.Problems, 2, "all the problems should be responded")
+}
+
 func TestDeviceProfileByName(t *testing.T) {
 	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
 	emptyName := ""
@@ -711,6 +801,7 @@ func TestDeviceProfileByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("Revision", mock.Anything).Return(int64(1), nil)
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
@@ -777,10 +868,12 @@ func TestDeleteDeviceProfileByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DevicesByProfileName", 0, 1, deviceProfile.Name).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByProfileName", 0, 1, deviceProfile.Name).Return([]models.ProvisionWatcher{}, nil)
-	dbClientMock.On("DeleteDeviceProfileByName", deviceProfile.Name).Return(nil)
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeleteDeviceProfileByName", deviceProfile.Name, pkgCommon.AnyRevision).Return(nil)
 	dbClientMock.On("DevicesByProfileName", 0, 1, notFoundName).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByProfileName", 0, 1, notFoundName).Return([]models.ProvisionWatcher{}, nil)
-	dbClientMock.On("DeleteDeviceProfileByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceProfileByName", notFoundName, pkgCommon.AnyRevision).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
 	dbClientMock.On("DevicesByProfileName", 0, 1, deviceExists).Return([]models.Device{models.Device{}}, nil)
 	dbClientMock.On("DevicesByProfileName", 0, 1, provisionWatcherExists).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByProfileName", 0, 1, provisionWatcherExists).Return([]models.ProvisionWatcher{models.ProvisionWatcher{}}, nil)
@@ -833,6 +926,77 @@ func TestDeleteDeviceProfileByName(t *testing.T) {
 	}
 }
 
+func TestCascadeDeleteDeviceProfileByName(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	devices := []models.Device{{Name: "device1", ProfileName: deviceProfile.Name, ServiceName: "service1"}}
+	provisionWatchers := []models.ProvisionWatcher{{Name: "watcher1", ProfileName: deviceProfile.Name, ServiceName: "service1"}}
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dbClientMock.On("DevicesByProfileName", 0, -1, deviceProfile.Name).Return(devices, nil)
+	dbClientMock.On("ProvisionWatchersByProfileName", 0, -1, deviceProfile.Name).Return(provisionWatchers, nil)
+	dbClientMock.On("CascadeDeleteDeviceProfileByName", deviceProfile.Name, pkgCommon.AnyRevision).Return(devices, provisionWatchers, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceProfileName  string
+		cascade            string
+		dryRun             string
+		expectedStatusCode int
+	}{
+		{"Valid - dry run of cascade deletion", deviceProfile.Name, "true", "true", http.StatusOK},
+		{"Valid - cascade deletion", deviceProfile.Name, "true", "false", http.StatusOK},
+		{"Invalid - device profile not found by name", notFoundName, "true", "true", http.StatusNotFound},
+		{"Invalid - dry run without cascade", deviceProfile.Name, "false", "true", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiDeviceProfileRoute, common.Name, testCase.deviceProfileName)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Cascade, testCase.cascade)
+			query.Add(pkgCommon.DryRun, testCase.dryRun)
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceProfileName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeleteDeviceProfileByName)
+			handler.ServeHTTP(recorder, req)
+			var res pkgResponses.CascadeDeleteResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, testCase.dryRun == "true", res.DryRun, "DryRun not as expected")
+			assert.Equal(t, []string{"device1"}, res.Devices, "Devices not as expected")
+			assert.Equal(t, []string{"watcher1"}, res.ProvisionWatchers, "ProvisionWatchers not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "CascadeDeleteDeviceProfileByName", 1)
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceServiceCallback", len(devices)+len(provisionWatchers))
+}
+
 func TestAllDeviceProfiles(t *testing.T) {
 	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
 	deviceProfiles := []models.DeviceProfile{deviceProfile, deviceProfile, deviceProfile}
@@ -13,7 +13,9 @@ import (
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -22,6 +24,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 
 	"github.com/gorilla/mux"
 )
@@ -98,6 +101,11 @@ func (dc *DeviceServiceController) DeviceServiceByName(w http.ResponseWriter, r
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	err = writeETagHeader(w, deviceService.Id, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	response := responseDTO.NewDeviceServiceResponse("", "", http.StatusOK, deviceService)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
@@ -119,13 +127,22 @@ func (dc *DeviceServiceController) PatchDeviceService(w http.ResponseWriter, r *
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	revision, err := parseIfMatchHeader(r, len(updateDeviceServiceDTOs))
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	var updateResponses []interface{}
 	for _, dto := range updateDeviceServiceDTOs {
 		var response interface{}
 		reqId := dto.RequestId
-		err := application.PatchDeviceService(dto.Service, ctx, dc.dic)
+		err := application.PatchDeviceService(dto.Service, revision, ctx, dc.dic)
 		if err != nil {
+			if errors.Kind(err) == pkgCommon.KindRevisionMismatch {
+				utils.WriteErrorResponse(w, ctx, lc, err, reqId)
+				return
+			}
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
 			response = commonDTO.NewBaseResponse(
@@ -153,7 +170,29 @@ func (dc *DeviceServiceController) DeleteDeviceServiceByName(w http.ResponseWrit
 	vars := mux.Vars(r)
 	name := vars[common.Name]
 
-	err := application.DeleteDeviceServiceByName(name, ctx, dc.dic)
+	cascade, dryRun, err := parseCascadeQueryString(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	revision, err := utils.ParseIfMatchHeader(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	if cascade {
+		devices, provisionWatchers, err := application.CascadeDeleteDeviceServiceByName(name, revision, dryRun, ctx, dc.dic)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		response := pkgResponses.NewCascadeDeleteResponse("", "", http.StatusOK, dryRun, devices, provisionWatchers)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		pkg.Encode(response, w, lc)
+		return
+	}
+
+	err = application.DeleteDeviceServiceByName(name, revision, ctx, dc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -186,3 +225,44 @@ func (dc *DeviceServiceController) AllDeviceServices(w http.ResponseWriter, r *h
 	// encode and send out the response
 	pkg.Encode(response, w, lc)
 }
+
+func (dc *DeviceServiceController) AllDeviceServiceHealth(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	health, err := application.AllDeviceServiceHealth(offset, limit, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDeviceServiceHealthResponse("", "", http.StatusOK, health)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceController) DeviceServiceHealthByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	health, err := application.DeviceServiceHealthByName(name, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewDeviceServiceHealthResponse("", "", http.StatusOK, health)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
@@ -15,6 +15,9 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
@@ -200,6 +203,7 @@ func TestDeviceServiceByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceServiceByName", deviceService.Name).Return(deviceService, nil)
+	dbClientMock.On("Revision", mock.Anything).Return(int64(1), nil)
 	dbClientMock.On("DeviceServiceByName", notFoundName).Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
@@ -270,7 +274,8 @@ func TestPatchDeviceService(t *testing.T) {
 
 	valid := testReq
 	dbClientMock.On("DeviceServiceById", *valid.Service.Id).Return(dsModels, nil)
-	dbClientMock.On("UpdateDeviceService", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceService", mock.Anything, pkgCommon.AnyRevision).Return(nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
 	validWithNoId := testReq
@@ -474,10 +479,12 @@ func TestDeleteDeviceServiceByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DevicesByServiceName", 0, 1, deviceService.Name).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByServiceName", 0, 1, deviceService.Name).Return([]models.ProvisionWatcher{}, nil)
-	dbClientMock.On("DeleteDeviceServiceByName", deviceService.Name).Return(nil)
+	dbClientMock.On("DeviceServiceByName", deviceService.Name).Return(deviceService, nil)
+	dbClientMock.On("DeleteDeviceServiceByName", deviceService.Name, pkgCommon.AnyRevision).Return(nil)
 	dbClientMock.On("DevicesByServiceName", 0, 1, notFoundName).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByServiceName", 0, 1, notFoundName).Return([]models.ProvisionWatcher{}, nil)
-	dbClientMock.On("DeleteDeviceServiceByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
+	dbClientMock.On("DeviceServiceByName", notFoundName).Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceServiceByName", notFoundName, pkgCommon.AnyRevision).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
 	dbClientMock.On("DevicesByServiceName", 0, 1, deviceExists).Return([]models.Device{models.Device{}}, nil)
 	dbClientMock.On("DevicesByServiceName", 0, 1, provisionWatcherExists).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByServiceName", 0, 1, provisionWatcherExists).Return([]models.ProvisionWatcher{models.ProvisionWatcher{}}, nil)
@@ -529,3 +536,151 @@ func TestDeleteDeviceServiceByName(t *testing.T) {
 		})
 	}
 }
+
+func TestCascadeDeleteDeviceServiceByName(t *testing.T) {
+	deviceService := dtos.ToDeviceServiceModel(buildTestDeviceServiceRequest().Service)
+	devices := []models.Device{{Name: "device1", ServiceName: deviceService.Name}, {Name: "device2", ServiceName: deviceService.Name}}
+	provisionWatchers := []models.ProvisionWatcher{{Name: "watcher1", ServiceName: deviceService.Name}}
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceByName", deviceService.Name).Return(deviceService, nil)
+	dbClientMock.On("DeviceServiceByName", notFoundName).Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
+	dbClientMock.On("DevicesByServiceName", 0, -1, deviceService.Name).Return(devices, nil)
+	dbClientMock.On("ProvisionWatchersByServiceName", 0, -1, deviceService.Name).Return(provisionWatchers, nil)
+	dbClientMock.On("CascadeDeleteDeviceServiceByName", deviceService.Name, pkgCommon.AnyRevision).Return(devices, provisionWatchers, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dbClientMock.On("Revision", deviceService.Id).Return(int64(2), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceServiceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceServiceName  string
+		cascade            string
+		dryRun             string
+		ifMatch            string
+		expectedStatusCode int
+	}{
+		{"Valid - dry run of cascade deletion", deviceService.Name, "true", "true", "", http.StatusOK},
+		{"Valid - dry run of cascade deletion at the expected revision", deviceService.Name, "true", "true", `"2"`, http.StatusOK},
+		{"Valid - cascade deletion", deviceService.Name, "true", "false", "", http.StatusOK},
+		{"Invalid - device service not found by name", notFoundName, "true", "true", "", http.StatusNotFound},
+		{"Invalid - dry run without cascade", deviceService.Name, "false", "true", "", http.StatusBadRequest},
+		{"Invalid - invalid cascade value", deviceService.Name, "invalid", "false", "", http.StatusBadRequest},
+		{"Invalid - dry run of device service not at the expected revision", deviceService.Name, "true", "true", `"1"`, http.StatusPreconditionFailed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s", common.ApiDeviceServiceByNameRoute, testCase.deviceServiceName)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Cascade, testCase.cascade)
+			query.Add(pkgCommon.DryRun, testCase.dryRun)
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceServiceName})
+			req.Header.Set(pkgCommon.IfMatch, testCase.ifMatch)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeleteDeviceServiceByName)
+			handler.ServeHTTP(recorder, req)
+			var res pkgResponses.CascadeDeleteResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, testCase.dryRun == "true", res.DryRun, "DryRun not as expected")
+			assert.Equal(t, []string{"device1", "device2"}, res.Devices, "Devices not as expected")
+			assert.Equal(t, []string{"watcher1"}, res.ProvisionWatchers, "ProvisionWatchers not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "CascadeDeleteDeviceServiceByName", 1)
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceServiceCallback", len(devices)+len(provisionWatchers))
+	dbClientMock.AssertCalled(t, "AddDeviceServiceCallback", mock.MatchedBy(func(cb pkgModels.DeviceServiceCallback) bool {
+		return cb.BaseAddress == deviceService.BaseAddress && cb.Action == pkgModels.DeleteDeviceAction
+	}))
+}
+
+func TestDeviceServiceHealthByName(t *testing.T) {
+	health := pkgModels.DeviceServiceHealth{
+		ServiceName:         testDeviceServiceName,
+		Status:              pkgModels.ServiceUnreachable,
+		ConsecutiveFailures: 3,
+		DownDevices:         []string{"testDevice"},
+	}
+	emptyName := ""
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceHealthByName", health.ServiceName).Return(health, nil)
+	dbClientMock.On("DeviceServiceHealthByName", notFoundName).Return(pkgModels.DeviceServiceHealth{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service health doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceServiceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceServiceName  string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find device service health by name", health.ServiceName, false, http.StatusOK},
+		{"Invalid - name parameter is empty", emptyName, true, http.StatusBadRequest},
+		{"Invalid - device service health not found by name", notFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s", pkgCommon.ApiDeviceServiceHealthRoute, common.Name, testCase.deviceServiceName)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceServiceName})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceServiceHealthByName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res pkgResponses.DeviceServiceHealthResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, pkgModels.ServiceUnreachable, res.Health.Status, "Health status not as expected")
+				assert.Equal(t, health.DownDevices, res.Health.DownDevices, "Down devices not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+type DeviceServiceCallbackController struct {
+	dic *di.Container
+}
+
+// NewDeviceServiceCallbackController creates and initializes an DeviceServiceCallbackController
+func NewDeviceServiceCallbackController(dic *di.Container) *DeviceServiceCallbackController {
+	return &DeviceServiceCallbackController{
+		dic: dic,
+	}
+}
+
+func (dc *DeviceServiceCallbackController) AllDeviceServiceCallbacks(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	callbacks, err := application.AllDeviceServiceCallbacks(offset, limit, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDeviceServiceCallbacksResponse("", "", http.StatusOK, callbacks)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceCallbackController) DeviceServiceCallbacksByStatus(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	vars := mux.Vars(r)
+	status := vars[common.Status]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	callbacks, err := application.DeviceServiceCallbacksByStatus(offset, limit, status, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDeviceServiceCallbacksResponse("", "", http.StatusOK, callbacks)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceCallbackController) DeviceServiceCallbacksByServiceName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	callbacks, err := application.DeviceServiceCallbacksByServiceName(offset, limit, name, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDeviceServiceCallbacksResponse("", "", http.StatusOK, callbacks)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceCallbackController) DeviceServiceCallbackById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	callback, err := application.DeviceServiceCallbackById(id, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewDeviceServiceCallbackResponse("", "", http.StatusOK, callback)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceCallbackController) RetriggerDeviceServiceCallbackById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	err := application.RetriggerDeviceServiceCallbackById(id, ctx, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusAccepted)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceCallbackController) RetriggerDeviceServiceCallbacksByServiceName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	err := application.RetriggerDeviceServiceCallbacksByServiceName(name, ctx, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusAccepted)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceServiceCallbackController) DeleteDeviceServiceCallbackById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	err := application.DeleteDeviceServiceCallbackById(id, ctx, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
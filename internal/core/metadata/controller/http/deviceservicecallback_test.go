@@ -0,0 +1,283 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestDeviceServiceCallback(status string) pkgModels.DeviceServiceCallback {
+	return pkgModels.DeviceServiceCallback{
+		Id:          ExampleUUID,
+		ServiceName: TestDeviceServiceName,
+		Action:      pkgModels.AddDeviceAction,
+		EntityName:  TestDeviceName,
+		Status:      pkgModels.CallbackStatus(status),
+		RetryCount:  3,
+		LastError:   "connection refused",
+	}
+}
+
+func TestDeviceServiceCallbacksByStatus(t *testing.T) {
+	failed := buildTestDeviceServiceCallback(pkgModels.CallbackFailed)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceCallbacksByStatus", 0, 20, pkgModels.CallbackFailed).Return([]pkgModels.DeviceServiceCallback{failed}, nil)
+	dbClientMock.On("DeviceServiceCallbacksByStatus", 0, 20, pkgModels.CallbackPending).Return([]pkgModels.DeviceServiceCallback{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceServiceCallbackController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		status             string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - find failed callbacks", pkgModels.CallbackFailed, false, 1, http.StatusOK},
+		{"Valid - find pending callbacks", pkgModels.CallbackPending, false, 0, http.StatusOK},
+		{"Invalid - unknown status", "DELIVERED", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s", pkgCommon.ApiDeviceServiceCallbackRoute, common.Status, testCase.status)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			query := req.URL.Query()
+			query.Add(common.Limit, "20")
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{common.Status: testCase.status})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceServiceCallbacksByStatus)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res pkgResponses.MultiDeviceServiceCallbacksResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedCount, len(res.Callbacks), "Callback count not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestDeviceServiceCallbackById(t *testing.T) {
+	failed := buildTestDeviceServiceCallback(pkgModels.CallbackFailed)
+	notFoundId := "notFoundId"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceCallbackById", failed.Id).Return(failed, nil)
+	dbClientMock.On("DeviceServiceCallbackById", notFoundId).Return(pkgModels.DeviceServiceCallback{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "callback doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceServiceCallbackController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		id                 string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find callback by id", failed.Id, false, http.StatusOK},
+		{"Invalid - id parameter is empty", "", true, http.StatusBadRequest},
+		{"Invalid - callback not found by id", notFoundId, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s", pkgCommon.ApiDeviceServiceCallbackRoute, common.Id, testCase.id)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceServiceCallbackById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res pkgResponses.DeviceServiceCallbackResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, failed.Id, res.Callback.Id, "Id not as expected")
+				assert.Equal(t, failed.LastError, res.Callback.LastError, "LastError not as expected")
+				assert.Equal(t, failed.RetryCount, res.Callback.RetryCount, "RetryCount not as expected")
+			}
+		})
+	}
+}
+
+func TestRetriggerDeviceServiceCallbackById(t *testing.T) {
+	failed := buildTestDeviceServiceCallback(pkgModels.CallbackFailed)
+	notFoundId := "notFoundId"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceCallbackById", failed.Id).Return(failed, nil)
+	dbClientMock.On("DeviceServiceCallbackById", notFoundId).Return(pkgModels.DeviceServiceCallback{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "callback doesn't exist in the database", nil))
+	dbClientMock.On("UpdateDeviceServiceCallback", mock.MatchedBy(func(cb pkgModels.DeviceServiceCallback) bool {
+		return cb.Id == failed.Id && cb.Status == pkgModels.CallbackPending && cb.RetryCount == 0
+	})).Return(nil)
+	dispatcherMock := &dbMock.CallbackDispatcher{}
+	dispatcherMock.On("Notify", failed.ServiceName).Return()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.CallbackDispatcherName: func(get di.Get) interface{} {
+			return dispatcherMock
+		},
+	})
+	controller := NewDeviceServiceCallbackController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		id                 string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - retrigger callback by id", failed.Id, false, http.StatusAccepted},
+		{"Invalid - id parameter is empty", "", true, http.StatusBadRequest},
+		{"Invalid - callback not found by id", notFoundId, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s/%s", pkgCommon.ApiDeviceServiceCallbackRoute, common.Id, testCase.id, pkgCommon.Retrigger)
+			req, err := http.NewRequest(http.MethodPost, reqPath, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.RetriggerDeviceServiceCallbackById)
+			handler.ServeHTTP(recorder, req)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				dispatcherMock.AssertCalled(t, "Notify", failed.ServiceName)
+			}
+		})
+	}
+}
+
+func TestDeleteDeviceServiceCallbackById(t *testing.T) {
+	notFoundId := "notFoundId"
+
+	failed := buildTestDeviceServiceCallback(pkgModels.CallbackFailed)
+	failed.Id = ExampleUUID
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceCallbackById", ExampleUUID).Return(failed, nil)
+	dbClientMock.On("DeviceServiceCallbackById", notFoundId).Return(pkgModels.DeviceServiceCallback{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "callback doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceServiceCallbackById", ExampleUUID).Return(nil)
+	dispatcherMock := &dbMock.CallbackDispatcher{}
+	dispatcherMock.On("Notify", failed.ServiceName).Return()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.CallbackDispatcherName: func(get di.Get) interface{} {
+			return dispatcherMock
+		},
+	})
+	controller := NewDeviceServiceCallbackController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		id                 string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - delete callback by id", ExampleUUID, false, http.StatusOK},
+		{"Invalid - id parameter is empty", "", true, http.StatusBadRequest},
+		{"Invalid - callback not found by id", notFoundId, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s", pkgCommon.ApiDeviceServiceCallbackRoute, common.Id, testCase.id)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeleteDeviceServiceCallbackById)
+			handler.ServeHTTP(recorder, req)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				dispatcherMock.AssertCalled(t, "Notify", failed.ServiceName)
+			}
+		})
+	}
+}
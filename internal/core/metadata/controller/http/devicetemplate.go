@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+type DeviceTemplateController struct {
+	reader io.DeviceTemplateReader
+	dic    *di.Container
+}
+
+// NewDeviceTemplateController creates and initializes an DeviceTemplateController
+func NewDeviceTemplateController(dic *di.Container) *DeviceTemplateController {
+	return &DeviceTemplateController{
+		reader: io.NewDeviceTemplateRequestReader(),
+		dic:    dic,
+	}
+}
+
+func (dc *DeviceTemplateController) AddDeviceTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	addDeviceTemplateDTOs, err := dc.reader.ReadAddDeviceTemplateRequest(r.Body)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	var addResponses []interface{}
+	for _, dto := range addDeviceTemplateDTOs {
+		var response interface{}
+		reqId := dto.RequestId
+		newId, err := application.AddDeviceTemplate(pkgDtos.ToDeviceTemplateModel(dto.Template), ctx, dc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				err.Message(),
+				err.Code())
+		} else {
+			response = commonDTO.NewBaseWithIdResponse(
+				reqId,
+				"",
+				http.StatusCreated,
+				newId)
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	pkg.Encode(addResponses, w, lc)
+}
+
+func (dc *DeviceTemplateController) AllDeviceTemplates(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	templates, err := application.AllDeviceTemplates(offset, limit, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDeviceTemplatesResponse("", "", http.StatusOK, templates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceTemplateController) DeviceTemplateByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	template, err := application.DeviceTemplateByName(name, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewDeviceTemplateResponse("", "", http.StatusOK, template)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceTemplateController) DeleteDeviceTemplateByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	err := application.DeleteDeviceTemplateByName(name, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// InstantiateDeviceTemplateByName creates the devices from the template and the per-device variables of the uploaded
+// CSV file, either all the devices are created or none of them
+func (dc *DeviceTemplateController) InstantiateDeviceTemplateByName(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	dryRun, err := utils.ParseQueryStringToBool(r, pkgCommon.DryRun, false)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	records, err := dc.reader.ReadDeviceVariablesCsvFile(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	devices, err := application.InstantiateDeviceTemplate(name, records, dryRun, ctx, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	statusCode := http.StatusCreated
+	if dryRun {
+		statusCode = http.StatusOK
+	}
+	response := pkgResponses.NewInstantiateDevicesResponse("", "", statusCode, dryRun, devices)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
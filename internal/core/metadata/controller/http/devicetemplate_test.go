@@ -0,0 +1,211 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeviceTemplateName = "TestDeviceTemplate"
+
+func buildTestDeviceTemplate() pkgDtos.DeviceTemplate {
+	return pkgDtos.DeviceTemplate{
+		Name:        testDeviceTemplateName,
+		ServiceName: TestDeviceServiceName,
+		ProfileName: TestDeviceProfileName,
+		AdminState:  models.Unlocked,
+		Protocols: map[string]dtos.ProtocolProperties{
+			"modbus-tcp": {"Address": "{{address}}", "Port": "502"},
+		},
+	}
+}
+
+func createDeviceTemplateInstantiateRequest(name string, csvContents string, query string) (*http.Request, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "devices.csv")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = part.Write([]byte(csvContents)); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceTemplateInstantiateByNameRoute+query, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(common.ContentType, writer.FormDataContentType())
+	return mux.SetURLVars(req, map[string]string{common.Name: name}), nil
+}
+
+func TestAddDeviceTemplate(t *testing.T) {
+	valid := pkgRequests.NewAddDeviceTemplateRequest(buildTestDeviceTemplate())
+	valid.RequestId = ExampleUUID
+	malformed := valid
+	malformed.Template = buildTestDeviceTemplate()
+	malformed.Template.Name = "malformed"
+	malformed.Template.Protocols = map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "{{address"}}
+	noProtocols := valid
+	noProtocols.Template = buildTestDeviceTemplate()
+	noProtocols.Template.Protocols = nil
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("AddDeviceTemplate", mock.Anything).Return(pkgModels.DeviceTemplate{Id: ExampleUUID}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                   string
+		request                []pkgRequests.AddDeviceTemplateRequest
+		expectedHttpStatusCode int
+		expectedStatusCode     int
+	}{
+		{"Valid", []pkgRequests.AddDeviceTemplateRequest{valid}, http.StatusMultiStatus, http.StatusCreated},
+		{"Invalid - malformed placeholder", []pkgRequests.AddDeviceTemplateRequest{malformed}, http.StatusMultiStatus, http.StatusBadRequest},
+		{"Invalid - no protocols", []pkgRequests.AddDeviceTemplateRequest{noProtocols}, http.StatusBadRequest, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceTemplateRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddDeviceTemplate)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedHttpStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedHttpStatusCode != http.StatusMultiStatus {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				return
+			}
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id, "Id not as expected")
+			} else {
+				assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestInstantiateDeviceTemplateByName(t *testing.T) {
+	validCsv := "name,address\nthermostat-1,10.0.0.1\nthermostat-2,10.0.0.2\n"
+	missingColumnCsv := "name,port\nthermostat-1,502\n"
+	notFoundName := "notFoundTemplate"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", testDeviceTemplateName).Return(pkgDtos.ToDeviceTemplateModel(buildTestDeviceTemplate()), nil)
+	dbClientMock.On("DeviceTemplateByName", notFoundName).Return(pkgModels.DeviceTemplate{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device template doesn't exist", nil))
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+	dbClientMock.On("AddDevice", mock.Anything).Return(func(d models.Device) models.Device {
+		d.Id = ExampleUUID
+		return d
+	}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                 string
+		templateName         string
+		csv                  string
+		query                string
+		expectedStatusCode   int
+		expectedDevicesCount int
+	}{
+		{"Valid", testDeviceTemplateName, validCsv, "", http.StatusCreated, 2},
+		{"Valid - dry run", testDeviceTemplateName, validCsv, "?dryRun=true", http.StatusOK, 2},
+		{"Invalid - missing placeholder column", testDeviceTemplateName, missingColumnCsv, "", http.StatusBadRequest, 0},
+		{"Invalid - malformed csv", testDeviceTemplateName, "name,address\nthermostat-1\n", "", http.StatusBadRequest, 0},
+		{"Invalid - invalid dryRun", testDeviceTemplateName, validCsv, "?dryRun=yes", http.StatusBadRequest, 0},
+		{"Not found - template", notFoundName, validCsv, "", http.StatusNotFound, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := createDeviceTemplateInstantiateRequest(testCase.templateName, testCase.csv, testCase.query)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.InstantiateDeviceTemplateByName)
+			handler.ServeHTTP(recorder, req)
+			var res pkgResponses.InstantiateDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Len(t, res.Devices, testCase.expectedDevicesCount)
+			if testCase.expectedDevicesCount > 0 {
+				assert.Equal(t, "10.0.0.2", res.Devices[1].Protocols["modbus-tcp"]["Address"])
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDevice", 2)
+}
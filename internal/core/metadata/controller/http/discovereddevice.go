@@ -0,0 +1,218 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+type DiscoveredDeviceController struct {
+	reader io.DiscoveredDeviceReader
+	dic    *di.Container
+}
+
+// NewDiscoveredDeviceController creates and initializes an DiscoveredDeviceController
+func NewDiscoveredDeviceController(dic *di.Container) *DiscoveredDeviceController {
+	return &DiscoveredDeviceController{
+		reader: io.NewDiscoveredDeviceRequestReader(),
+		dic:    dic,
+	}
+}
+
+// AddDiscoveredDevice matches the devices submitted by the device service against the provision watchers, the result
+// of each device tells whether it is created, waiting for the approval or ignored
+func (dc *DiscoveredDeviceController) AddDiscoveredDevice(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	addDiscoveredDeviceDTOs, err := dc.reader.ReadAddDiscoveredDeviceRequest(r.Body)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	var responses []interface{}
+	for _, dto := range addDiscoveredDeviceDTOs {
+		var response interface{}
+		reqId := dto.RequestId
+		id, result, watcherName, err := application.SubmitDiscoveredDevice(pkgDtos.ToDiscoveredDeviceModel(dto.Device), ctx, dc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				err.Message(),
+				err.Code())
+		} else {
+			response = pkgResponses.NewDiscoveryResultResponse(
+				reqId,
+				"",
+				discoveryResultStatusCode(result),
+				id,
+				string(result),
+				watcherName)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	pkg.Encode(responses, w, lc)
+}
+
+func (dc *DiscoveredDeviceController) AllDiscoveredDevices(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	devices, err := application.AllDiscoveredDevices(offset, limit, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDiscoveredDevicesResponse("", "", http.StatusOK, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DiscoveredDeviceController) DiscoveredDevicesByStatus(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	vars := mux.Vars(r)
+	status := vars[common.Status]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	devices, err := application.DiscoveredDevicesByStatus(offset, limit, status, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiDiscoveredDevicesResponse("", "", http.StatusOK, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DiscoveredDeviceController) DiscoveredDeviceById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	device, err := application.DiscoveredDeviceById(id, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewDiscoveredDeviceResponse("", "", http.StatusOK, device)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DiscoveredDeviceController) DeleteDiscoveredDeviceById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	err := application.DeleteDiscoveredDeviceById(id, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+// ApproveDiscoveredDeviceById adds the pending discovered device as a device, the id of the device is responded
+func (dc *DiscoveredDeviceController) ApproveDiscoveredDeviceById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	deviceId, err := application.ApproveDiscoveredDevice(id, ctx, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseWithIdResponse("", "", http.StatusCreated, deviceId)
+	utils.WriteHttpHeader(w, ctx, http.StatusCreated)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DiscoveredDeviceController) RejectDiscoveredDeviceById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	err := application.RejectDiscoveredDevice(id, ctx, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func discoveryResultStatusCode(result pkgModels.DiscoveryResult) int {
+	switch result {
+	case pkgModels.DiscoveryCreated:
+		return http.StatusCreated
+	case pkgModels.DiscoveryPending:
+		return http.StatusAccepted
+	default:
+		return http.StatusOK
+	}
+}
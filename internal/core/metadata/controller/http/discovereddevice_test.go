@@ -0,0 +1,193 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testDiscoveredDeviceId = "97fd1e2b-5da8-4f4f-9b8a-4e6a3c4d2e10"
+
+func buildTestDiscoveredDeviceRequest(name string, address string) pkgRequests.AddDiscoveredDeviceRequest {
+	req := pkgRequests.NewAddDiscoveredDeviceRequest(pkgDtos.DiscoveredDevice{
+		Name:        name,
+		ServiceName: TestDeviceServiceName,
+		Protocols:   map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": address, "Port": "502"}},
+	})
+	req.RequestId = ExampleUUID
+	return req
+}
+
+func TestAddDiscoveredDevice(t *testing.T) {
+	watcher := models.ProvisionWatcher{
+		Name:        testProvisionWatcherName,
+		Identifiers: map[string]string{"Address": "^10\\.0\\.0\\."},
+		ServiceName: TestDeviceServiceName,
+		ProfileName: TestDeviceProfileName,
+		AdminState:  models.Unlocked,
+	}
+	matched := buildTestDiscoveredDeviceRequest("matchedDevice", "10.0.0.1")
+	notMatched := buildTestDiscoveredDeviceRequest("notMatchedDevice", "192.168.0.1")
+	noProtocols := buildTestDiscoveredDeviceRequest("invalidDevice", "10.0.0.1")
+	noProtocols.Device.Protocols = nil
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+	dbClientMock.On("DiscoveredDeviceByName", mock.Anything).Return(pkgModels.DiscoveredDevice{}, notFound)
+	dbClientMock.On("ProvisionWatchersByServiceName", 0, -1, TestDeviceServiceName).Return([]models.ProvisionWatcher{watcher}, nil)
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("AddDevice", mock.Anything).Return(models.Device{Id: ExampleUUID}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDiscoveredDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                   string
+		request                []pkgRequests.AddDiscoveredDeviceRequest
+		expectedHttpStatusCode int
+		expectedStatusCode     int
+		expectedResult         pkgModels.DiscoveryResult
+	}{
+		{"Valid - matched device", []pkgRequests.AddDiscoveredDeviceRequest{matched}, http.StatusMultiStatus, http.StatusCreated, pkgModels.DiscoveryCreated},
+		{"Valid - not matched device", []pkgRequests.AddDiscoveredDeviceRequest{notMatched}, http.StatusMultiStatus, http.StatusOK, pkgModels.DiscoveryNotMatched},
+		{"Invalid - no protocols", []pkgRequests.AddDiscoveredDeviceRequest{noProtocols}, http.StatusBadRequest, http.StatusBadRequest, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDiscoveredDeviceRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddDiscoveredDevice)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedHttpStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedHttpStatusCode != http.StatusMultiStatus {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res []pkgResponses.DiscoveryResultResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, ExampleUUID, res[0].RequestId, "RequestID not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			assert.Equal(t, string(testCase.expectedResult), res[0].Result, "Discovery result not as expected")
+			if testCase.expectedResult == pkgModels.DiscoveryCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id, "Device id not as expected")
+				assert.Equal(t, testProvisionWatcherName, res[0].ProvisionWatcherName, "Provision watcher not as expected")
+			}
+		})
+	}
+}
+
+func TestApproveDiscoveredDeviceById(t *testing.T) {
+	pending := pkgModels.DiscoveredDevice{
+		Id:                   testDiscoveredDeviceId,
+		Name:                 "pendingDevice",
+		ServiceName:          TestDeviceServiceName,
+		Protocols:            map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1"}},
+		ProvisionWatcherName: testProvisionWatcherName,
+		ProfileName:          TestDeviceProfileName,
+		Status:               pkgModels.DiscoveredDevicePending,
+	}
+	notFoundId := "b3a1f1a8-0d25-4b8e-9b1e-7e3f1c9d0a55"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DiscoveredDeviceById", pending.Id).Return(pending, nil)
+	dbClientMock.On("DiscoveredDeviceById", notFoundId).Return(pkgModels.DiscoveredDevice{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "discovered device doesn't exist", nil))
+	dbClientMock.On("ProvisionWatcherByName", testProvisionWatcherName).Return(models.ProvisionWatcher{Name: testProvisionWatcherName}, nil)
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("AddDevice", mock.Anything).Return(models.Device{Id: ExampleUUID}, nil)
+	dbClientMock.On("AddDeviceServiceCallback", mock.Anything).Return(pkgModels.DeviceServiceCallback{}, nil)
+	dbClientMock.On("DeleteDiscoveredDeviceById", pending.Id).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDiscoveredDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - approve pending device", pending.Id, http.StatusCreated},
+		{"Invalid - discovered device not found", notFoundId, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDiscoveredDeviceApproveByIdRoute, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Id: testCase.id})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ApproveDiscoveredDeviceById)
+			handler.ServeHTTP(recorder, req)
+			var res commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res.Id, "Device id not as expected")
+				dbClientMock.AssertCalled(t, "DeleteDiscoveredDeviceById", pending.Id)
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -21,6 +22,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/gorilla/mux"
 )
 
@@ -96,6 +98,11 @@ func (pwc *ProvisionWatcherController) ProvisionWatcherByName(w http.ResponseWri
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	err = writeETagHeader(w, provisionWatcher.Id, pwc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	response := responseDTO.NewProvisionWatcherResponse("", "", http.StatusOK, provisionWatcher)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
@@ -182,7 +189,12 @@ func (pwc *ProvisionWatcherController) DeleteProvisionWatcherByName(w http.Respo
 	vars := mux.Vars(r)
 	name := vars[common.Name]
 
-	err := application.DeleteProvisionWatcherByName(ctx, name, pwc.dic)
+	revision, err := utils.ParseIfMatchHeader(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	err = application.DeleteProvisionWatcherByName(ctx, name, revision, pwc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -208,13 +220,22 @@ func (pwc *ProvisionWatcherController) PatchProvisionWatcher(w http.ResponseWrit
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	revision, err := parseIfMatchHeader(r, len(updateProvisionWatcherDTOs))
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
 
 	var updateResponses []interface{}
 	for _, dto := range updateProvisionWatcherDTOs {
 		var response interface{}
 		reqId := dto.RequestId
-		err := application.PatchProvisionWatcher(ctx, dto.ProvisionWatcher, pwc.dic)
+		err := application.PatchProvisionWatcher(ctx, dto.ProvisionWatcher, revision, pwc.dic)
 		if err != nil {
+			if errors.Kind(err) == pkgCommon.KindRevisionMismatch {
+				utils.WriteErrorResponse(w, ctx, lc, err, reqId)
+				return
+			}
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
 			response = commonDTO.NewBaseResponse(
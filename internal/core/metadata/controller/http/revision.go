@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// parseIfMatchHeader parses the expected revision from the If-Match header of a request which updates the given count
+// of entities, the header is only allowed along with a single entity since one ETag can't match several entities
+func parseIfMatchHeader(r *http.Request, count int) (int64, errors.EdgeX) {
+	revision, err := utils.ParseIfMatchHeader(r)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if revision != pkgCommon.AnyRevision && count != 1 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the %s header is only supported when updating a single entity", pkgCommon.IfMatch), nil)
+	}
+	return revision, nil
+}
+
+// writeETagHeader writes the current revision of the entity as the ETag header, it shall be invoked before the status
+// code is written
+func writeETagHeader(w http.ResponseWriter, id string, dic *di.Container) errors.EdgeX {
+	revision, err := application.EntityRevision(id, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	utils.WriteETagHeader(w, revision)
+	return nil
+}
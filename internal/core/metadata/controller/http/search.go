@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+)
+
+type SearchController struct {
+	dic *di.Container
+}
+
+// NewSearchController creates and initializes an SearchController
+func NewSearchController(dic *di.Container) *SearchController {
+	return &SearchController{
+		dic: dic,
+	}
+}
+
+// Search queries the devices, device profiles and device services matching the terms of the q query parameter,
+// the type query parameter restricts the searched entity types
+func (sc *SearchController) Search(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(sc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	query := utils.ParseQueryStringToString(r, pkgCommon.Query, "")
+	types := utils.ParseQueryStringToStrings(r, pkgCommon.Type, common.CommaSeparator)
+
+	hits, totalCount, err := application.Search(query, types, offset, limit, sc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewSearchResponse("", "", http.StatusOK, totalCount, hits)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
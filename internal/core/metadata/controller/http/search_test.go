@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	hits := []pkgModels.SearchHit{
+		{Type: pkgModels.SearchDevice, Id: ExampleUUID, Name: "thermostat-1", Labels: []string{"hvac"}},
+		{Type: pkgModels.SearchDeviceProfile, Id: ExampleUUID, Name: TestDeviceProfileName, Labels: []string{"hvac"}},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SearchMetadata", "hvac", []string{"hvac"}, []pkgModels.SearchEntityType{}, 0, 20).Return(hits, uint32(2), nil)
+	dbClientMock.On("SearchMetadata", "hvac", []string{"hvac"}, []pkgModels.SearchEntityType{pkgModels.SearchDevice}, 0, 20).Return(hits[:1], uint32(1), nil)
+	dbClientMock.On("SearchMetadata", "hvac", []string{"hvac"}, []pkgModels.SearchEntityType{}, 5, 20).Return(nil, uint32(0),
+		errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, "query objects bounds out of range", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSearchController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - all types", "?q=HVAC", 2, http.StatusOK},
+		{"Valid - device type", "?q=hvac&type=device", 1, http.StatusOK},
+		{"Invalid - no query", "", 0, http.StatusBadRequest},
+		{"Invalid - unsupported type", "?q=hvac&type=interval", 0, http.StatusBadRequest},
+		{"Invalid - offset out of range", "?q=hvac&offset=5", 0, http.StatusRequestedRangeNotSatisfiable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiSearchRoute+testCase.query, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.Search)
+			handler.ServeHTTP(recorder, req)
+			var res pkgResponses.SearchResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Len(t, res.Hits, int(testCase.expectedTotalCount))
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+	"sync"
+)
+
+// CallbackDispatcher delivers the callbacks persisted in the outbox to the device services. The callbacks of the same
+// device service are delivered in the order they were created, and the failed deliveries are retried with backoff.
+type CallbackDispatcher interface {
+	Start(ctx context.Context, wg *sync.WaitGroup)
+	Notify(serviceName string)
+}
@@ -8,16 +8,19 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
 	AddDeviceProfile(e model.DeviceProfile) (model.DeviceProfile, errors.EdgeX)
-	UpdateDeviceProfile(e model.DeviceProfile) errors.EdgeX
+	UpdateDeviceProfile(e model.DeviceProfile, revision int64) errors.EdgeX
 	DeviceProfileByName(name string) (model.DeviceProfile, errors.EdgeX)
 	DeleteDeviceProfileById(id string) errors.EdgeX
-	DeleteDeviceProfileByName(name string) errors.EdgeX
+	DeleteDeviceProfileByName(name string, revision int64) errors.EdgeX
+	CascadeDeleteDeviceProfileByName(name string, revision int64) ([]model.Device, []model.ProvisionWatcher, errors.EdgeX)
 	DeviceProfileNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceProfiles(offset int, limit int, labels []string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX)
@@ -28,14 +31,16 @@ type DBClient interface {
 	DeviceServiceById(id string) (model.DeviceService, errors.EdgeX)
 	DeviceServiceByName(name string) (model.DeviceService, errors.EdgeX)
 	DeleteDeviceServiceById(id string) errors.EdgeX
-	DeleteDeviceServiceByName(name string) errors.EdgeX
+	DeleteDeviceServiceByName(name string, revision int64) errors.EdgeX
+	CascadeDeleteDeviceServiceByName(name string, revision int64) ([]model.Device, []model.ProvisionWatcher, errors.EdgeX)
 	DeviceServiceNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceServices(offset int, limit int, labels []string) ([]model.DeviceService, errors.EdgeX)
-	UpdateDeviceService(ds model.DeviceService) errors.EdgeX
+	UpdateDeviceService(ds model.DeviceService, revision int64) errors.EdgeX
+	UpdateDeviceServiceLastConnected(name string, lastConnected int64) errors.EdgeX
 
 	AddDevice(d model.Device) (model.Device, errors.EdgeX)
 	DeleteDeviceById(id string) errors.EdgeX
-	DeleteDeviceByName(name string) errors.EdgeX
+	DeleteDeviceByName(name string, revision int64) errors.EdgeX
 	DevicesByServiceName(offset int, limit int, name string) ([]model.Device, errors.EdgeX)
 	DeviceIdExists(id string) (bool, errors.EdgeX)
 	DeviceNameExists(id string) (bool, errors.EdgeX)
@@ -43,7 +48,8 @@ type DBClient interface {
 	DeviceByName(name string) (model.Device, errors.EdgeX)
 	AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX)
 	DevicesByProfileName(offset int, limit int, profileName string) ([]model.Device, errors.EdgeX)
-	UpdateDevice(d model.Device) errors.EdgeX
+	UpdateDevice(d model.Device, revision int64) errors.EdgeX
+	UpdateDeviceLastReported(name string, lastReported int64) errors.EdgeX
 
 	AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatcherById(id string) (model.ProvisionWatcher, errors.EdgeX)
@@ -51,6 +57,45 @@ type DBClient interface {
 	ProvisionWatchersByServiceName(offset int, limit int, name string) ([]model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatchersByProfileName(offset int, limit int, name string) ([]model.ProvisionWatcher, errors.EdgeX)
 	AllProvisionWatchers(offset int, limit int, labels []string) ([]model.ProvisionWatcher, errors.EdgeX)
-	DeleteProvisionWatcherByName(name string) errors.EdgeX
-	UpdateProvisionWatcher(pw model.ProvisionWatcher) errors.EdgeX
+	DeleteProvisionWatcherByName(name string, revision int64) errors.EdgeX
+	UpdateProvisionWatcher(pw model.ProvisionWatcher, revision int64) errors.EdgeX
+	Revision(id string) (int64, errors.EdgeX)
+	SearchMetadata(namePrefix string, terms []string, types []pkgModels.SearchEntityType, offset int, limit int) ([]pkgModels.SearchHit, uint32, errors.EdgeX)
+	EnsureSearchIndex() (int, errors.EdgeX)
+	DevicesWithinRadius(latitude float64, longitude float64, radius float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX)
+	DevicesWithinBoundingBox(south float64, west float64, north float64, east float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX)
+	DevicesBySite(offset int, limit int, site string) ([]model.Device, errors.EdgeX)
+	EnsureDeviceGeoIndex() (int, errors.EdgeX)
+
+	AddDeviceServiceCallback(cb pkgModels.DeviceServiceCallback) (pkgModels.DeviceServiceCallback, errors.EdgeX)
+	UpdateDeviceServiceCallback(cb pkgModels.DeviceServiceCallback) errors.EdgeX
+	DeviceServiceCallbackById(id string) (pkgModels.DeviceServiceCallback, errors.EdgeX)
+	DeleteDeviceServiceCallbackById(id string) errors.EdgeX
+	AllDeviceServiceCallbacks(offset int, limit int) ([]pkgModels.DeviceServiceCallback, errors.EdgeX)
+	DeviceServiceCallbacksByStatus(offset int, limit int, status string) ([]pkgModels.DeviceServiceCallback, errors.EdgeX)
+	DeviceServiceCallbacksByServiceName(offset int, limit int, name string) ([]pkgModels.DeviceServiceCallback, errors.EdgeX)
+
+	DeviceServiceHealthByName(name string) (pkgModels.DeviceServiceHealth, errors.EdgeX)
+	UpdateDeviceServiceHealth(health pkgModels.DeviceServiceHealth) errors.EdgeX
+	AllDeviceServiceHealth(offset int, limit int) ([]pkgModels.DeviceServiceHealth, errors.EdgeX)
+
+	AddDiscoveredDevice(d pkgModels.DiscoveredDevice) (pkgModels.DiscoveredDevice, errors.EdgeX)
+	UpdateDiscoveredDevice(d pkgModels.DiscoveredDevice) errors.EdgeX
+	DiscoveredDeviceById(id string) (pkgModels.DiscoveredDevice, errors.EdgeX)
+	DiscoveredDeviceByName(name string) (pkgModels.DiscoveredDevice, errors.EdgeX)
+	DeleteDiscoveredDeviceById(id string) errors.EdgeX
+	AllDiscoveredDevices(offset int, limit int) ([]pkgModels.DiscoveredDevice, errors.EdgeX)
+	DiscoveredDevicesByStatus(offset int, limit int, status string) ([]pkgModels.DiscoveredDevice, errors.EdgeX)
+
+	AddDeviceTemplate(t pkgModels.DeviceTemplate) (pkgModels.DeviceTemplate, errors.EdgeX)
+	DeviceTemplateByName(name string) (pkgModels.DeviceTemplate, errors.EdgeX)
+	DeleteDeviceTemplateByName(name string) errors.EdgeX
+	AllDeviceTemplates(offset int, limit int) ([]pkgModels.DeviceTemplate, errors.EdgeX)
+
+	AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX)
 }
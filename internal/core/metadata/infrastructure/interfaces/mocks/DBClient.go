@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,6 +17,29 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddAuditRecord provides a mock function with given fields: r
+func (_m *DBClient) AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	var r0 pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(pkgModels.AuditRecord) pkgModels.AuditRecord); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(pkgModels.AuditRecord)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.AuditRecord) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDevice provides a mock function with given fields: d
 func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	ret := _m.Called(d)
@@ -84,6 +109,75 @@ func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceServ
 	return r0, r1
 }
 
+// AddDeviceServiceCallback provides a mock function with given fields: cb
+func (_m *DBClient) AddDeviceServiceCallback(cb pkgModels.DeviceServiceCallback) (pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	ret := _m.Called(cb)
+
+	var r0 pkgModels.DeviceServiceCallback
+	if rf, ok := ret.Get(0).(func(pkgModels.DeviceServiceCallback) pkgModels.DeviceServiceCallback); ok {
+		r0 = rf(cb)
+	} else {
+		r0 = ret.Get(0).(pkgModels.DeviceServiceCallback)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.DeviceServiceCallback) errors.EdgeX); ok {
+		r1 = rf(cb)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeviceTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddDeviceTemplate(t pkgModels.DeviceTemplate) (pkgModels.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	var r0 pkgModels.DeviceTemplate
+	if rf, ok := ret.Get(0).(func(pkgModels.DeviceTemplate) pkgModels.DeviceTemplate); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(pkgModels.DeviceTemplate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.DeviceTemplate) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDiscoveredDevice provides a mock function with given fields: d
+func (_m *DBClient) AddDiscoveredDevice(d pkgModels.DiscoveredDevice) (pkgModels.DiscoveredDevice, errors.EdgeX) {
+	ret := _m.Called(d)
+
+	var r0 pkgModels.DiscoveredDevice
+	if rf, ok := ret.Get(0).(func(pkgModels.DiscoveredDevice) pkgModels.DiscoveredDevice); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Get(0).(pkgModels.DiscoveredDevice)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.DiscoveredDevice) errors.EdgeX); ok {
+		r1 = rf(d)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddProvisionWatcher provides a mock function with given fields: pw
 func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(pw)
@@ -132,6 +226,56 @@ func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([
 	return r0, r1
 }
 
+// AllDeviceServiceCallbacks provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceServiceCallbacks(offset int, limit int) ([]pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgModels.DeviceServiceCallback
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.DeviceServiceCallback); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DeviceServiceCallback)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllDeviceServiceHealth provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceServiceHealth(offset int, limit int) ([]pkgModels.DeviceServiceHealth, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgModels.DeviceServiceHealth
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.DeviceServiceHealth); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DeviceServiceHealth)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDeviceServices provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([]models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -157,6 +301,31 @@ func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([
 	return r0, r1
 }
 
+// AllDeviceTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceTemplates(offset int, limit int) ([]pkgModels.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgModels.DeviceTemplate
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.DeviceTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DeviceTemplate)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDevices provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -182,6 +351,31 @@ func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models
 	return r0, r1
 }
 
+// AllDiscoveredDevices provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDiscoveredDevices(offset int, limit int) ([]pkgModels.DiscoveredDevice, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgModels.DiscoveredDevice
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.DiscoveredDevice); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DiscoveredDevice)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllProvisionWatchers provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string) ([]models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -207,11 +401,202 @@ func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string)
 	return r0, r1
 }
 
+// AuditRecordsByActor provides a mock function with given fields: service, actor, offset, limit
+func (_m *DBClient) AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, actor, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, actor, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(service, actor, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByEntity provides a mock function with given fields: entityType, name, offset, limit
+func (_m *DBClient) AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(entityType, name, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(pkgModels.AuditEntityType, string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(entityType, name, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.AuditEntityType, string, int, int) errors.EdgeX); ok {
+		r1 = rf(entityType, name, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByService provides a mock function with given fields: service, offset, limit
+func (_m *DBClient) AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int) errors.EdgeX); ok {
+		r1 = rf(service, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByTimeRange provides a mock function with given fields: service, start, end, offset, limit
+func (_m *DBClient) AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, start, end, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int, int, int) errors.EdgeX); ok {
+		r1 = rf(service, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CascadeDeleteDeviceProfileByName provides a mock function with given fields: name, revision
+func (_m *DBClient) CascadeDeleteDeviceProfileByName(name string, revision int64) ([]models.Device, []models.ProvisionWatcher, errors.EdgeX) {
+	ret := _m.Called(name, revision)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(string, int64) []models.Device); ok {
+		r0 = rf(name, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 []models.ProvisionWatcher
+	if rf, ok := ret.Get(1).(func(string, int64) []models.ProvisionWatcher); ok {
+		r1 = rf(name, revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.ProvisionWatcher)
+		}
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(string, int64) errors.EdgeX); ok {
+		r2 = rf(name, revision)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// CascadeDeleteDeviceServiceByName provides a mock function with given fields: name, revision
+func (_m *DBClient) CascadeDeleteDeviceServiceByName(name string, revision int64) ([]models.Device, []models.ProvisionWatcher, errors.EdgeX) {
+	ret := _m.Called(name, revision)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(string, int64) []models.Device); ok {
+		r0 = rf(name, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 []models.ProvisionWatcher
+	if rf, ok := ret.Get(1).(func(string, int64) []models.ProvisionWatcher); ok {
+		r1 = rf(name, revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.ProvisionWatcher)
+		}
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(string, int64) errors.EdgeX); ok {
+		r2 = rf(name, revision)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteAuditRecordsByAge provides a mock function with given fields: service, age
+func (_m *DBClient) DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX) {
+	ret := _m.Called(service, age)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int64) int); ok {
+		r0 = rf(service, age)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int64) errors.EdgeX); ok {
+		r1 = rf(service, age)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteDeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -228,13 +613,13 @@ func (_m *DBClient) DeleteDeviceById(id string) errors.EdgeX {
 	return r0
 }
 
-// DeleteDeviceByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteDeviceByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceByName provides a mock function with given fields: name, revision
+func (_m *DBClient) DeleteDeviceByName(name string, revision int64) errors.EdgeX {
+	ret := _m.Called(name, revision)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(name, revision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -260,13 +645,13 @@ func (_m *DBClient) DeleteDeviceProfileById(id string) errors.EdgeX {
 	return r0
 }
 
-// DeleteDeviceProfileByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteDeviceProfileByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceProfileByName provides a mock function with given fields: name, revision
+func (_m *DBClient) DeleteDeviceProfileByName(name string, revision int64) errors.EdgeX {
+	ret := _m.Called(name, revision)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(name, revision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -292,13 +677,13 @@ func (_m *DBClient) DeleteDeviceServiceById(id string) errors.EdgeX {
 	return r0
 }
 
-// DeleteDeviceServiceByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceServiceByName provides a mock function with given fields: name, revision
+func (_m *DBClient) DeleteDeviceServiceByName(name string, revision int64) errors.EdgeX {
+	ret := _m.Called(name, revision)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(name, revision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -308,13 +693,13 @@ func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return r0
 }
 
-// DeleteProvisionWatcherByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceServiceCallbackById provides a mock function with given fields: id
+func (_m *DBClient) DeleteDeviceServiceCallbackById(id string) errors.EdgeX {
+	ret := _m.Called(id)
 
 	var r0 errors.EdgeX
 	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+		r0 = rf(id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -324,9 +709,57 @@ func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	return r0
 }
 
-// DeviceById provides a mock function with given fields: id
-func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
-	ret := _m.Called(id)
+// DeleteDeviceTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceTemplateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDiscoveredDeviceById provides a mock function with given fields: id
+func (_m *DBClient) DeleteDiscoveredDeviceById(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProvisionWatcherByName provides a mock function with given fields: name, revision
+func (_m *DBClient) DeleteProvisionWatcherByName(name string, revision int64) errors.EdgeX {
+	ret := _m.Called(name, revision)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(name, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeviceById provides a mock function with given fields: id
+func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
+	ret := _m.Called(id)
 
 	var r0 models.Device
 	if rf, ok := ret.Get(0).(func(string) models.Device); ok {
@@ -336,8 +769,317 @@ func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceByName(name string) (models.Device, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 models.Device
+	if rf, ok := ret.Get(0).(func(string) models.Device); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(models.Device)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceIdExists provides a mock function with given fields: id
+func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceNameExists provides a mock function with given fields: id
+func (_m *DBClient) DeviceNameExists(id string) (bool, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceProfileByName(name string) (models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(string) models.DeviceProfile); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(models.DeviceProfile)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileNameExists provides a mock function with given fields: name
+func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
+func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(offset, limit, manufacturer)
+
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
+		r0 = rf(offset, limit, manufacturer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, manufacturer)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfilesByManufacturerAndModel provides a mock function with given fields: offset, limit, manufacturer, model
+func (_m *DBClient) DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(offset, limit, manufacturer, model)
+
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []models.DeviceProfile); ok {
+		r0 = rf(offset, limit, manufacturer, model)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, manufacturer, model)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfilesByModel provides a mock function with given fields: offset, limit, model
+func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(offset, limit, model)
+
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
+		r0 = rf(offset, limit, model)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, model)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServiceById provides a mock function with given fields: id
+func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 models.DeviceService
+	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.DeviceService)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServiceByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceServiceByName(name string) (models.DeviceService, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 models.DeviceService
+	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(models.DeviceService)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServiceCallbackById provides a mock function with given fields: id
+func (_m *DBClient) DeviceServiceCallbackById(id string) (pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 pkgModels.DeviceServiceCallback
+	if rf, ok := ret.Get(0).(func(string) pkgModels.DeviceServiceCallback); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(pkgModels.DeviceServiceCallback)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServiceCallbacksByServiceName provides a mock function with given fields: offset, limit, name
+func (_m *DBClient) DeviceServiceCallbacksByServiceName(offset int, limit int, name string) ([]pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	ret := _m.Called(offset, limit, name)
+
+	var r0 []pkgModels.DeviceServiceCallback
+	if rf, ok := ret.Get(0).(func(int, int, string) []pkgModels.DeviceServiceCallback); ok {
+		r0 = rf(offset, limit, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DeviceServiceCallback)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServiceCallbacksByStatus provides a mock function with given fields: offset, limit, status
+func (_m *DBClient) DeviceServiceCallbacksByStatus(offset int, limit int, status string) ([]pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	ret := _m.Called(offset, limit, status)
+
+	var r0 []pkgModels.DeviceServiceCallback
+	if rf, ok := ret.Get(0).(func(int, int, string) []pkgModels.DeviceServiceCallback); ok {
+		r0 = rf(offset, limit, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DeviceServiceCallback)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, status)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -347,15 +1089,15 @@ func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
-// DeviceByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceByName(name string) (models.Device, errors.EdgeX) {
+// DeviceServiceHealthByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceServiceHealthByName(name string) (pkgModels.DeviceServiceHealth, errors.EdgeX) {
 	ret := _m.Called(name)
 
-	var r0 models.Device
-	if rf, ok := ret.Get(0).(func(string) models.Device); ok {
+	var r0 pkgModels.DeviceServiceHealth
+	if rf, ok := ret.Get(0).(func(string) pkgModels.DeviceServiceHealth); ok {
 		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.Device)
+		r0 = ret.Get(0).(pkgModels.DeviceServiceHealth)
 	}
 
 	var r1 errors.EdgeX
@@ -370,20 +1112,20 @@ func (_m *DBClient) DeviceByName(name string) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
-// DeviceIdExists provides a mock function with given fields: id
-func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
-	ret := _m.Called(id)
+// DeviceServiceNameExists provides a mock function with given fields: name
+func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
+	ret := _m.Called(name)
 
 	var r0 bool
 	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(id)
+		r0 = rf(name)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 errors.EdgeX
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(id)
+		r1 = rf(name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -393,20 +1135,20 @@ func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
-// DeviceNameExists provides a mock function with given fields: id
-func (_m *DBClient) DeviceNameExists(id string) (bool, errors.EdgeX) {
-	ret := _m.Called(id)
+// DeviceTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceTemplateByName(name string) (pkgModels.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(id)
+	var r0 pkgModels.DeviceTemplate
+	if rf, ok := ret.Get(0).(func(string) pkgModels.DeviceTemplate); ok {
+		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(pkgModels.DeviceTemplate)
 	}
 
 	var r1 errors.EdgeX
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(id)
+		r1 = rf(name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -416,20 +1158,22 @@ func (_m *DBClient) DeviceNameExists(id string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
-// DeviceProfileByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceProfileByName(name string) (models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(name)
+// DevicesByProfileName provides a mock function with given fields: offset, limit, profileName
+func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, profileName)
 
-	var r0 models.DeviceProfile
-	if rf, ok := ret.Get(0).(func(string) models.DeviceProfile); ok {
-		r0 = rf(name)
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+		r0 = rf(offset, limit, profileName)
 	} else {
-		r0 = ret.Get(0).(models.DeviceProfile)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, profileName)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -439,20 +1183,22 @@ func (_m *DBClient) DeviceProfileByName(name string) (models.DeviceProfile, erro
 	return r0, r1
 }
 
-// DeviceProfileNameExists provides a mock function with given fields: name
-func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
-	ret := _m.Called(name)
+// DevicesByServiceName provides a mock function with given fields: offset, limit, name
+func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, name)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(name)
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+		r0 = rf(offset, limit, name)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -462,22 +1208,22 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
-// DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
-func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(offset, limit, manufacturer)
+// DevicesBySite provides a mock function with given fields: offset, limit, site
+func (_m *DBClient) DevicesBySite(offset int, limit int, site string) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, site)
 
-	var r0 []models.DeviceProfile
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
-		r0 = rf(offset, limit, manufacturer)
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+		r0 = rf(offset, limit, site)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]models.Device)
 		}
 	}
 
 	var r1 errors.EdgeX
 	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, manufacturer)
+		r1 = rf(offset, limit, site)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -487,65 +1233,79 @@ func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufact
 	return r0, r1
 }
 
-// DeviceProfilesByManufacturerAndModel provides a mock function with given fields: offset, limit, manufacturer, model
-func (_m *DBClient) DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(offset, limit, manufacturer, model)
+// DevicesWithinBoundingBox provides a mock function with given fields: south, west, north, east, offset, limit
+func (_m *DBClient) DevicesWithinBoundingBox(south float64, west float64, north float64, east float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	ret := _m.Called(south, west, north, east, offset, limit)
 
-	var r0 []models.DeviceProfile
-	if rf, ok := ret.Get(0).(func(int, int, string, string) []models.DeviceProfile); ok {
-		r0 = rf(offset, limit, manufacturer, model)
+	var r0 []pkgModels.NearbyDevice
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, float64, int, int) []pkgModels.NearbyDevice); ok {
+		r0 = rf(south, west, north, east, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]pkgModels.NearbyDevice)
 		}
 	}
 
-	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int, string, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, manufacturer, model)
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(float64, float64, float64, float64, int, int) uint32); ok {
+		r1 = rf(south, west, north, east, offset, limit)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(errors.EdgeX)
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(float64, float64, float64, float64, int, int) errors.EdgeX); ok {
+		r2 = rf(south, west, north, east, offset, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
 		}
 	}
 
-	return r0, r1
+	return r0, r1, r2
 }
 
-// DeviceProfilesByModel provides a mock function with given fields: offset, limit, model
-func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) ([]models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(offset, limit, model)
+// DevicesWithinRadius provides a mock function with given fields: latitude, longitude, radius, offset, limit
+func (_m *DBClient) DevicesWithinRadius(latitude float64, longitude float64, radius float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	ret := _m.Called(latitude, longitude, radius, offset, limit)
 
-	var r0 []models.DeviceProfile
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
-		r0 = rf(offset, limit, model)
+	var r0 []pkgModels.NearbyDevice
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, int, int) []pkgModels.NearbyDevice); ok {
+		r0 = rf(latitude, longitude, radius, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]pkgModels.NearbyDevice)
 		}
 	}
 
-	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, model)
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(float64, float64, float64, int, int) uint32); ok {
+		r1 = rf(latitude, longitude, radius, offset, limit)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(errors.EdgeX)
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(float64, float64, float64, int, int) errors.EdgeX); ok {
+		r2 = rf(latitude, longitude, radius, offset, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
 		}
 	}
 
-	return r0, r1
+	return r0, r1, r2
 }
 
-// DeviceServiceById provides a mock function with given fields: id
-func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.EdgeX) {
+// DiscoveredDeviceById provides a mock function with given fields: id
+func (_m *DBClient) DiscoveredDeviceById(id string) (pkgModels.DiscoveredDevice, errors.EdgeX) {
 	ret := _m.Called(id)
 
-	var r0 models.DeviceService
-	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+	var r0 pkgModels.DiscoveredDevice
+	if rf, ok := ret.Get(0).(func(string) pkgModels.DiscoveredDevice); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(models.DeviceService)
+		r0 = ret.Get(0).(pkgModels.DiscoveredDevice)
 	}
 
 	var r1 errors.EdgeX
@@ -560,15 +1320,15 @@ func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.E
 	return r0, r1
 }
 
-// DeviceServiceByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceServiceByName(name string) (models.DeviceService, errors.EdgeX) {
+// DiscoveredDeviceByName provides a mock function with given fields: name
+func (_m *DBClient) DiscoveredDeviceByName(name string) (pkgModels.DiscoveredDevice, errors.EdgeX) {
 	ret := _m.Called(name)
 
-	var r0 models.DeviceService
-	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+	var r0 pkgModels.DiscoveredDevice
+	if rf, ok := ret.Get(0).(func(string) pkgModels.DiscoveredDevice); ok {
 		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.DeviceService)
+		r0 = ret.Get(0).(pkgModels.DiscoveredDevice)
 	}
 
 	var r1 errors.EdgeX
@@ -583,20 +1343,22 @@ func (_m *DBClient) DeviceServiceByName(name string) (models.DeviceService, erro
 	return r0, r1
 }
 
-// DeviceServiceNameExists provides a mock function with given fields: name
-func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
-	ret := _m.Called(name)
+// DiscoveredDevicesByStatus provides a mock function with given fields: offset, limit, status
+func (_m *DBClient) DiscoveredDevicesByStatus(offset int, limit int, status string) ([]pkgModels.DiscoveredDevice, errors.EdgeX) {
+	ret := _m.Called(offset, limit, status)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(name)
+	var r0 []pkgModels.DiscoveredDevice
+	if rf, ok := ret.Get(0).(func(int, int, string) []pkgModels.DiscoveredDevice); ok {
+		r0 = rf(offset, limit, status)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.DiscoveredDevice)
+		}
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, status)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -606,22 +1368,20 @@ func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
-// DevicesByProfileName provides a mock function with given fields: offset, limit, profileName
-func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]models.Device, errors.EdgeX) {
-	ret := _m.Called(offset, limit, profileName)
+// EnsureDeviceGeoIndex provides a mock function with given fields:
+func (_m *DBClient) EnsureDeviceGeoIndex() (int, errors.EdgeX) {
+	ret := _m.Called()
 
-	var r0 []models.Device
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
-		r0 = rf(offset, limit, profileName)
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Device)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, profileName)
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -631,22 +1391,20 @@ func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName stri
 	return r0, r1
 }
 
-// DevicesByServiceName provides a mock function with given fields: offset, limit, name
-func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]models.Device, errors.EdgeX) {
-	ret := _m.Called(offset, limit, name)
+// EnsureSearchIndex provides a mock function with given fields:
+func (_m *DBClient) EnsureSearchIndex() (int, errors.EdgeX) {
+	ret := _m.Called()
 
-	var r0 []models.Device
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
-		r0 = rf(offset, limit, name)
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Device)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, name)
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -752,13 +1510,68 @@ func (_m *DBClient) ProvisionWatchersByServiceName(offset int, limit int, name s
 	return r0, r1
 }
 
-// UpdateDevice provides a mock function with given fields: d
-func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
-	ret := _m.Called(d)
+// Revision provides a mock function with given fields: id
+func (_m *DBClient) Revision(id string) (int64, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SearchMetadata provides a mock function with given fields: namePrefix, terms, types, offset, limit
+func (_m *DBClient) SearchMetadata(namePrefix string, terms []string, types []pkgModels.SearchEntityType, offset int, limit int) ([]pkgModels.SearchHit, uint32, errors.EdgeX) {
+	ret := _m.Called(namePrefix, terms, types, offset, limit)
+
+	var r0 []pkgModels.SearchHit
+	if rf, ok := ret.Get(0).(func(string, []string, []pkgModels.SearchEntityType, int, int) []pkgModels.SearchHit); ok {
+		r0 = rf(namePrefix, terms, types, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.SearchHit)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(string, []string, []pkgModels.SearchEntityType, int, int) uint32); ok {
+		r1 = rf(namePrefix, terms, types, offset, limit)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(string, []string, []pkgModels.SearchEntityType, int, int) errors.EdgeX); ok {
+		r2 = rf(namePrefix, terms, types, offset, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateDevice provides a mock function with given fields: d, revision
+func (_m *DBClient) UpdateDevice(d models.Device, revision int64) errors.EdgeX {
+	ret := _m.Called(d, revision)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Device) errors.EdgeX); ok {
-		r0 = rf(d)
+	if rf, ok := ret.Get(0).(func(models.Device, int64) errors.EdgeX); ok {
+		r0 = rf(d, revision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -768,13 +1581,13 @@ func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
 	return r0
 }
 
-// UpdateDeviceProfile provides a mock function with given fields: e
-func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
-	ret := _m.Called(e)
+// UpdateDeviceLastReported provides a mock function with given fields: name, lastReported
+func (_m *DBClient) UpdateDeviceLastReported(name string, lastReported int64) errors.EdgeX {
+	ret := _m.Called(name, lastReported)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceProfile) errors.EdgeX); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(name, lastReported)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -784,13 +1597,13 @@ func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 	return r0
 }
 
-// UpdateProvisionWatcher provides a mock function with given fields: pw
-func (_m *DBClient) UpdateProvisionWatcher(pw models.ProvisionWatcher) errors.EdgeX {
-	ret := _m.Called(pw)
+// UpdateDeviceProfile provides a mock function with given fields: e, revision
+func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile, revision int64) errors.EdgeX {
+	ret := _m.Called(e, revision)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.ProvisionWatcher) errors.EdgeX); ok {
-		r0 = rf(pw)
+	if rf, ok := ret.Get(0).(func(models.DeviceProfile, int64) errors.EdgeX); ok {
+		r0 = rf(e, revision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -800,13 +1613,93 @@ func (_m *DBClient) UpdateProvisionWatcher(pw models.ProvisionWatcher) errors.Ed
 	return r0
 }
 
-// UpdateDeviceService provides a mock function with given fields: ds
-func (_m *DBClient) UpdateDeviceService(ds models.DeviceService) errors.EdgeX {
-	ret := _m.Called(ds)
+// UpdateDeviceService provides a mock function with given fields: ds, revision
+func (_m *DBClient) UpdateDeviceService(ds models.DeviceService, revision int64) errors.EdgeX {
+	ret := _m.Called(ds, revision)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceService) errors.EdgeX); ok {
-		r0 = rf(ds)
+	if rf, ok := ret.Get(0).(func(models.DeviceService, int64) errors.EdgeX); ok {
+		r0 = rf(ds, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDeviceServiceCallback provides a mock function with given fields: cb
+func (_m *DBClient) UpdateDeviceServiceCallback(cb pkgModels.DeviceServiceCallback) errors.EdgeX {
+	ret := _m.Called(cb)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgModels.DeviceServiceCallback) errors.EdgeX); ok {
+		r0 = rf(cb)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDeviceServiceHealth provides a mock function with given fields: health
+func (_m *DBClient) UpdateDeviceServiceHealth(health pkgModels.DeviceServiceHealth) errors.EdgeX {
+	ret := _m.Called(health)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgModels.DeviceServiceHealth) errors.EdgeX); ok {
+		r0 = rf(health)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDeviceServiceLastConnected provides a mock function with given fields: name, lastConnected
+func (_m *DBClient) UpdateDeviceServiceLastConnected(name string, lastConnected int64) errors.EdgeX {
+	ret := _m.Called(name, lastConnected)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(name, lastConnected)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDiscoveredDevice provides a mock function with given fields: d
+func (_m *DBClient) UpdateDiscoveredDevice(d pkgModels.DiscoveredDevice) errors.EdgeX {
+	ret := _m.Called(d)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgModels.DiscoveredDevice) errors.EdgeX); ok {
+		r0 = rf(d)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateProvisionWatcher provides a mock function with given fields: pw, revision
+func (_m *DBClient) UpdateProvisionWatcher(pw models.ProvisionWatcher, revision int64) errors.EdgeX {
+	ret := _m.Called(pw, revision)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.ProvisionWatcher, int64) errors.EdgeX); ok {
+		r0 = rf(pw, revision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -16,11 +16,18 @@ package metadata
 
 import (
 	"context"
-
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application/callback"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application/liveness"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/gorilla/mux"
 )
 
@@ -40,5 +47,36 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	LoadRestRoutes(b.router, dic)
 
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	// initialize clients required by the service
+	dic.Update(di.ServiceConstructorMap{
+		container.NotificationClientName: func(get di.Get) interface{} { // add v2 API NotificationClient
+			return clients.NewNotificationClient(configuration.Clients[common.SupportNotificationsServiceKey].Url())
+		},
+	})
+
+	// V2 audit trail
+	if !audit.StartTrail(ctx, wg, dic, common.CoreMetaDataServiceKey, configuration.Audit, container.DBClientFrom(dic.Get)) {
+		return false
+	}
+
+	// V2 device service callback outbox
+	dispatcher := callback.NewDispatcher(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.CallbackDispatcherName: func(get di.Get) interface{} {
+			return dispatcher
+		},
+	})
+	dispatcher.Start(ctx, wg)
+
+	// V2 search and geo indexes of the entities stored before the indexes were introduced
+	application.EnsureSearchIndex(dic)
+	application.EnsureDeviceGeoIndex(dic)
+
+	// V2 device liveness tracking
+	liveness.NewMonitor(dic).Start(ctx, wg)
+	liveness.NewServiceMonitor(dic).Start(ctx, wg)
+
 	return true
 }
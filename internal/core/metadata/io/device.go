@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"io"
 
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
 	dtoRequest "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 )
@@ -17,6 +19,7 @@ import (
 type DeviceReader interface {
 	ReadAddDeviceRequest(reader io.Reader) ([]dtoRequest.AddDeviceRequest, errors.EdgeX)
 	ReadUpdateDeviceRequest(reader io.Reader) ([]dtoRequest.UpdateDeviceRequest, errors.EdgeX)
+	ReadDeviceHeartbeatRequest(reader io.Reader) ([]pkgRequests.DeviceHeartbeatRequest, errors.EdgeX)
 }
 
 // NewRequestReader returns a BodyReader capable of processing the request body
@@ -51,3 +54,13 @@ func (jsonDeviceReader) ReadUpdateDeviceRequest(reader io.Reader) ([]dtoRequest.
 	}
 	return updateDevices, nil
 }
+
+// ReadDeviceHeartbeatRequest reads a request and then converts its JSON data into an array of DeviceHeartbeatRequest struct
+func (jsonDeviceReader) ReadDeviceHeartbeatRequest(reader io.Reader) ([]pkgRequests.DeviceHeartbeatRequest, errors.EdgeX) {
+	var heartbeats []pkgRequests.DeviceHeartbeatRequest
+	err := json.NewDecoder(reader).Decode(&heartbeats)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "device heartbeat json decoding failed", err)
+	}
+	return heartbeats, nil
+}
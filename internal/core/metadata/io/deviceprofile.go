@@ -21,6 +21,8 @@ import (
 type DeviceProfileReader interface {
 	ReadDeviceProfileRequest(reader io.Reader) ([]dto.DeviceProfileRequest, errors.EdgeX)
 	ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX)
+	ReadDeviceProfileYamlFile(r *http.Request) ([]byte, errors.EdgeX)
+	DecodeDeviceProfileYaml(data []byte) (dtos.DeviceProfile, errors.EdgeX)
 }
 
 // NewRequestReader returns a BodyReader capable of processing the request body
@@ -47,24 +49,37 @@ func (jsonDeviceProfileReader) ReadDeviceProfileRequest(reader io.Reader) ([]dto
 }
 
 // ReadDeviceProfileYaml reads and converts the request's YAML file into an DeviceProfile struct
-func (jsonDeviceProfileReader) ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX) {
+func (j jsonDeviceProfileReader) ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX) {
+	data, err := j.ReadDeviceProfileYamlFile(r)
+	if err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return j.DecodeDeviceProfileYaml(data)
+}
+
+// ReadDeviceProfileYamlFile reads the content of the request's YAML file
+func (jsonDeviceProfileReader) ReadDeviceProfileYamlFile(r *http.Request) ([]byte, errors.EdgeX) {
 	var f multipart.File
 	f, _, err := r.FormFile("file")
 	if err != nil {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing yaml file", err)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing yaml file", err)
 	}
 
 	data, err := io.ReadAll(f)
 	if err != nil {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to read yaml file", err)
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to read yaml file", err)
 	}
 	if len(data) == 0 {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "yaml file is empty", err)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "yaml file is empty", err)
 	}
+	return data, nil
+}
 
+// DecodeDeviceProfileYaml converts the YAML data into an DeviceProfile struct
+func (jsonDeviceProfileReader) DecodeDeviceProfileYaml(data []byte) (dtos.DeviceProfile, errors.EdgeX) {
 	var dp dtos.DeviceProfile
 
-	err = yaml.Unmarshal(data, &dp)
+	err := yaml.Unmarshal(data, &dp)
 	if err != nil {
 		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal yaml file", err)
 	}
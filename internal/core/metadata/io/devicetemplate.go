@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package io
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceTemplateReader unmarshals a request body into an array of AddDeviceTemplateRequest type, or reads the CSV file
+// holding the per-device variables of the template instantiation
+type DeviceTemplateReader interface {
+	ReadAddDeviceTemplateRequest(reader io.Reader) ([]pkgRequests.AddDeviceTemplateRequest, errors.EdgeX)
+	ReadDeviceVariablesCsvFile(r *http.Request) ([][]string, errors.EdgeX)
+}
+
+// NewDeviceTemplateRequestReader returns a BodyReader capable of processing the request body
+func NewDeviceTemplateRequestReader() DeviceTemplateReader {
+	return jsonDeviceTemplateReader{}
+}
+
+// jsonDeviceTemplateReader unmarshals the JSON request body payload
+type jsonDeviceTemplateReader struct{}
+
+// ReadAddDeviceTemplateRequest reads a request and then converts its JSON data into an array of AddDeviceTemplateRequest struct
+func (jsonDeviceTemplateReader) ReadAddDeviceTemplateRequest(reader io.Reader) ([]pkgRequests.AddDeviceTemplateRequest, errors.EdgeX) {
+	var requests []pkgRequests.AddDeviceTemplateRequest
+	err := json.NewDecoder(reader).Decode(&requests)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "device template json decoding failed", err)
+	}
+	return requests, nil
+}
+
+// ReadDeviceVariablesCsvFile reads the records of the request's CSV file, the first record is the header
+func (jsonDeviceTemplateReader) ReadDeviceVariablesCsvFile(r *http.Request) ([][]string, errors.EdgeX) {
+	f, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing csv file", err)
+	}
+
+	reader := csv.NewReader(f)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse csv file", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "csv file is empty", nil)
+	}
+	return records, nil
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package io
+
+import (
+	"encoding/json"
+	"io"
+
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DiscoveredDeviceReader unmarshals a request body into an array of AddDiscoveredDeviceRequest type
+type DiscoveredDeviceReader interface {
+	ReadAddDiscoveredDeviceRequest(reader io.Reader) ([]pkgRequests.AddDiscoveredDeviceRequest, errors.EdgeX)
+}
+
+// NewDiscoveredDeviceRequestReader returns a BodyReader capable of processing the request body
+func NewDiscoveredDeviceRequestReader() DiscoveredDeviceReader {
+	return jsonDiscoveredDeviceReader{}
+}
+
+// jsonDiscoveredDeviceReader unmarshals the JSON request body payload
+type jsonDiscoveredDeviceReader struct{}
+
+// ReadAddDiscoveredDeviceRequest reads a request and then converts its JSON data into an array of AddDiscoveredDeviceRequest struct
+func (jsonDiscoveredDeviceReader) ReadAddDiscoveredDeviceRequest(reader io.Reader) ([]pkgRequests.AddDiscoveredDeviceRequest, errors.EdgeX) {
+	var requests []pkgRequests.AddDiscoveredDeviceRequest
+	err := json.NewDecoder(reader).Decode(&requests)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "discovered device json decoding failed", err)
+	}
+	return requests, nil
+}
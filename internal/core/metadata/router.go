@@ -14,6 +14,8 @@ import (
 	"github.com/gorilla/mux"
 
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 )
@@ -32,6 +34,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiDeviceProfileRoute, dc.UpdateDeviceProfile).Methods(http.MethodPut)
 	r.HandleFunc(common.ApiDeviceProfileUploadFileRoute, dc.AddDeviceProfileByYaml).Methods(http.MethodPost)
 	r.HandleFunc(common.ApiDeviceProfileUploadFileRoute, dc.UpdateDeviceProfileByYaml).Methods(http.MethodPut)
+	r.HandleFunc(pkgCommon.ApiDeviceProfileValidateUploadFileRoute, dc.ValidateDeviceProfileByYaml).Methods(http.MethodPost)
 	r.HandleFunc(common.ApiDeviceProfileByNameRoute, dc.DeviceProfileByName).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceProfileByNameRoute, dc.DeleteDeviceProfileByName).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiAllDeviceProfileRoute, dc.AllDeviceProfiles).Methods(http.MethodGet)
@@ -50,6 +53,8 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiDeviceServiceByNameRoute, ds.DeviceServiceByName).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceServiceByNameRoute, ds.DeleteDeviceServiceByName).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiAllDeviceServiceRoute, ds.AllDeviceServices).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAllDeviceServiceHealthRoute, ds.AllDeviceServiceHealth).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceHealthByNameRoute, ds.DeviceServiceHealthByName).Methods(http.MethodGet)
 
 	// Device
 	d := metadataController.NewDeviceController(dic)
@@ -61,6 +66,10 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiAllDeviceRoute, d.AllDevices).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceByNameRoute, d.DeviceByName).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceByProfileNameRoute, d.DevicesByProfileName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceHeartbeatRoute, d.DeviceHeartbeat).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceWithinRadiusRoute, d.DevicesWithinRadius).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceWithinBoundingBoxRoute, d.DevicesWithinBoundingBox).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceBySiteRoute, d.DevicesBySite).Methods(http.MethodGet)
 
 	// ProvisionWatcher
 	pwc := metadataController.NewProvisionWatcherController(dic)
@@ -72,6 +81,46 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiProvisionWatcherByNameRoute, pwc.DeleteProvisionWatcherByName).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiProvisionWatcherRoute, pwc.PatchProvisionWatcher).Methods(http.MethodPatch)
 
+	// Device Service Callback
+	dscb := metadataController.NewDeviceServiceCallbackController(dic)
+	r.HandleFunc(pkgCommon.ApiAllDeviceServiceCallbackRoute, dscb.AllDeviceServiceCallbacks).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceCallbackByIdRoute, dscb.DeviceServiceCallbackById).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceCallbackByIdRoute, dscb.DeleteDeviceServiceCallbackById).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceCallbackByStatusRoute, dscb.DeviceServiceCallbacksByStatus).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceCallbackByServiceNameRoute, dscb.DeviceServiceCallbacksByServiceName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceCallbackRetriggerByIdRoute, dscb.RetriggerDeviceServiceCallbackById).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceServiceCallbackRetriggerByServiceNameRoute, dscb.RetriggerDeviceServiceCallbacksByServiceName).Methods(http.MethodPost)
+
+	// Discovered Device
+	ddc := metadataController.NewDiscoveredDeviceController(dic)
+	r.HandleFunc(pkgCommon.ApiDiscoveredDeviceRoute, ddc.AddDiscoveredDevice).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllDiscoveredDeviceRoute, ddc.AllDiscoveredDevices).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDiscoveredDeviceByStatusRoute, ddc.DiscoveredDevicesByStatus).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDiscoveredDeviceByIdRoute, ddc.DiscoveredDeviceById).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDiscoveredDeviceByIdRoute, ddc.DeleteDiscoveredDeviceById).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiDiscoveredDeviceApproveByIdRoute, ddc.ApproveDiscoveredDeviceById).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDiscoveredDeviceRejectByIdRoute, ddc.RejectDiscoveredDeviceById).Methods(http.MethodPost)
+
+	// Device Template
+	dtc := metadataController.NewDeviceTemplateController(dic)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateRoute, dtc.AddDeviceTemplate).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllDeviceTemplateRoute, dtc.AllDeviceTemplates).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateByNameRoute, dtc.DeviceTemplateByName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateByNameRoute, dtc.DeleteDeviceTemplateByName).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateInstantiateByNameRoute, dtc.InstantiateDeviceTemplateByName).Methods(http.MethodPost)
+
+	// Search
+	sc := metadataController.NewSearchController(dic)
+	r.HandleFunc(pkgCommon.ApiSearchRoute, sc.Search).Methods(http.MethodGet)
+
+	// Audit
+	ac := commonController.NewAuditController(dic)
+	r.HandleFunc(pkgCommon.ApiAllAuditRoute, ac.AllAuditRecords).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByTimeRangeRoute, ac.AuditRecordsByTimeRange).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByEntityRoute, ac.AuditRecordsByEntity).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByActorRoute, ac.AuditRecordsByActor).Methods(http.MethodGet)
+
 	r.Use(correlation.ManageHeader)
+	r.Use(audit.ManageActor)
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 }
@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AllAuditRecords queries the audit records of the service with offset and limit, newest first
+func AllAuditRecords(offset int, limit int, dic *di.Container) (records []pkgDtos.AuditRecord, err errors.EdgeX) {
+	trail, err := auditTrail(dic)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	rs, err := trail.DBClient().AuditRecordsByService(trail.Service(), offset, limit)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromAuditRecordModelsToDTOs(rs), nil
+}
+
+// AuditRecordsByTimeRange queries the audit records of the service recorded within the time range with offset and
+// limit, newest first
+func AuditRecordsByTimeRange(start int, end int, offset int, limit int, dic *di.Container) (records []pkgDtos.AuditRecord, err errors.EdgeX) {
+	trail, err := auditTrail(dic)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	rs, err := trail.DBClient().AuditRecordsByTimeRange(trail.Service(), start, end, offset, limit)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromAuditRecordModelsToDTOs(rs), nil
+}
+
+// AuditRecordsByEntity queries the audit records of an entity with offset and limit, newest first
+func AuditRecordsByEntity(entityType string, name string, offset int, limit int, dic *di.Container) (records []pkgDtos.AuditRecord, err errors.EdgeX) {
+	if entityType == "" {
+		return records, errors.NewCommonEdgeX(errors.KindContractInvalid, "type is empty", nil)
+	}
+	if name == "" {
+		return records, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	trail, err := auditTrail(dic)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	rs, err := trail.DBClient().AuditRecordsByEntity(pkgModels.AuditEntityType(entityType), name, offset, limit)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromAuditRecordModelsToDTOs(rs), nil
+}
+
+// AuditRecordsByActor queries the audit records of the changes made by the actor with offset and limit, newest first
+func AuditRecordsByActor(actor string, offset int, limit int, dic *di.Container) (records []pkgDtos.AuditRecord, err errors.EdgeX) {
+	if actor == "" {
+		return records, errors.NewCommonEdgeX(errors.KindContractInvalid, "actor is empty", nil)
+	}
+	trail, err := auditTrail(dic)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	rs, err := trail.DBClient().AuditRecordsByActor(trail.Service(), actor, offset, limit)
+	if err != nil {
+		return records, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromAuditRecordModelsToDTOs(rs), nil
+}
+
+func auditTrail(dic *di.Container) (*audit.Trail, errors.EdgeX) {
+	trail := audit.TrailFrom(dic.Get)
+	if trail == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "audit trail is missing. Make sure it is created in the service bootstrap", nil)
+	}
+	return trail, nil
+}
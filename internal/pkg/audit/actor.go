@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Headers set by the API gateway once the consumer of the request is authenticated
+const (
+	ConsumerUsernameHeader     = "X-Consumer-Username"
+	CredentialIdentifierHeader = "X-Credential-Identifier"
+	AuthorizationHeader        = "Authorization"
+)
+
+// Anonymous is the actor of the requests which carry no identity, e.g. when the services run without the API gateway
+const Anonymous = "anonymous"
+
+type actorKey struct{}
+
+// ManageActor resolves the actor of the request and keeps it in the request context for the audit trail
+func ManageActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), actorKey{}, actorFromRequest(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// WithActor keeps the actor in the context, for the requests which don't come from the REST API, e.g. the ones
+// received from the message bus
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor kept by ManageActor, an empty string is returned if the context doesn't come
+// from a request, e.g. the changes made by the background tasks of the service
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := ctx.Value(actorKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return actor
+}
+
+// actorFromRequest resolves the actor from the consumer headers set by the API gateway, or the subject of the JWT
+// bearer token if the gateway doesn't set them. Neither the headers nor the token are verified here, so the actor only
+// labels the audit records and shall not authorize anything, see access.ManageCaller of core-command.
+func actorFromRequest(r *http.Request) string {
+	if username := r.Header.Get(ConsumerUsernameHeader); username != "" {
+		return username
+	}
+	if identifier := r.Header.Get(CredentialIdentifierHeader); identifier != "" {
+		return identifier
+	}
+	if subject := jwtSubject(r.Header.Get(AuthorizationHeader)); subject != "" {
+		return subject
+	}
+	return Anonymous
+}
+
+// jwtSubject returns the sub claim of the bearer token, or the iss claim if the token has no subject
+func jwtSubject(authorization string) string {
+	const bearer = "Bearer "
+	if len(authorization) <= len(bearer) || !strings.EqualFold(authorization[:len(bearer)], bearer) {
+		return ""
+	}
+	parts := strings.Split(strings.TrimSpace(authorization[len(bearer):]), ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Subject string `json:"sub"`
+		Issuer  string `json:"iss"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	if claims.Subject != "" {
+		return claims.Subject
+	}
+	return claims.Issuer
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testToken(payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	return "Bearer " + header + "." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestActorFromRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		expectedActor string
+	}{
+		{"consumer username", map[string]string{ConsumerUsernameHeader: "admin", CredentialIdentifierHeader: "key-1"}, "admin"},
+		{"credential identifier", map[string]string{CredentialIdentifierHeader: "key-1"}, "key-1"},
+		{"token subject", map[string]string{AuthorizationHeader: testToken(`{"sub":"operator","iss":"issuer"}`)}, "operator"},
+		{"token issuer", map[string]string{AuthorizationHeader: testToken(`{"iss":"issuer"}`)}, "issuer"},
+		{"malformed token", map[string]string{AuthorizationHeader: "Bearer abc"}, Anonymous},
+		{"basic authorization", map[string]string{AuthorizationHeader: "Basic YWRtaW46cGFzcw=="}, Anonymous},
+		{"no identity", nil, Anonymous},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+			require.NoError(t, err)
+			for k, v := range testCase.headers {
+				req.Header.Set(k, v)
+			}
+			assert.Equal(t, testCase.expectedActor, actorFromRequest(req))
+		})
+	}
+}
+
+func TestManageActor(t *testing.T) {
+	var actor string
+	handler := ManageActor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = ActorFromContext(r.Context())
+	}))
+	req, err := http.NewRequest(http.MethodPost, "/", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set(ConsumerUsernameHeader, "admin")
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "admin", actor)
+	assert.Empty(t, ActorFromContext(context.Background()), "no actor is expected outside of a request")
+}
@@ -0,0 +1,225 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// TrailInfo provides the settings of the audit trail of a core service
+type TrailInfo struct {
+	// Enabled records the additions, updates and deletions of the entities managed by the service
+	Enabled bool
+	// MaxAge is how long the audit records are kept, e.g. "720h", the records are kept forever if it is empty
+	MaxAge string
+	// PurgeInterval is how often the audit records older than MaxAge are deleted
+	PurgeInterval string
+}
+
+// DBClient is implemented by the database client of each core service which keeps an audit trail
+type DBClient interface {
+	AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX)
+}
+
+// ignoredFields are not compared since they change with every update
+var ignoredFields = map[string]bool{"created": true, "modified": true}
+
+// Trail records the administrative changes made to the entities of a core service
+type Trail struct {
+	service       string
+	info          TrailInfo
+	maxAge        time.Duration
+	purgeInterval time.Duration
+	dbClient      DBClient
+	lc            logger.LoggingClient
+}
+
+// TrailName contains the name of the Trail implementation in the DIC.
+var TrailName = di.TypeInstanceToName(Trail{})
+
+// TrailFrom helper function queries the DIC and returns the Trail, nil is returned if the service keeps no audit trail.
+func TrailFrom(get di.Get) *Trail {
+	trail, ok := get(TrailName).(*Trail)
+	if !ok {
+		return nil
+	}
+	return trail
+}
+
+// NewTrail creates the audit trail of the service, the records are written with the given database client
+func NewTrail(service string, info TrailInfo, dbClient DBClient, lc logger.LoggingClient) (*Trail, errors.EdgeX) {
+	t := &Trail{service: service, info: info, dbClient: dbClient, lc: lc}
+	if info.MaxAge == "" {
+		return t, nil
+	}
+	var err error
+	t.maxAge, err = time.ParseDuration(info.MaxAge)
+	if err != nil || t.maxAge <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid audit MaxAge '%s'", info.MaxAge), err)
+	}
+	t.purgeInterval, err = time.ParseDuration(info.PurgeInterval)
+	if err != nil || t.purgeInterval <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid audit PurgeInterval '%s'", info.PurgeInterval), err)
+	}
+	return t, nil
+}
+
+// StartTrail creates the audit trail of the service, registers it in the DIC and starts purging the expired records,
+// false is returned if the settings are invalid
+func StartTrail(ctx context.Context, wg *sync.WaitGroup, dic *di.Container, service string, info TrailInfo, dbClient DBClient) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	trail, err := NewTrail(service, info, dbClient, lc)
+	if err != nil {
+		lc.Errorf("fail to create the audit trail, err: %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		TrailName: func(get di.Get) interface{} {
+			return trail
+		},
+	})
+	trail.Start(ctx, wg)
+	return true
+}
+
+// Service returns the name of the service whose changes are recorded
+func (t *Trail) Service() string {
+	return t.service
+}
+
+// DBClient returns the database client which keeps the audit records
+func (t *Trail) DBClient() DBClient {
+	return t.dbClient
+}
+
+// Record records the change made to the entity, before is nil when the entity is added and after is nil when it's
+// deleted. The entities shall be given as DTOs, so the changed fields are named as in the APIs. A failure to record is
+// only logged since the change has been made already.
+func (t *Trail) Record(ctx context.Context, action pkgModels.AuditAction, entityType pkgModels.AuditEntityType,
+	name string, before interface{}, after interface{}) {
+	if t == nil || !t.info.Enabled {
+		return
+	}
+	correlationId := correlation.FromContext(ctx)
+	changes, err := diff(before, after)
+	if err != nil {
+		t.lc.Errorf("fail to compare the changes of %s %s for the audit trail, correlation id: %s, err: %v", entityType, name, correlationId, err)
+		return
+	}
+	if action == pkgModels.AuditUpdate && len(changes) == 0 {
+		return
+	}
+	actor := ActorFromContext(ctx)
+	if actor == "" {
+		actor = t.service
+	}
+	_, edgeXerr := t.dbClient.AddAuditRecord(pkgModels.AuditRecord{
+		Service:       t.service,
+		Actor:         actor,
+		CorrelationId: correlationId,
+		Action:        action,
+		EntityType:    entityType,
+		EntityName:    name,
+		Changes:       changes,
+	})
+	if edgeXerr != nil {
+		t.lc.Errorf("fail to record the %s of %s %s in the audit trail, correlation id: %s, err: %v", action, entityType, name, correlationId, edgeXerr)
+	}
+}
+
+// Record records the change with the audit trail registered in the DIC, nothing is recorded if there is none
+func Record(ctx context.Context, dic *di.Container, action pkgModels.AuditAction, entityType pkgModels.AuditEntityType,
+	name string, before interface{}, after interface{}) {
+	TrailFrom(dic.Get).Record(ctx, action, entityType, name, before, after)
+}
+
+// Start deletes the audit records older than MaxAge every PurgeInterval until the context is done, nothing is deleted
+// if MaxAge is empty
+func (t *Trail) Start(ctx context.Context, wg *sync.WaitGroup) {
+	if t.maxAge == 0 {
+		return
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(t.purgeInterval)
+		defer ticker.Stop()
+		for {
+			count, err := t.dbClient.DeleteAuditRecordsByAge(t.service, t.maxAge.Milliseconds())
+			if err != nil {
+				t.lc.Errorf("fail to purge the expired audit records, err: %v", err)
+			} else if count > 0 {
+				t.lc.Debugf("%d expired audit records are purged", count)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// diff compares the top-level fields of the entities and returns the previous and new value of each changed field
+func diff(before interface{}, after interface{}) (map[string]pkgModels.AuditChange, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[string]pkgModels.AuditChange)
+	for field, value := range beforeFields {
+		if ignoredFields[field] {
+			continue
+		}
+		if afterValue, ok := afterFields[field]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changes[field] = pkgModels.AuditChange{Before: value, After: afterValue}
+		}
+	}
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; ok || ignoredFields[field] {
+			continue
+		}
+		changes[field] = pkgModels.AuditChange{After: value}
+	}
+	return changes, nil
+}
+
+// fields converts the entity to its JSON fields
+func fields(entity interface{}) (map[string]interface{}, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
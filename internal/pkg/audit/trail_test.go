@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingDBClient keeps the added audit records in memory
+type recordingDBClient struct {
+	records []pkgModels.AuditRecord
+}
+
+func (c *recordingDBClient) AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX) {
+	c.records = append(c.records, r)
+	return r, nil
+}
+
+func (c *recordingDBClient) AuditRecordsByService(string, int, int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	return c.records, nil
+}
+
+func (c *recordingDBClient) AuditRecordsByTimeRange(string, int, int, int, int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	return c.records, nil
+}
+
+func (c *recordingDBClient) AuditRecordsByEntity(pkgModels.AuditEntityType, string, int, int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	return c.records, nil
+}
+
+func (c *recordingDBClient) AuditRecordsByActor(string, string, int, int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	return c.records, nil
+}
+
+func (c *recordingDBClient) DeleteAuditRecordsByAge(string, int64) (int, errors.EdgeX) {
+	return 0, nil
+}
+
+func TestNewTrail(t *testing.T) {
+	tests := []struct {
+		name          string
+		info          TrailInfo
+		errorExpected bool
+	}{
+		{"valid", TrailInfo{Enabled: true, MaxAge: "720h", PurgeInterval: "1h"}, false},
+		{"valid - records kept forever", TrailInfo{Enabled: true}, false},
+		{"invalid - MaxAge", TrailInfo{Enabled: true, MaxAge: "month", PurgeInterval: "1h"}, true},
+		{"invalid - negative MaxAge", TrailInfo{Enabled: true, MaxAge: "-1h", PurgeInterval: "1h"}, true},
+		{"invalid - PurgeInterval missing", TrailInfo{Enabled: true, MaxAge: "720h"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewTrail("core-metadata", testCase.info, &recordingDBClient{}, logger.NewMockClient())
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	before := dtos.DeviceService{Name: "ds", BaseAddress: "http://localhost:59900", AdminState: "UNLOCKED"}
+	before.Created = 1
+	after := before
+	after.AdminState = "LOCKED"
+	after.Labels = []string{"label"}
+	after.Modified = 2
+
+	dbClient := &recordingDBClient{}
+	trail, err := NewTrail("core-metadata", TrailInfo{Enabled: true}, dbClient, logger.NewMockClient())
+	require.NoError(t, err)
+
+	trail.Record(context.Background(), pkgModels.AuditUpdate, pkgModels.AuditDeviceService, "ds", before, after)
+	require.Len(t, dbClient.records, 1)
+	r := dbClient.records[0]
+	assert.Equal(t, "core-metadata", r.Service)
+	assert.Equal(t, "core-metadata", r.Actor, "the service is expected to be the actor of the changes made outside of a request")
+	assert.Equal(t, pkgModels.AuditUpdate, r.Action)
+	assert.Equal(t, pkgModels.AuditDeviceService, r.EntityType)
+	assert.Equal(t, "ds", r.EntityName)
+	assert.Equal(t, map[string]pkgModels.AuditChange{
+		"adminState": {Before: "UNLOCKED", After: "LOCKED"},
+		"labels":     {After: []interface{}{"label"}},
+	}, r.Changes)
+
+	// an update which only touches the timestamps is not recorded
+	trail.Record(context.Background(), pkgModels.AuditUpdate, pkgModels.AuditDeviceService, "ds", after, after)
+	assert.Len(t, dbClient.records, 1)
+
+	trail.Record(context.Background(), pkgModels.AuditDelete, pkgModels.AuditDeviceService, "ds", after, nil)
+	require.Len(t, dbClient.records, 2)
+	assert.Equal(t, "LOCKED", dbClient.records[1].Changes["adminState"].Before)
+	assert.Nil(t, dbClient.records[1].Changes["adminState"].After)
+}
+
+func TestRecordDisabled(t *testing.T) {
+	dbClient := &recordingDBClient{}
+	trail, err := NewTrail("core-metadata", TrailInfo{Enabled: false}, dbClient, logger.NewMockClient())
+	require.NoError(t, err)
+
+	trail.Record(context.Background(), pkgModels.AuditAdd, pkgModels.AuditDeviceService, "ds", nil, dtos.DeviceService{Name: "ds"})
+	assert.Empty(t, dbClient.records)
+
+	var noTrail *Trail
+	noTrail.Record(context.Background(), pkgModels.AuditAdd, pkgModels.AuditDeviceService, "ds", nil, dtos.DeviceService{Name: "ds"})
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/clients/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+type deviceHeartbeatClient struct {
+	baseUrl string
+}
+
+// NewDeviceHeartbeatClient creates an instance of DeviceHeartbeatClient
+func NewDeviceHeartbeatClient(baseUrl string) interfaces.DeviceHeartbeatClient {
+	return &deviceHeartbeatClient{
+		baseUrl: baseUrl,
+	}
+}
+
+func (client *deviceHeartbeatClient) Heartbeat(ctx context.Context, reqs []requests.DeviceHeartbeatRequest) ([]common.BaseResponse, errors.EdgeX) {
+	var responses []common.BaseResponse
+	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl+pkgCommon.ApiDeviceHeartbeatRoute, reqs)
+	if err != nil {
+		return responses, errors.NewCommonEdgeXWrapper(err)
+	}
+	return responses, nil
+}
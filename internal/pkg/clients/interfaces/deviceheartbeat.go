@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceHeartbeatClient defines the interface for reporting the device heartbeats to the EdgeX Foundry core-metadata service.
+type DeviceHeartbeatClient interface {
+	// Heartbeat reports the devices were seen alive at the specified time.
+	Heartbeat(ctx context.Context, reqs []requests.DeviceHeartbeatRequest) ([]common.BaseResponse, errors.EdgeX)
+}
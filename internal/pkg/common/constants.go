@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Routes of the APIs which are served by edgex-go only and not yet defined in go-mod-core-contracts
+const (
+	ApiDeviceServiceCallbackRoute                       = common.ApiBase + "/deviceservicecallback"
+	ApiAllDeviceServiceCallbackRoute                    = ApiDeviceServiceCallbackRoute + "/" + common.All
+	ApiDeviceServiceCallbackByIdRoute                   = ApiDeviceServiceCallbackRoute + "/" + common.Id + "/{" + common.Id + "}"
+	ApiDeviceServiceCallbackByStatusRoute               = ApiDeviceServiceCallbackRoute + "/" + common.Status + "/{" + common.Status + "}"
+	ApiDeviceServiceCallbackByServiceNameRoute          = ApiDeviceServiceCallbackRoute + "/" + common.Service + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceServiceCallbackRetriggerByIdRoute          = ApiDeviceServiceCallbackByIdRoute + "/" + Retrigger
+	ApiDeviceServiceCallbackRetriggerByServiceNameRoute = ApiDeviceServiceCallbackByServiceNameRoute + "/" + Retrigger
+
+	ApiDeviceHeartbeatRoute = common.ApiDeviceRoute + "/" + Heartbeat
+
+	ApiDeviceLocationRoute          = common.ApiDeviceRoute + "/" + Location
+	ApiDeviceWithinRadiusRoute      = ApiDeviceLocationRoute + "/" + Latitude + "/{" + Latitude + "}/" + Longitude + "/{" + Longitude + "}/" + Radius + "/{" + Radius + "}"
+	ApiDeviceWithinBoundingBoxRoute = ApiDeviceLocationRoute + "/" + South + "/{" + South + "}/" + West + "/{" + West + "}/" + North + "/{" + North + "}/" + East + "/{" + East + "}"
+	ApiDeviceBySiteRoute            = ApiDeviceLocationRoute + "/" + Site + "/{" + Site + "}"
+
+	ApiDeviceServiceHealthRoute       = common.ApiDeviceServiceRoute + "/" + Health
+	ApiAllDeviceServiceHealthRoute    = ApiDeviceServiceHealthRoute + "/" + common.All
+	ApiDeviceServiceHealthByNameRoute = ApiDeviceServiceHealthRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiDeviceProfileValidateUploadFileRoute = common.ApiDeviceProfileUploadFileRoute + "/" + Validate
+
+	ApiDiscoveredDeviceRoute            = common.ApiBase + "/discovereddevice"
+	ApiAllDiscoveredDeviceRoute         = ApiDiscoveredDeviceRoute + "/" + common.All
+	ApiDiscoveredDeviceByIdRoute        = ApiDiscoveredDeviceRoute + "/" + common.Id + "/{" + common.Id + "}"
+	ApiDiscoveredDeviceByStatusRoute    = ApiDiscoveredDeviceRoute + "/" + common.Status + "/{" + common.Status + "}"
+	ApiDiscoveredDeviceApproveByIdRoute = ApiDiscoveredDeviceByIdRoute + "/" + Approve
+	ApiDiscoveredDeviceRejectByIdRoute  = ApiDiscoveredDeviceByIdRoute + "/" + Reject
+
+	ApiDeviceTemplateRoute                  = common.ApiBase + "/devicetemplate"
+	ApiAllDeviceTemplateRoute               = ApiDeviceTemplateRoute + "/" + common.All
+	ApiDeviceTemplateByNameRoute            = ApiDeviceTemplateRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceTemplateInstantiateByNameRoute = ApiDeviceTemplateByNameRoute + "/" + Instantiate
+
+	ApiSearchRoute = common.ApiBase + "/search"
+
+	ApiAuditRoute            = common.ApiBase + "/audit"
+	ApiAllAuditRoute         = ApiAuditRoute + "/" + common.All
+	ApiAuditByTimeRangeRoute = ApiAuditRoute + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiAuditByEntityRoute    = ApiAuditRoute + "/" + Type + "/{" + Type + "}/" + common.Name + "/{" + common.Name + "}"
+	ApiAuditByActorRoute     = ApiAuditRoute + "/" + Actor + "/{" + Actor + "}"
+
+	ApiDeviceBatchCommandRoute = common.ApiDeviceRoute + "/" + Batch
+
+	ApiMetadataCacheRoute           = common.ApiBase + "/metadatacache"
+	ApiMetadataCacheInvalidateRoute = ApiMetadataCacheRoute + "/" + Invalidate
+	ApiMetadataCacheStatsRoute      = ApiMetadataCacheRoute + "/" + Stats
+
+	ApiCommandJobRoute           = common.ApiBase + "/commandjob"
+	ApiAllCommandJobRoute        = ApiCommandJobRoute + "/" + common.All
+	ApiCommandJobByIdRoute       = ApiCommandJobRoute + "/" + common.Id + "/{" + common.Id + "}"
+	ApiCommandJobByStatusRoute   = ApiCommandJobRoute + "/" + common.Status + "/{" + common.Status + "}"
+	ApiCommandJobCancelByIdRoute = ApiCommandJobByIdRoute + "/" + Cancel
+
+	ApiCommandRecordRoute             = common.ApiBase + "/commandrecord"
+	ApiCommandRecordByIdRoute         = ApiCommandRecordRoute + "/" + common.Id + "/{" + common.Id + "}"
+	ApiCommandRecordByDeviceNameRoute = ApiCommandRecordRoute + "/" + common.Device + "/" + common.Name + "/{" + common.Name + "}"
+	ApiCommandRecordReplayByIdRoute   = ApiCommandRecordByIdRoute + "/" + Replay
+
+	ApiRecipeRoute          = common.ApiBase + "/recipe"
+	ApiAllRecipeRoute       = ApiRecipeRoute + "/" + common.All
+	ApiRecipeByNameRoute    = ApiRecipeRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiRecipeRunByNameRoute = ApiRecipeByNameRoute + "/" + Run
+
+	ApiRecipeRunRoute             = common.ApiBase + "/reciperun"
+	ApiRecipeRunByIdRoute         = ApiRecipeRunRoute + "/" + common.Id + "/{" + common.Id + "}"
+	ApiRecipeRunByRecipeNameRoute = ApiRecipeRunRoute + "/" + Recipe + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiCatalogueRoute             = common.ApiBase + "/catalogue"
+	ApiCatalogueByDeviceNameRoute = ApiCatalogueRoute + "/" + common.Device + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiSubscriptionTemplateRoute                   = common.ApiBase + "/subscriptiontemplate"
+	ApiAllSubscriptionTemplateRoute                = ApiSubscriptionTemplateRoute + "/" + common.All
+	ApiSubscriptionTemplateBySubscriptionNameRoute = ApiSubscriptionTemplateRoute + "/" + common.Subscription + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiSubscriptionDigestRoute                   = common.ApiBase + "/subscriptiondigest"
+	ApiAllSubscriptionDigestRoute                = ApiSubscriptionDigestRoute + "/" + common.All
+	ApiSubscriptionDigestBySubscriptionNameRoute = ApiSubscriptionDigestRoute + "/" + common.Subscription + "/" + common.Name + "/{" + common.Name + "}"
+)
+
+// Constants related to the URL path segments and query parameters of the edgex-go specific APIs
+const (
+	Retrigger   = "retrigger"
+	Heartbeat   = "heartbeat"
+	Health      = "health"
+	Cascade     = "cascade"
+	DryRun      = "dryRun"
+	Validate    = "validate"
+	Approve     = "approve"
+	Reject      = "reject"
+	Instantiate = "instantiate"
+	Query       = "q"
+	Type        = "type"
+	Actor       = "actor"
+	Location    = "location"
+	Latitude    = "latitude"
+	Longitude   = "longitude"
+	Radius      = "radius"
+	South       = "south"
+	West        = "west"
+	North       = "north"
+	East        = "east"
+	Site        = "site"
+	Batch       = "batch"
+	Invalidate  = "invalidate"
+	Stats       = "stats"
+	Cancel      = "cancel"
+	Async       = "async"
+	CallbackUrl = "callbackUrl"
+	Replay      = "replay"
+	Recipe      = "recipe"
+	Run         = "run"
+)
+
+// Constants related to the optimistic concurrency control of the metadata entities
+const (
+	IfMatch = "If-Match"
+	ETag    = "ETag"
+
+	// AnyRevision is the expected revision of an update or deletion which shall be applied regardless of the
+	// current revision of the entity
+	AnyRevision int64 = -1
+	// KindRevisionMismatch is the error kind reported when the entity is not at the expected revision
+	KindRevisionMismatch errors.ErrKind = "RevisionMismatch"
+)
+
+// KindForbidden is the error kind reported when the caller is not allowed to perform the operation
+const KindForbidden errors.ErrKind = "Forbidden"
+
+// KindUnauthorized is the error kind reported when the identity carried by the request can't be verified
+const KindUnauthorized errors.ErrKind = "Unauthorized"
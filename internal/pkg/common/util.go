@@ -6,7 +6,9 @@
 package common
 
 import (
+	"strings"
 	"time"
+	"unicode"
 )
 
 func MakeTimestamp() int64 {
@@ -47,3 +49,24 @@ func ConvertStringsToInterfaces(stringArray []string) []interface{} {
 	}
 	return result
 }
+
+// SearchTerms splits the texts into the distinct lower-case terms indexed and matched by the core-metadata search, a
+// term is a run of letters and digits
+// e.g.
+// SearchTerms("Thermostat-1", "2nd floor, room 12") shall return a string slice with {"thermostat", "1", "2nd", "floor", "room", "12"}
+func SearchTerms(texts ...string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, f := range fields {
+			if !seen[f] {
+				seen[f] = true
+				terms = append(terms, f)
+			}
+		}
+	}
+	return terms
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/application"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/gorilla/mux"
+)
+
+// AuditController controller for the V2 REST APIs querying the audit trail of a core service
+type AuditController struct {
+	dic *di.Container
+}
+
+// NewAuditController creates and initializes an AuditController
+func NewAuditController(dic *di.Container) *AuditController {
+	return &AuditController{
+		dic: dic,
+	}
+}
+
+// maxResultCount reads the maximum result count from the bootstrap configuration shared by all the services
+func (ac *AuditController) maxResultCount() int {
+	return container.ConfigurationFrom(ac.dic.Get).GetBootstrap().Service.MaxResultCount
+}
+
+func (ac *AuditController) AllAuditRecords(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	ctx := r.Context()
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, ac.maxResultCount())
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	records, err := application.AllAuditRecords(offset, limit, ac.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiAuditRecordsResponse("", "", http.StatusOK, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (ac *AuditController) AuditRecordsByTimeRange(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	ctx := r.Context()
+
+	// parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseTimeRangeOffsetLimit(r, 0, math.MaxInt32, -1, ac.maxResultCount())
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	records, err := application.AuditRecordsByTimeRange(start, end, offset, limit, ac.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiAuditRecordsResponse("", "", http.StatusOK, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (ac *AuditController) AuditRecordsByEntity(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	entityType := vars[pkgCommon.Type]
+	name := vars[common.Name]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, ac.maxResultCount())
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	records, err := application.AuditRecordsByEntity(entityType, name, offset, limit, ac.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiAuditRecordsResponse("", "", http.StatusOK, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (ac *AuditController) AuditRecordsByActor(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	actor := vars[pkgCommon.Actor]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, ac.maxResultCount())
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	records, err := application.AuditRecordsByActor(actor, offset, limit, ac.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiAuditRecordsResponse("", "", http.StatusOK, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AuditRecord represents an administrative change made to an entity of a core service, the actor comes from the
+// identity forwarded by the API gateway
+type AuditRecord struct {
+	Id            string                 `json:"id"`
+	Created       int64                  `json:"created"`
+	Service       string                 `json:"service"`
+	Actor         string                 `json:"actor"`
+	CorrelationId string                 `json:"correlationId,omitempty"`
+	Action        string                 `json:"action"`
+	EntityType    string                 `json:"entityType"`
+	EntityName    string                 `json:"entityName"`
+	Changes       map[string]AuditChange `json:"changes,omitempty"`
+}
+
+// AuditChange holds the previous and new value of a changed field
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// FromAuditRecordModelToDTO transforms the AuditRecord Model to the AuditRecord DTO
+func FromAuditRecordModelToDTO(r models.AuditRecord) AuditRecord {
+	var changes map[string]AuditChange
+	if len(r.Changes) > 0 {
+		changes = make(map[string]AuditChange, len(r.Changes))
+		for field, c := range r.Changes {
+			changes[field] = AuditChange{Before: c.Before, After: c.After}
+		}
+	}
+	return AuditRecord{
+		Id:            r.Id,
+		Created:       r.Created,
+		Service:       r.Service,
+		Actor:         r.Actor,
+		CorrelationId: r.CorrelationId,
+		Action:        string(r.Action),
+		EntityType:    string(r.EntityType),
+		EntityName:    r.EntityName,
+		Changes:       changes,
+	}
+}
+
+// FromAuditRecordModelsToDTOs transforms the AuditRecord Models to the AuditRecord DTOs
+func FromAuditRecordModelsToDTOs(records []models.AuditRecord) []AuditRecord {
+	dtos := make([]AuditRecord, len(records))
+	for i, r := range records {
+		dtos[i] = FromAuditRecordModelToDTO(r)
+	}
+	return dtos
+}
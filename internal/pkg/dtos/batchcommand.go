@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+)
+
+// BatchCommandResult is the outcome of a command issued to one of the devices of a batch, StatusCode and Message are
+// those of the device service response or of the error which prevented the command from being issued.
+type BatchCommandResult struct {
+	DeviceName string      `json:"deviceName"`
+	StatusCode int         `json:"statusCode"`
+	Message    string      `json:"message,omitempty"`
+	Event      *dtos.Event `json:"event,omitempty"`
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// CommandJob represents a command which core-command issues to the device in the background
+type CommandJob struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string            `json:"id"`
+	DeviceName       string            `json:"deviceName"`
+	CommandName      string            `json:"commandName"`
+	Method           string            `json:"method"`
+	QueryParams      string            `json:"queryParams,omitempty"`
+	Settings         map[string]string `json:"settings,omitempty"`
+	CallbackUrl      string            `json:"callbackUrl,omitempty"`
+	Status           string            `json:"status"`
+	StatusCode       int               `json:"statusCode,omitempty"`
+	Message          string            `json:"message,omitempty"`
+	// Event is the event read by a GET command, as it was returned by the device service
+	Event         json.RawMessage `json:"event,omitempty"`
+	Started       int64           `json:"started,omitempty"`
+	Completed     int64           `json:"completed,omitempty"`
+	CorrelationId string          `json:"correlationId,omitempty"`
+}
+
+// FromCommandJobModelToDTO transforms the CommandJob Model to the CommandJob DTO
+func FromCommandJobModelToDTO(job models.CommandJob) CommandJob {
+	return CommandJob{
+		DBTimestamp:   dtos.DBTimestamp(job.DBTimestamp),
+		Id:            job.Id,
+		DeviceName:    job.DeviceName,
+		CommandName:   job.CommandName,
+		Method:        job.Method,
+		QueryParams:   job.QueryParams,
+		Settings:      job.Settings,
+		CallbackUrl:   job.CallbackUrl,
+		Status:        string(job.Status),
+		StatusCode:    job.StatusCode,
+		Message:       job.Message,
+		Event:         job.Event,
+		Started:       job.Started,
+		Completed:     job.Completed,
+		CorrelationId: job.CorrelationId,
+	}
+}
+
+// FromCommandJobModelsToDTOs transforms the CommandJob model array to the CommandJob DTO array
+func FromCommandJobModelsToDTOs(jobs []models.CommandJob) []CommandJob {
+	dtos := make([]CommandJob, len(jobs))
+	for i, job := range jobs {
+		dtos[i] = FromCommandJobModelToDTO(job)
+	}
+	return dtos
+}
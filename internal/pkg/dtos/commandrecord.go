@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// CommandRecord represents the history of a command which core-command issued to a device
+type CommandRecord struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string            `json:"id"`
+	DeviceName       string            `json:"deviceName"`
+	CommandName      string            `json:"commandName"`
+	Method           string            `json:"method"`
+	QueryParams      string            `json:"queryParams,omitempty"`
+	Settings         map[string]string `json:"settings,omitempty"`
+	Caller           string            `json:"caller"`
+	CorrelationId    string            `json:"correlationId,omitempty"`
+	StatusCode       int               `json:"statusCode"`
+	Message          string            `json:"message,omitempty"`
+	Latency          int64             `json:"latency"`
+	ReplayOf         string            `json:"replayOf,omitempty"`
+}
+
+// FromCommandRecordModelToDTO transforms the CommandRecord Model to the CommandRecord DTO
+func FromCommandRecordModelToDTO(record models.CommandRecord) CommandRecord {
+	return CommandRecord{
+		DBTimestamp:   dtos.DBTimestamp(record.DBTimestamp),
+		Id:            record.Id,
+		DeviceName:    record.DeviceName,
+		CommandName:   record.CommandName,
+		Method:        record.Method,
+		QueryParams:   record.QueryParams,
+		Settings:      record.Settings,
+		Caller:        record.Caller,
+		CorrelationId: record.CorrelationId,
+		StatusCode:    record.StatusCode,
+		Message:       record.Message,
+		Latency:       record.Latency,
+		ReplayOf:      record.ReplayOf,
+	}
+}
+
+// FromCommandRecordModelsToDTOs transforms the CommandRecord model array to the CommandRecord DTO array
+func FromCommandRecordModelsToDTOs(records []models.CommandRecord) []CommandRecord {
+	dtos := make([]CommandRecord, len(records))
+	for i, record := range records {
+		dtos[i] = FromCommandRecordModelToDTO(record)
+	}
+	return dtos
+}
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// DeviceProfileProblem represents a problem found by linting a device profile
+type DeviceProfileProblem struct {
+	// Line is the line number of the problem in the device profile YAML, zero if unknown
+	Line     int    `json:"line,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// Constants for the DeviceProfileProblem severity, only the errors make the device profile rejected
+const (
+	ProblemSeverityError   = "ERROR"
+	ProblemSeverityWarning = "WARNING"
+)
@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DeviceServiceCallback represents an entry of the core-metadata callback outbox
+type DeviceServiceCallback struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string `json:"id"`
+	ServiceName      string `json:"serviceName"`
+	Action           string `json:"action"`
+	EntityName       string `json:"entityName"`
+	Status           string `json:"status"`
+	RetryCount       int    `json:"retryCount"`
+	NextRetry        int64  `json:"nextRetry,omitempty"`
+	LastError        string `json:"lastError,omitempty"`
+	CorrelationId    string `json:"correlationId,omitempty"`
+	BaseAddress      string `json:"baseAddress,omitempty"`
+}
+
+// FromDeviceServiceCallbackModelToDTO transforms the DeviceServiceCallback Model to the DeviceServiceCallback DTO
+func FromDeviceServiceCallbackModelToDTO(cb models.DeviceServiceCallback) DeviceServiceCallback {
+	return DeviceServiceCallback{
+		DBTimestamp:   dtos.DBTimestamp(cb.DBTimestamp),
+		Id:            cb.Id,
+		ServiceName:   cb.ServiceName,
+		Action:        string(cb.Action),
+		EntityName:    cb.EntityName,
+		Status:        string(cb.Status),
+		RetryCount:    cb.RetryCount,
+		NextRetry:     cb.NextRetry,
+		LastError:     cb.LastError,
+		CorrelationId: cb.CorrelationId,
+		BaseAddress:   cb.BaseAddress,
+	}
+}
+
+// FromDeviceServiceCallbackModelsToDTOs transforms the DeviceServiceCallback model array to the DeviceServiceCallback DTO array
+func FromDeviceServiceCallbackModelsToDTOs(cbs []models.DeviceServiceCallback) []DeviceServiceCallback {
+	dtos := make([]DeviceServiceCallback, len(cbs))
+	for i, cb := range cbs {
+		dtos[i] = FromDeviceServiceCallbackModelToDTO(cb)
+	}
+	return dtos
+}
@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DeviceServiceHealth represents the result of pinging a device service by the core-metadata liveness monitor
+type DeviceServiceHealth struct {
+	dtos.DBTimestamp    `json:",inline"`
+	ServiceName         string   `json:"serviceName"`
+	Status              string   `json:"status"`
+	LastChecked         int64    `json:"lastChecked"`
+	ConsecutiveFailures int      `json:"consecutiveFailures"`
+	LastError           string   `json:"lastError,omitempty"`
+	DownDevices         []string `json:"downDevices,omitempty"`
+}
+
+// FromDeviceServiceHealthModelToDTO transforms the DeviceServiceHealth Model to the DeviceServiceHealth DTO
+func FromDeviceServiceHealthModelToDTO(h models.DeviceServiceHealth) DeviceServiceHealth {
+	return DeviceServiceHealth{
+		DBTimestamp:         dtos.DBTimestamp(h.DBTimestamp),
+		ServiceName:         h.ServiceName,
+		Status:              string(h.Status),
+		LastChecked:         h.LastChecked,
+		ConsecutiveFailures: h.ConsecutiveFailures,
+		LastError:           h.LastError,
+		DownDevices:         h.DownDevices,
+	}
+}
+
+// FromDeviceServiceHealthModelsToDTOs transforms the DeviceServiceHealth model array to the DeviceServiceHealth DTO array
+func FromDeviceServiceHealthModelsToDTOs(hs []models.DeviceServiceHealth) []DeviceServiceHealth {
+	dtos := make([]DeviceServiceHealth, len(hs))
+	for i, h := range hs {
+		dtos[i] = FromDeviceServiceHealthModelToDTO(h)
+	}
+	return dtos
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	contractsModels "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DeviceTemplate defines the common properties of the devices instantiated from it, the protocol property values may
+// contain placeholders such as {{address}} which are replaced with the per-device variables.
+type DeviceTemplate struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string                             `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string                             `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string                             `json:"description,omitempty"`
+	ServiceName      string                             `json:"serviceName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	ProfileName      string                             `json:"profileName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Labels           []string                           `json:"labels,omitempty"`
+	AdminState       string                             `json:"adminState" validate:"oneof='LOCKED' 'UNLOCKED'"`
+	Protocols        map[string]dtos.ProtocolProperties `json:"protocols" validate:"required,gt=0"`
+	AutoEvents       []dtos.AutoEvent                   `json:"autoEvents,omitempty" validate:"dive"`
+}
+
+// ToDeviceTemplateModel transforms the DeviceTemplate DTO to the DeviceTemplate model
+func ToDeviceTemplateModel(dto DeviceTemplate) models.DeviceTemplate {
+	return models.DeviceTemplate{
+		Id:          dto.Id,
+		Name:        dto.Name,
+		Description: dto.Description,
+		ServiceName: dto.ServiceName,
+		ProfileName: dto.ProfileName,
+		Labels:      dto.Labels,
+		AdminState:  contractsModels.AdminState(dto.AdminState),
+		Protocols:   dtos.ToProtocolModels(dto.Protocols),
+		AutoEvents:  dtos.ToAutoEventModels(dto.AutoEvents),
+	}
+}
+
+// FromDeviceTemplateModelToDTO transforms the DeviceTemplate model to the DeviceTemplate DTO
+func FromDeviceTemplateModelToDTO(t models.DeviceTemplate) DeviceTemplate {
+	return DeviceTemplate{
+		DBTimestamp: dtos.DBTimestamp(t.DBTimestamp),
+		Id:          t.Id,
+		Name:        t.Name,
+		Description: t.Description,
+		ServiceName: t.ServiceName,
+		ProfileName: t.ProfileName,
+		Labels:      t.Labels,
+		AdminState:  string(t.AdminState),
+		Protocols:   dtos.FromProtocolModelsToDTOs(t.Protocols),
+		AutoEvents:  dtos.FromAutoEventModelsToDTOs(t.AutoEvents),
+	}
+}
+
+// FromDeviceTemplateModelsToDTOs transforms the DeviceTemplate model array to the DeviceTemplate DTO array
+func FromDeviceTemplateModelsToDTOs(ts []models.DeviceTemplate) []DeviceTemplate {
+	dtos := make([]DeviceTemplate, len(ts))
+	for i, t := range ts {
+		dtos[i] = FromDeviceTemplateModelToDTO(t)
+	}
+	return dtos
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DiscoveredDevice represents a device found by a device service. The matched provision watcher, the device profile
+// and the status are set by core-metadata and ignored when the discovered device is submitted.
+type DiscoveredDevice struct {
+	dtos.DBTimestamp     `json:",inline"`
+	Id                   string                             `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name                 string                             `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description          string                             `json:"description,omitempty"`
+	Labels               []string                           `json:"labels,omitempty"`
+	ServiceName          string                             `json:"serviceName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Protocols            map[string]dtos.ProtocolProperties `json:"protocols" validate:"required,gt=0"`
+	ProvisionWatcherName string                             `json:"provisionWatcherName,omitempty"`
+	ProfileName          string                             `json:"profileName,omitempty"`
+	Status               string                             `json:"status,omitempty"`
+}
+
+// ToDiscoveredDeviceModel transforms the DiscoveredDevice DTO to the DiscoveredDevice model
+func ToDiscoveredDeviceModel(dto DiscoveredDevice) models.DiscoveredDevice {
+	return models.DiscoveredDevice{
+		Id:                   dto.Id,
+		Name:                 dto.Name,
+		Description:          dto.Description,
+		Labels:               dto.Labels,
+		ServiceName:          dto.ServiceName,
+		Protocols:            dtos.ToProtocolModels(dto.Protocols),
+		ProvisionWatcherName: dto.ProvisionWatcherName,
+		ProfileName:          dto.ProfileName,
+		Status:               models.DiscoveredDeviceStatus(dto.Status),
+	}
+}
+
+// FromDiscoveredDeviceModelToDTO transforms the DiscoveredDevice model to the DiscoveredDevice DTO
+func FromDiscoveredDeviceModelToDTO(d models.DiscoveredDevice) DiscoveredDevice {
+	return DiscoveredDevice{
+		DBTimestamp:          dtos.DBTimestamp(d.DBTimestamp),
+		Id:                   d.Id,
+		Name:                 d.Name,
+		Description:          d.Description,
+		Labels:               d.Labels,
+		ServiceName:          d.ServiceName,
+		Protocols:            dtos.FromProtocolModelsToDTOs(d.Protocols),
+		ProvisionWatcherName: d.ProvisionWatcherName,
+		ProfileName:          d.ProfileName,
+		Status:               string(d.Status),
+	}
+}
+
+// FromDiscoveredDeviceModelsToDTOs transforms the DiscoveredDevice model array to the DiscoveredDevice DTO array
+func FromDiscoveredDeviceModelsToDTOs(ds []models.DiscoveredDevice) []DiscoveredDevice {
+	dtos := make([]DiscoveredDevice, len(ds))
+	for i, d := range ds {
+		dtos[i] = FromDiscoveredDeviceModelToDTO(d)
+	}
+	return dtos
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// NearbyDevice represents a device found by a geospatial query, the distance is in meters from the center of the
+// queried area
+type NearbyDevice struct {
+	Distance float64     `json:"distance"`
+	Device   dtos.Device `json:"device"`
+}
+
+// FromNearbyDeviceModelToDTO transforms the NearbyDevice Model to the NearbyDevice DTO
+func FromNearbyDeviceModelToDTO(d models.NearbyDevice) NearbyDevice {
+	return NearbyDevice{
+		Distance: d.Distance,
+		Device:   dtos.FromDeviceModelToDTO(d.Device),
+	}
+}
+
+// FromNearbyDeviceModelsToDTOs transforms the NearbyDevice model array to the NearbyDevice DTO array
+func FromNearbyDeviceModelsToDTOs(ds []models.NearbyDevice) []NearbyDevice {
+	dtos := make([]NearbyDevice, len(ds))
+	for i, d := range ds {
+		dtos[i] = FromNearbyDeviceModelToDTO(d)
+	}
+	return dtos
+}
@@ -0,0 +1,15 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// MetadataChange describes a device, device profile or device service added, updated or deleted in core-metadata. It
+// is the JSON content of the change notifications which core-metadata sends when Notifications.PostDeviceChanges is
+// enabled, so that the services caching the metadata can invalidate the changed entity.
+type MetadataChange struct {
+	Action     string `json:"action" validate:"oneof='ADD' 'UPDATE' 'DELETE'"`
+	EntityType string `json:"entityType" validate:"oneof='device' 'deviceprofile' 'deviceservice'"`
+	Name       string `json:"name" validate:"required,edgex-dto-none-empty-string"`
+}
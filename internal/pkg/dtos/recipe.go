@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// Recipe represents an ordered sequence of commands which core-command issues to the devices on demand
+type Recipe struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string       `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string       `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string       `json:"description,omitempty"`
+	Steps            []RecipeStep `json:"steps" validate:"required,gt=0,dive"`
+}
+
+// RecipeStep represents a command of the recipe, which is issued once the delay has elapsed and only if the condition
+// is met
+type RecipeStep struct {
+	Name        string            `json:"name,omitempty" validate:"omitempty,edgex-dto-rfc3986-unreserved-chars"`
+	DeviceName  string            `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	CommandName string            `json:"commandName" validate:"required,edgex-dto-none-empty-string"`
+	Method      string            `json:"method" validate:"oneof='GET' 'PUT'"`
+	QueryParams string            `json:"queryParams,omitempty"`
+	Settings    map[string]string `json:"settings,omitempty"`
+	Delay       string            `json:"delay,omitempty"`
+	Condition   *RecipeCondition  `json:"condition,omitempty"`
+}
+
+// RecipeCondition represents the comparison of a reading returned by an earlier GET step with the value
+type RecipeCondition struct {
+	Step         string `json:"step" validate:"required,edgex-dto-none-empty-string"`
+	ResourceName string `json:"resourceName" validate:"required,edgex-dto-none-empty-string"`
+	Operator     string `json:"operator" validate:"oneof='==' '!=' '>' '>=' '<' '<='"`
+	Value        string `json:"value"`
+}
+
+// RecipeRun represents the log of an execution of the recipe
+type RecipeRun struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string          `json:"id"`
+	RecipeName       string          `json:"recipeName"`
+	Status           string          `json:"status"`
+	Message          string          `json:"message,omitempty"`
+	Steps            []RecipeStepLog `json:"steps"`
+	Caller           string          `json:"caller"`
+	CorrelationId    string          `json:"correlationId,omitempty"`
+	Started          int64           `json:"started"`
+	Completed        int64           `json:"completed,omitempty"`
+}
+
+// RecipeStepLog represents the outcome of a step of the recipe run
+type RecipeStepLog struct {
+	Name        string            `json:"name,omitempty"`
+	DeviceName  string            `json:"deviceName"`
+	CommandName string            `json:"commandName"`
+	Method      string            `json:"method"`
+	Skipped     bool              `json:"skipped,omitempty"`
+	StatusCode  int               `json:"statusCode,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	Readings    map[string]string `json:"readings,omitempty"`
+	Started     int64             `json:"started,omitempty"`
+	Completed   int64             `json:"completed,omitempty"`
+}
+
+// ToRecipeModel transforms the Recipe DTO to the Recipe model
+func ToRecipeModel(dto Recipe) models.Recipe {
+	steps := make([]models.RecipeStep, len(dto.Steps))
+	for i, s := range dto.Steps {
+		steps[i] = models.RecipeStep{
+			Name:        s.Name,
+			DeviceName:  s.DeviceName,
+			CommandName: s.CommandName,
+			Method:      s.Method,
+			QueryParams: s.QueryParams,
+			Settings:    s.Settings,
+			Delay:       s.Delay,
+		}
+		if s.Condition != nil {
+			steps[i].Condition = &models.RecipeCondition{
+				Step:         s.Condition.Step,
+				ResourceName: s.Condition.ResourceName,
+				Operator:     s.Condition.Operator,
+				Value:        s.Condition.Value,
+			}
+		}
+	}
+	return models.Recipe{
+		Id:          dto.Id,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Steps:       steps,
+	}
+}
+
+// FromRecipeModelToDTO transforms the Recipe model to the Recipe DTO
+func FromRecipeModelToDTO(r models.Recipe) Recipe {
+	steps := make([]RecipeStep, len(r.Steps))
+	for i, s := range r.Steps {
+		steps[i] = RecipeStep{
+			Name:        s.Name,
+			DeviceName:  s.DeviceName,
+			CommandName: s.CommandName,
+			Method:      s.Method,
+			QueryParams: s.QueryParams,
+			Settings:    s.Settings,
+			Delay:       s.Delay,
+		}
+		if s.Condition != nil {
+			steps[i].Condition = &RecipeCondition{
+				Step:         s.Condition.Step,
+				ResourceName: s.Condition.ResourceName,
+				Operator:     s.Condition.Operator,
+				Value:        s.Condition.Value,
+			}
+		}
+	}
+	return Recipe{
+		DBTimestamp: dtos.DBTimestamp(r.DBTimestamp),
+		Id:          r.Id,
+		Name:        r.Name,
+		Description: r.Description,
+		Steps:       steps,
+	}
+}
+
+// FromRecipeModelsToDTOs transforms the Recipe model array to the Recipe DTO array
+func FromRecipeModelsToDTOs(rs []models.Recipe) []Recipe {
+	dtos := make([]Recipe, len(rs))
+	for i, r := range rs {
+		dtos[i] = FromRecipeModelToDTO(r)
+	}
+	return dtos
+}
+
+// FromRecipeRunModelToDTO transforms the RecipeRun model to the RecipeRun DTO
+func FromRecipeRunModelToDTO(run models.RecipeRun) RecipeRun {
+	steps := make([]RecipeStepLog, len(run.Steps))
+	for i, s := range run.Steps {
+		steps[i] = RecipeStepLog(s)
+	}
+	return RecipeRun{
+		DBTimestamp:   dtos.DBTimestamp(run.DBTimestamp),
+		Id:            run.Id,
+		RecipeName:    run.RecipeName,
+		Status:        string(run.Status),
+		Message:       run.Message,
+		Steps:         steps,
+		Caller:        run.Caller,
+		CorrelationId: run.CorrelationId,
+		Started:       run.Started,
+		Completed:     run.Completed,
+	}
+}
+
+// FromRecipeRunModelsToDTOs transforms the RecipeRun model array to the RecipeRun DTO array
+func FromRecipeRunModelsToDTOs(runs []models.RecipeRun) []RecipeRun {
+	dtos := make([]RecipeRun, len(runs))
+	for i, run := range runs {
+		dtos[i] = FromRecipeRunModelToDTO(run)
+	}
+	return dtos
+}
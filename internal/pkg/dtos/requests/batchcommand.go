@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// BatchCommandRequest defines the Request Content for POST batch command. The devices are selected by exactly one of
+// DeviceNames, Labels or ProfileName, the command is read with GET or written with PUT along with the Settings.
+type BatchCommandRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceNames           []string          `json:"deviceNames,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	Labels                []string          `json:"labels,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	ProfileName           string            `json:"profileName,omitempty"`
+	Command               string            `json:"command" validate:"required,edgex-dto-none-empty-string"`
+	Method                string            `json:"method" validate:"oneof='GET' 'PUT'"`
+	Settings              map[string]string `json:"settings,omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (b BatchCommandRequest) Validate() error {
+	err := common.Validate(b)
+	if err != nil {
+		return err
+	}
+
+	selectors := 0
+	for _, selected := range []bool{len(b.DeviceNames) > 0, len(b.Labels) > 0, b.ProfileName != ""} {
+		if selected {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "exactly one of deviceNames, labels or profileName is expected to select the devices", nil)
+	}
+	if b.Method == http.MethodPut && len(b.Settings) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "settings are required to write the command", nil)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the BatchCommandRequest type
+func (b *BatchCommandRequest) UnmarshalJSON(data []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceNames []string
+		Labels      []string
+		ProfileName string
+		Command     string
+		Method      string
+		Settings    map[string]string
+	}
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*b = BatchCommandRequest(alias)
+
+	// validate BatchCommandRequest DTO
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
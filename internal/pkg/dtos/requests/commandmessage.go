@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// CommandMessageRequest defines the payload of a command request received from the message bus. The device, the command
+// and whether it is read or written are given by the topic of the request, so the payload may be empty for reading.
+type CommandMessageRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	// QueryParameters are passed to the device service as the query parameters of the command, e.g. ds-pushevent
+	QueryParameters map[string]string `json:"queryParameters,omitempty"`
+	Settings        map[string]string `json:"settings,omitempty"`
+}
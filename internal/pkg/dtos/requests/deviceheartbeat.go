@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceHeartbeatRequest defines the Request Content for POST device heartbeat, which reports the device was seen alive.
+type DeviceHeartbeatRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceName            string `json:"deviceName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	// Timestamp is the time in milliseconds when the device was seen, the time of receiving the request is used if it is zero
+	Timestamp int64 `json:"timestamp,omitempty" validate:"gte=0"`
+}
+
+// Validate satisfies the Validator interface
+func (d DeviceHeartbeatRequest) Validate() error {
+	err := common.Validate(d)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the DeviceHeartbeatRequest type
+func (d *DeviceHeartbeatRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceName string
+		Timestamp  int64
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*d = DeviceHeartbeatRequest(alias)
+
+	// validate DeviceHeartbeatRequest DTO
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewDeviceHeartbeatRequest(deviceName string, timestamp int64) DeviceHeartbeatRequest {
+	return DeviceHeartbeatRequest{
+		BaseRequest: dtoCommon.NewBaseRequest(),
+		DeviceName:  deviceName,
+		Timestamp:   timestamp,
+	}
+}
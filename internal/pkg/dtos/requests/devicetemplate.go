@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddDeviceTemplateRequest defines the Request Content for POST device template.
+type AddDeviceTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Template              dtos.DeviceTemplate `json:"template"`
+}
+
+// Validate satisfies the Validator interface
+func (t AddDeviceTemplateRequest) Validate() error {
+	err := common.Validate(t)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceTemplateRequest type
+func (t *AddDeviceTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Template dtos.DeviceTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*t = AddDeviceTemplateRequest(alias)
+
+	// validate AddDeviceTemplateRequest DTO
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAddDeviceTemplateRequest(template dtos.DeviceTemplate) AddDeviceTemplateRequest {
+	return AddDeviceTemplateRequest{
+		BaseRequest: dtoCommon.NewBaseRequest(),
+		Template:    template,
+	}
+}
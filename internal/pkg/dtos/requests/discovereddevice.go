@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddDiscoveredDeviceRequest defines the Request Content for POST discovered device, which submits a device found by
+// the device service to be matched against the provision watchers by core-metadata.
+type AddDiscoveredDeviceRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Device                dtos.DiscoveredDevice `json:"device"`
+}
+
+// Validate satisfies the Validator interface
+func (d AddDiscoveredDeviceRequest) Validate() error {
+	err := common.Validate(d)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDiscoveredDeviceRequest type
+func (d *AddDiscoveredDeviceRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Device dtos.DiscoveredDevice
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*d = AddDiscoveredDeviceRequest(alias)
+
+	// validate AddDiscoveredDeviceRequest DTO
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAddDiscoveredDeviceRequest(device dtos.DiscoveredDevice) AddDiscoveredDeviceRequest {
+	return AddDiscoveredDeviceRequest{
+		BaseRequest: dtoCommon.NewBaseRequest(),
+		Device:      device,
+	}
+}
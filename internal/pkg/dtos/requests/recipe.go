@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddRecipeRequest defines the Request Content for POST recipe.
+type AddRecipeRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Recipe                dtos.Recipe `json:"recipe"`
+}
+
+// Validate satisfies the Validator interface. Besides the DTO tags, the delays have to be durations, the PUT steps
+// need settings, and the conditions can only refer to the readings of the earlier GET steps.
+func (r AddRecipeRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return err
+	}
+
+	getSteps := make(map[string]bool)
+	names := make(map[string]bool)
+	for i, step := range r.Recipe.Steps {
+		if step.Name != "" {
+			if names[step.Name] {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("step name %s is duplicated", step.Name), nil)
+			}
+			names[step.Name] = true
+		}
+		if step.Delay != "" {
+			if delay, err := time.ParseDuration(step.Delay); err != nil || delay < 0 {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid delay '%s' of step %d", step.Delay, i), err)
+			}
+		}
+		if step.Method == http.MethodPut && len(step.Settings) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("settings are required to write the command of step %d", i), nil)
+		}
+		if step.Condition != nil && !getSteps[step.Condition.Step] {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the condition of step %d doesn't refer to an earlier GET step", i), nil)
+		}
+		if step.Name != "" && step.Method == http.MethodGet {
+			getSteps[step.Name] = true
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddRecipeRequest type
+func (r *AddRecipeRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Recipe dtos.Recipe
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddRecipeRequest(alias)
+
+	// validate AddRecipeRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAddRecipeRequest(recipe dtos.Recipe) AddRecipeRequest {
+	return AddRecipeRequest{
+		BaseRequest: dtoCommon.NewBaseRequest(),
+		Recipe:      recipe,
+	}
+}
@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddSubscriptionDigestRequest defines the Request Content for POST subscription digest.
+type AddSubscriptionDigestRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	SubscriptionDigest    dtos.SubscriptionDigest `json:"subscriptionDigest"`
+}
+
+// Validate satisfies the Validator interface. Besides the DTO tags, the digest has to be sent by a positive interval,
+// a number of notifications or both.
+func (r AddSubscriptionDigestRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return err
+	}
+
+	d := r.SubscriptionDigest
+	if d.Interval == "" && d.MaxNotifications == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the digest needs an interval or a maximum number of notifications", nil)
+	}
+	if d.Interval != "" {
+		if interval, err := time.ParseDuration(d.Interval); err != nil || interval <= 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the interval of the digest must be positive", err)
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddSubscriptionDigestRequest type
+func (r *AddSubscriptionDigestRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		SubscriptionDigest dtos.SubscriptionDigest
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddSubscriptionDigestRequest(alias)
+
+	// validate AddSubscriptionDigestRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAddSubscriptionDigestRequest(digest dtos.SubscriptionDigest) AddSubscriptionDigestRequest {
+	return AddSubscriptionDigestRequest{
+		BaseRequest:        dtoCommon.NewBaseRequest(),
+		SubscriptionDigest: digest,
+	}
+}
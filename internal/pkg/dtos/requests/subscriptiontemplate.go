@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddSubscriptionTemplateRequest defines the Request Content for POST subscription template.
+type AddSubscriptionTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	SubscriptionTemplate  dtos.SubscriptionTemplate `json:"subscriptionTemplate"`
+}
+
+// Validate satisfies the Validator interface. Besides the DTO tags, a channel type can only have one template and each
+// template has to render the subject or the body. The templates themselves are parsed when they are added.
+func (r AddSubscriptionTemplateRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return err
+	}
+
+	channelTypes := make(map[string]bool)
+	for _, t := range r.SubscriptionTemplate.Templates {
+		if channelTypes[t.ChannelType] {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("channel type '%s' has more than one template", t.ChannelType), nil)
+		}
+		channelTypes[t.ChannelType] = true
+		if t.Subject == "" && t.Body == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the template of channel type '%s' has neither subject nor body", t.ChannelType), nil)
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddSubscriptionTemplateRequest type
+func (r *AddSubscriptionTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		SubscriptionTemplate dtos.SubscriptionTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddSubscriptionTemplateRequest(alias)
+
+	// validate AddSubscriptionTemplateRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAddSubscriptionTemplateRequest(template dtos.SubscriptionTemplate) AddSubscriptionTemplateRequest {
+	return AddSubscriptionTemplateRequest{
+		BaseRequest:          dtoCommon.NewBaseRequest(),
+		SubscriptionTemplate: template,
+	}
+}
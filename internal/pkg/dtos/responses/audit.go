@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// MultiAuditRecordsResponse defines the Response Content for GET multiple AuditRecord DTOs.
+type MultiAuditRecordsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Records             []dtos.AuditRecord `json:"records"`
+}
+
+func NewMultiAuditRecordsResponse(requestId string, message string, statusCode int,
+	records []dtos.AuditRecord) MultiAuditRecordsResponse {
+	return MultiAuditRecordsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Records:      records,
+	}
+}
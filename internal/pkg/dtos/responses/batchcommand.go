@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// BatchCommandResponse defines the Response Content for POST batch command, which holds the result of each selected
+// device in the order they were selected.
+type BatchCommandResponse struct {
+	common.BaseResponse `json:",inline"`
+	Results             []dtos.BatchCommandResult `json:"results"`
+}
+
+func NewBatchCommandResponse(requestId string, message string, statusCode int, results []dtos.BatchCommandResult) BatchCommandResponse {
+	return BatchCommandResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Results:      results,
+	}
+}
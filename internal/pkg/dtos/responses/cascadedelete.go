@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// CascadeDeleteResponse defines the Response Content for deleting a device service or device profile along with its
+// devices and provision watchers. The listed entities are not deleted if DryRun is true.
+type CascadeDeleteResponse struct {
+	common.BaseResponse `json:",inline"`
+	DryRun              bool     `json:"dryRun"`
+	Devices             []string `json:"devices"`
+	ProvisionWatchers   []string `json:"provisionWatchers"`
+}
+
+func NewCascadeDeleteResponse(requestId string, message string, statusCode int,
+	dryRun bool, devices []string, provisionWatchers []string) CascadeDeleteResponse {
+	return CascadeDeleteResponse{
+		BaseResponse:      common.NewBaseResponse(requestId, message, statusCode),
+		DryRun:            dryRun,
+		Devices:           devices,
+		ProvisionWatchers: provisionWatchers,
+	}
+}
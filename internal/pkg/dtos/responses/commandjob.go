@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// CommandJobResponse defines the Response Content for GET CommandJob DTO, it's also the response of issuing a command
+// asynchronously and the body posted to the callback URL of the job once it's completed.
+type CommandJobResponse struct {
+	common.BaseResponse `json:",inline"`
+	Job                 dtos.CommandJob `json:"job"`
+}
+
+func NewCommandJobResponse(requestId string, message string, statusCode int, job dtos.CommandJob) CommandJobResponse {
+	return CommandJobResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Job:          job,
+	}
+}
+
+// MultiCommandJobsResponse defines the Response Content for GET multiple CommandJob DTOs.
+type MultiCommandJobsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Jobs                []dtos.CommandJob `json:"jobs"`
+}
+
+func NewMultiCommandJobsResponse(requestId string, message string, statusCode int, jobs []dtos.CommandJob) MultiCommandJobsResponse {
+	return MultiCommandJobsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Jobs:         jobs,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// CommandRecordResponse defines the Response Content for GET CommandRecord DTO
+type CommandRecordResponse struct {
+	common.BaseResponse `json:",inline"`
+	Record              dtos.CommandRecord `json:"record"`
+}
+
+func NewCommandRecordResponse(requestId string, message string, statusCode int, record dtos.CommandRecord) CommandRecordResponse {
+	return CommandRecordResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Record:       record,
+	}
+}
+
+// MultiCommandRecordsResponse defines the Response Content for GET multiple CommandRecord DTOs
+type MultiCommandRecordsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Records             []dtos.CommandRecord `json:"records"`
+}
+
+func NewMultiCommandRecordsResponse(requestId string, message string, statusCode int, records []dtos.CommandRecord) MultiCommandRecordsResponse {
+	return MultiCommandRecordsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Records:      records,
+	}
+}
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceProfileValidationResponse defines the Response Content for linting a device profile. The device profile is
+// valid when none of the problems is an error.
+type DeviceProfileValidationResponse struct {
+	common.BaseResponse `json:",inline"`
+	Valid               bool                        `json:"valid"`
+	Problems            []dtos.DeviceProfileProblem `json:"problems"`
+}
+
+func NewDeviceProfileValidationResponse(requestId string, message string, statusCode int,
+	valid bool, problems []dtos.DeviceProfileProblem) DeviceProfileValidationResponse {
+	return DeviceProfileValidationResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Valid:        valid,
+		Problems:     problems,
+	}
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceServiceCallbackResponse defines the Response Content for GET DeviceServiceCallback DTO.
+type DeviceServiceCallbackResponse struct {
+	common.BaseResponse `json:",inline"`
+	Callback            dtos.DeviceServiceCallback `json:"callback"`
+}
+
+func NewDeviceServiceCallbackResponse(requestId string, message string, statusCode int,
+	callback dtos.DeviceServiceCallback) DeviceServiceCallbackResponse {
+	return DeviceServiceCallbackResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Callback:     callback,
+	}
+}
+
+// MultiDeviceServiceCallbacksResponse defines the Response Content for GET multiple DeviceServiceCallback DTOs.
+type MultiDeviceServiceCallbacksResponse struct {
+	common.BaseResponse `json:",inline"`
+	Callbacks           []dtos.DeviceServiceCallback `json:"callbacks"`
+}
+
+func NewMultiDeviceServiceCallbacksResponse(requestId string, message string, statusCode int,
+	callbacks []dtos.DeviceServiceCallback) MultiDeviceServiceCallbacksResponse {
+	return MultiDeviceServiceCallbacksResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Callbacks:    callbacks,
+	}
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceServiceHealthResponse defines the Response Content for GET DeviceServiceHealth DTO.
+type DeviceServiceHealthResponse struct {
+	common.BaseResponse `json:",inline"`
+	Health              dtos.DeviceServiceHealth `json:"health"`
+}
+
+func NewDeviceServiceHealthResponse(requestId string, message string, statusCode int,
+	health dtos.DeviceServiceHealth) DeviceServiceHealthResponse {
+	return DeviceServiceHealthResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Health:       health,
+	}
+}
+
+// MultiDeviceServiceHealthResponse defines the Response Content for GET multiple DeviceServiceHealth DTOs.
+type MultiDeviceServiceHealthResponse struct {
+	common.BaseResponse `json:",inline"`
+	Health              []dtos.DeviceServiceHealth `json:"health"`
+}
+
+func NewMultiDeviceServiceHealthResponse(requestId string, message string, statusCode int,
+	health []dtos.DeviceServiceHealth) MultiDeviceServiceHealthResponse {
+	return MultiDeviceServiceHealthResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Health:       health,
+	}
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	contractsDtos "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceTemplateResponse defines the Response Content for GET DeviceTemplate DTO.
+type DeviceTemplateResponse struct {
+	common.BaseResponse `json:",inline"`
+	Template            dtos.DeviceTemplate `json:"template"`
+}
+
+func NewDeviceTemplateResponse(requestId string, message string, statusCode int,
+	template dtos.DeviceTemplate) DeviceTemplateResponse {
+	return DeviceTemplateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Template:     template,
+	}
+}
+
+// MultiDeviceTemplatesResponse defines the Response Content for GET multiple DeviceTemplate DTOs.
+type MultiDeviceTemplatesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Templates           []dtos.DeviceTemplate `json:"templates"`
+}
+
+func NewMultiDeviceTemplatesResponse(requestId string, message string, statusCode int,
+	templates []dtos.DeviceTemplate) MultiDeviceTemplatesResponse {
+	return MultiDeviceTemplatesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Templates:    templates,
+	}
+}
+
+// InstantiateDevicesResponse defines the Response Content for POST device template instantiation, which lists the
+// devices created from the template, or the devices which would be created in a dry run.
+type InstantiateDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	DryRun              bool                   `json:"dryRun,omitempty"`
+	Devices             []contractsDtos.Device `json:"devices"`
+}
+
+func NewInstantiateDevicesResponse(requestId string, message string, statusCode int,
+	dryRun bool, devices []contractsDtos.Device) InstantiateDevicesResponse {
+	return InstantiateDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DryRun:       dryRun,
+		Devices:      devices,
+	}
+}
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DiscoveredDeviceResponse defines the Response Content for GET DiscoveredDevice DTO.
+type DiscoveredDeviceResponse struct {
+	common.BaseResponse `json:",inline"`
+	Device              dtos.DiscoveredDevice `json:"device"`
+}
+
+func NewDiscoveredDeviceResponse(requestId string, message string, statusCode int,
+	device dtos.DiscoveredDevice) DiscoveredDeviceResponse {
+	return DiscoveredDeviceResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Device:       device,
+	}
+}
+
+// MultiDiscoveredDevicesResponse defines the Response Content for GET multiple DiscoveredDevice DTOs.
+type MultiDiscoveredDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Devices             []dtos.DiscoveredDevice `json:"devices"`
+}
+
+func NewMultiDiscoveredDevicesResponse(requestId string, message string, statusCode int,
+	devices []dtos.DiscoveredDevice) MultiDiscoveredDevicesResponse {
+	return MultiDiscoveredDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Devices:      devices,
+	}
+}
+
+// DiscoveryResultResponse defines the Response Content for POST discovered device. The id is the id of the created
+// device if the result is CREATED, or the id of the discovered device parked for the approval if it is PENDING.
+type DiscoveryResultResponse struct {
+	common.BaseWithIdResponse `json:",inline"`
+	Result                    string `json:"result"`
+	ProvisionWatcherName      string `json:"provisionWatcherName,omitempty"`
+}
+
+func NewDiscoveryResultResponse(requestId string, message string, statusCode int,
+	id string, result string, provisionWatcherName string) DiscoveryResultResponse {
+	return DiscoveryResultResponse{
+		BaseWithIdResponse:   common.NewBaseWithIdResponse(requestId, message, statusCode, id),
+		Result:               result,
+		ProvisionWatcherName: provisionWatcherName,
+	}
+}
@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// MultiNearbyDevicesResponse defines the Response Content for the geospatial device queries, TotalCount is the number
+// of all the devices within the queried area regardless of the offset and limit
+type MultiNearbyDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	TotalCount          uint32              `json:"totalCount"`
+	Devices             []dtos.NearbyDevice `json:"devices"`
+}
+
+func NewMultiNearbyDevicesResponse(requestId string, message string, statusCode int, totalCount uint32, devices []dtos.NearbyDevice) MultiNearbyDevicesResponse {
+	return MultiNearbyDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		TotalCount:   totalCount,
+		Devices:      devices,
+	}
+}
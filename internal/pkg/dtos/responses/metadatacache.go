@@ -0,0 +1,33 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// MetadataCacheStatsResponse defines the Response Content for GET metadata cache stats, the counters are zero if the
+// cache is disabled
+type MetadataCacheStatsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Enabled             bool    `json:"enabled"`
+	Hits                uint64  `json:"hits"`
+	Misses              uint64  `json:"misses"`
+	HitRate             float64 `json:"hitRate"`
+	Entries             int     `json:"entries"`
+}
+
+func NewMetadataCacheStatsResponse(requestId string, message string, statusCode int,
+	enabled bool, hits uint64, misses uint64, hitRate float64, entries int) MetadataCacheStatsResponse {
+	return MetadataCacheStatsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Enabled:      enabled,
+		Hits:         hits,
+		Misses:       misses,
+		HitRate:      hitRate,
+		Entries:      entries,
+	}
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// RecipeResponse defines the Response Content for GET Recipe DTO
+type RecipeResponse struct {
+	common.BaseResponse `json:",inline"`
+	Recipe              dtos.Recipe `json:"recipe"`
+}
+
+func NewRecipeResponse(requestId string, message string, statusCode int, recipe dtos.Recipe) RecipeResponse {
+	return RecipeResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Recipe:       recipe,
+	}
+}
+
+// MultiRecipesResponse defines the Response Content for GET multiple Recipe DTOs
+type MultiRecipesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Recipes             []dtos.Recipe `json:"recipes"`
+}
+
+func NewMultiRecipesResponse(requestId string, message string, statusCode int, recipes []dtos.Recipe) MultiRecipesResponse {
+	return MultiRecipesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Recipes:      recipes,
+	}
+}
+
+// RecipeRunResponse defines the Response Content for GET RecipeRun DTO
+type RecipeRunResponse struct {
+	common.BaseResponse `json:",inline"`
+	Run                 dtos.RecipeRun `json:"run"`
+}
+
+func NewRecipeRunResponse(requestId string, message string, statusCode int, run dtos.RecipeRun) RecipeRunResponse {
+	return RecipeRunResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Run:          run,
+	}
+}
+
+// MultiRecipeRunsResponse defines the Response Content for GET multiple RecipeRun DTOs
+type MultiRecipeRunsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Runs                []dtos.RecipeRun `json:"runs"`
+}
+
+func NewMultiRecipeRunsResponse(requestId string, message string, statusCode int, runs []dtos.RecipeRun) MultiRecipeRunsResponse {
+	return MultiRecipeRunsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Runs:         runs,
+	}
+}
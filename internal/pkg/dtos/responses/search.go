@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// SearchResponse defines the Response Content for the metadata search, TotalCount is the number of all the matched
+// entities regardless of the offset and limit
+type SearchResponse struct {
+	common.BaseResponse `json:",inline"`
+	TotalCount          uint32           `json:"totalCount"`
+	Hits                []dtos.SearchHit `json:"hits"`
+}
+
+func NewSearchResponse(requestId string, message string, statusCode int, totalCount uint32, hits []dtos.SearchHit) SearchResponse {
+	return SearchResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		TotalCount:   totalCount,
+		Hits:         hits,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// SubscriptionDigestResponse defines the Response Content for GET SubscriptionDigest DTO
+type SubscriptionDigestResponse struct {
+	common.BaseResponse `json:",inline"`
+	SubscriptionDigest  dtos.SubscriptionDigest `json:"subscriptionDigest"`
+}
+
+func NewSubscriptionDigestResponse(requestId string, message string, statusCode int, digest dtos.SubscriptionDigest) SubscriptionDigestResponse {
+	return SubscriptionDigestResponse{
+		BaseResponse:       common.NewBaseResponse(requestId, message, statusCode),
+		SubscriptionDigest: digest,
+	}
+}
+
+// MultiSubscriptionDigestsResponse defines the Response Content for GET multiple SubscriptionDigest DTOs
+type MultiSubscriptionDigestsResponse struct {
+	common.BaseResponse `json:",inline"`
+	SubscriptionDigests []dtos.SubscriptionDigest `json:"subscriptionDigests"`
+}
+
+func NewMultiSubscriptionDigestsResponse(requestId string, message string, statusCode int, digests []dtos.SubscriptionDigest) MultiSubscriptionDigestsResponse {
+	return MultiSubscriptionDigestsResponse{
+		BaseResponse:        common.NewBaseResponse(requestId, message, statusCode),
+		SubscriptionDigests: digests,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// SubscriptionTemplateResponse defines the Response Content for GET SubscriptionTemplate DTO
+type SubscriptionTemplateResponse struct {
+	common.BaseResponse  `json:",inline"`
+	SubscriptionTemplate dtos.SubscriptionTemplate `json:"subscriptionTemplate"`
+}
+
+func NewSubscriptionTemplateResponse(requestId string, message string, statusCode int, template dtos.SubscriptionTemplate) SubscriptionTemplateResponse {
+	return SubscriptionTemplateResponse{
+		BaseResponse:         common.NewBaseResponse(requestId, message, statusCode),
+		SubscriptionTemplate: template,
+	}
+}
+
+// MultiSubscriptionTemplatesResponse defines the Response Content for GET multiple SubscriptionTemplate DTOs
+type MultiSubscriptionTemplatesResponse struct {
+	common.BaseResponse   `json:",inline"`
+	SubscriptionTemplates []dtos.SubscriptionTemplate `json:"subscriptionTemplates"`
+}
+
+func NewMultiSubscriptionTemplatesResponse(requestId string, message string, statusCode int, templates []dtos.SubscriptionTemplate) MultiSubscriptionTemplatesResponse {
+	return MultiSubscriptionTemplatesResponse{
+		BaseResponse:          common.NewBaseResponse(requestId, message, statusCode),
+		SubscriptionTemplates: templates,
+	}
+}
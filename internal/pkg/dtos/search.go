@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// SearchHit represents a metadata entity matched by the core-metadata search, the entity can be queried by the type and
+// name for the complete details
+type SearchHit struct {
+	Type        string   `json:"type"`
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Modified    int64    `json:"modified"`
+}
+
+// FromSearchHitModelToDTO transforms the SearchHit Model to the SearchHit DTO
+func FromSearchHitModelToDTO(h models.SearchHit) SearchHit {
+	return SearchHit{
+		Type:        string(h.Type),
+		Id:          h.Id,
+		Name:        h.Name,
+		Description: h.Description,
+		Labels:      h.Labels,
+		Modified:    h.Modified,
+	}
+}
+
+// FromSearchHitModelsToDTOs transforms the SearchHit model array to the SearchHit DTO array
+func FromSearchHitModelsToDTOs(hs []models.SearchHit) []SearchHit {
+	dtos := make([]SearchHit, len(hs))
+	for i, h := range hs {
+		dtos[i] = FromSearchHitModelToDTO(h)
+	}
+	return dtos
+}
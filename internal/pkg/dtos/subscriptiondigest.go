@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// SubscriptionDigest represents the digest mode of a subscription, the pending notifications are reported but never
+// taken from the requests
+type SubscriptionDigest struct {
+	dtos.DBTimestamp       `json:",inline"`
+	Id                     string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	SubscriptionName       string   `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	Interval               string   `json:"interval,omitempty" validate:"omitempty,edgex-dto-duration"`
+	MaxNotifications       int      `json:"maxNotifications,omitempty" validate:"gte=0"`
+	PendingNotificationIds []string `json:"pendingNotificationIds,omitempty"`
+	PendingSince           int64    `json:"pendingSince,omitempty"`
+}
+
+// ToSubscriptionDigestModel transforms the SubscriptionDigest DTO to the SubscriptionDigest model without the pending
+// notifications
+func ToSubscriptionDigestModel(dto SubscriptionDigest) models.SubscriptionDigest {
+	return models.SubscriptionDigest{
+		Id:               dto.Id,
+		SubscriptionName: dto.SubscriptionName,
+		Interval:         dto.Interval,
+		MaxNotifications: dto.MaxNotifications,
+	}
+}
+
+// FromSubscriptionDigestModelToDTO transforms the SubscriptionDigest model to the SubscriptionDigest DTO
+func FromSubscriptionDigestModelToDTO(d models.SubscriptionDigest) SubscriptionDigest {
+	return SubscriptionDigest{
+		DBTimestamp:            dtos.DBTimestamp(d.DBTimestamp),
+		Id:                     d.Id,
+		SubscriptionName:       d.SubscriptionName,
+		Interval:               d.Interval,
+		MaxNotifications:       d.MaxNotifications,
+		PendingNotificationIds: d.PendingNotificationIds,
+		PendingSince:           d.PendingSince,
+	}
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// SubscriptionTemplate represents the templates rendering the notifications transmitted to the channels of a
+// subscription
+type SubscriptionTemplate struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string            `json:"id,omitempty" validate:"omitempty,uuid"`
+	SubscriptionName string            `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	Templates        []ChannelTemplate `json:"templates" validate:"required,gt=0,dive"`
+}
+
+// ChannelTemplate represents the templates of the subject and body of the notifications sent via a channel type, the
+// template without a channel type applies to all the other channels
+type ChannelTemplate struct {
+	ChannelType string `json:"channelType,omitempty" validate:"omitempty,oneof='REST' 'EMAIL' 'MQTT' 'MESSAGEBUS'"`
+	Engine      string `json:"engine,omitempty" validate:"omitempty,oneof='text' 'html'"`
+	Subject     string `json:"subject,omitempty"`
+	Body        string `json:"body,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// ToSubscriptionTemplateModel transforms the SubscriptionTemplate DTO to the SubscriptionTemplate model
+func ToSubscriptionTemplateModel(dto SubscriptionTemplate) models.SubscriptionTemplate {
+	templates := make([]models.ChannelTemplate, len(dto.Templates))
+	for i, t := range dto.Templates {
+		engine := t.Engine
+		if engine == "" {
+			engine = models.TemplateEngineText
+		}
+		templates[i] = models.ChannelTemplate{
+			ChannelType: t.ChannelType,
+			Engine:      engine,
+			Subject:     t.Subject,
+			Body:        t.Body,
+			ContentType: t.ContentType,
+		}
+	}
+	return models.SubscriptionTemplate{
+		Id:               dto.Id,
+		SubscriptionName: dto.SubscriptionName,
+		Templates:        templates,
+	}
+}
+
+// FromSubscriptionTemplateModelToDTO transforms the SubscriptionTemplate model to the SubscriptionTemplate DTO
+func FromSubscriptionTemplateModelToDTO(t models.SubscriptionTemplate) SubscriptionTemplate {
+	templates := make([]ChannelTemplate, len(t.Templates))
+	for i, ct := range t.Templates {
+		templates[i] = ChannelTemplate{
+			ChannelType: ct.ChannelType,
+			Engine:      ct.Engine,
+			Subject:     ct.Subject,
+			Body:        ct.Body,
+			ContentType: ct.ContentType,
+		}
+	}
+	return SubscriptionTemplate{
+		DBTimestamp:      dtos.DBTimestamp(t.DBTimestamp),
+		Id:               t.Id,
+		SubscriptionName: t.SubscriptionName,
+		Templates:        templates,
+	}
+}
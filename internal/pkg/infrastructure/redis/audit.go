@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// The audit records of all the core services share the database, so they are indexed by the service which recorded
+// them, by the changed entity and by the actor within the service.
+const (
+	AuditCollection        = "audit"
+	AuditCollectionService = AuditCollection + DBKeySeparator + "service"
+	AuditCollectionEntity  = AuditCollection + DBKeySeparator + "entity"
+	AuditCollectionActor   = AuditCollection + DBKeySeparator + "actor"
+)
+
+// auditRecordStoredKey return the audit record's stored key which combines the collection name and object id
+func auditRecordStoredKey(id string) string {
+	return CreateKey(AuditCollection, id)
+}
+
+// sendAddAuditRecordCmd sends redis command for adding audit record
+func sendAddAuditRecordCmd(conn redis.Conn, storedKey string, r models.AuditRecord) errors.EdgeX {
+	m, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal audit record for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CreateKey(AuditCollectionService, r.Service), r.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(AuditCollectionEntity, string(r.EntityType), r.EntityName), r.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(AuditCollectionActor, r.Service, r.Actor), r.Created, storedKey)
+	return nil
+}
+
+// sendDeleteAuditRecordCmd sends redis command for deleting audit record
+func sendDeleteAuditRecordCmd(conn redis.Conn, storedKey string, r models.AuditRecord) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, CreateKey(AuditCollectionService, r.Service), storedKey)
+	_ = conn.Send(ZREM, CreateKey(AuditCollectionEntity, string(r.EntityType), r.EntityName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(AuditCollectionActor, r.Service, r.Actor), storedKey)
+}
+
+// addAuditRecord adds a new audit record into DB
+func addAuditRecord(conn redis.Conn, r models.AuditRecord) (models.AuditRecord, errors.EdgeX) {
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+
+	_ = conn.Send(MULTI)
+	edgeXerr := sendAddAuditRecordCmd(conn, auditRecordStoredKey(r.Id), r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record creation failed", err)
+	}
+	return r, nil
+}
+
+// auditRecordsByService queries the audit records of the service by offset and limit, newest first
+func auditRecordsByService(conn redis.Conn, service string, offset int, limit int) ([]models.AuditRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(AuditCollectionService, service), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToAuditRecords(objects)
+}
+
+// auditRecordsByTimeRange queries the audit records of the service created within the time range by offset and
+// limit, newest first
+func auditRecordsByTimeRange(conn redis.Conn, service string, start int, end int, offset int, limit int) ([]models.AuditRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, CreateKey(AuditCollectionService, service), start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToAuditRecords(objects)
+}
+
+// auditRecordsByEntity queries the audit records of the entity by offset and limit, newest first
+func auditRecordsByEntity(conn redis.Conn, entityType models.AuditEntityType, name string, offset int, limit int) ([]models.AuditRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(AuditCollectionEntity, string(entityType), name), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToAuditRecords(objects)
+}
+
+// auditRecordsByActor queries the audit records of the changes made by the actor to the entities of the service by
+// offset and limit, newest first
+func auditRecordsByActor(conn redis.Conn, service string, actor string, offset int, limit int) ([]models.AuditRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(AuditCollectionActor, service, actor), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToAuditRecords(objects)
+}
+
+// deleteAuditRecordsByAge deletes the audit records of the service which are older than age and returns the count
+// of the deleted records
+func deleteAuditRecordsByAge(conn redis.Conn, service string, age int64) (int, errors.EdgeX) {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Values(conn.Do(ZRANGEBYSCORE, CreateKey(AuditCollectionService, service), InfiniteMin, expireTimestamp))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the audit records older than %d ms", age), err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, storedKeys)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	records, edgeXerr := convertObjectsToAuditRecords(objects)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	_ = conn.Send(MULTI)
+	for _, r := range records {
+		sendDeleteAuditRecordCmd(conn, auditRecordStoredKey(r.Id), r)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit records deletion failed", err)
+	}
+	return len(records), nil
+}
+
+func convertObjectsToAuditRecords(objects [][]byte) (records []models.AuditRecord, edgeXerr errors.EdgeX) {
+	records = make([]models.AuditRecord, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &records[i])
+		if err != nil {
+			return []models.AuditRecord{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record format parsing failed from the database", err)
+		}
+	}
+	return records, nil
+}
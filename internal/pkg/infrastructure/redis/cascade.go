@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// cascadeDeleteDeviceServiceByName deletes the device service together with its devices and provision watchers in one
+// transaction if it is at the expected revision, and returns the deleted dependents
+func cascadeDeleteDeviceServiceByName(conn redis.Conn, name string, revision int64) ([]models.Device, []models.ProvisionWatcher, errors.EdgeX) {
+	deviceIndex := CreateKey(DeviceCollectionServiceName, name)
+	provisionWatcherIndex := CreateKey(ProvisionWatcherCollectionServiceName, name)
+	edgeXerr := watch(conn, DeviceServiceCollectionName, deviceIndex, provisionWatcherIndex)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	ds, edgeXerr := deviceServiceByName(conn, name)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = watchRevision(conn, ds.Id, revision)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	devices, edgeXerr := devicesByServiceName(conn, 0, -1, name)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	provisionWatchers, edgeXerr := provisionWatchersByServiceName(conn, 0, -1, name)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDependentsCmd(conn, devices, provisionWatchers)
+	sendDeleteDeviceServiceCmd(conn, deviceServiceStoredKey(ds.Id), ds)
+	sendDeleteDeviceServiceHealthCmd(conn, ds.Name)
+	sendDeleteRevisionCmd(conn, ds.Id)
+	edgeXerr = execWatched(conn, "device service cascade deletion failed")
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return devices, provisionWatchers, nil
+}
+
+// cascadeDeleteDeviceProfileByName deletes the device profile together with its devices and provision watchers in one
+// transaction if it is at the expected revision, and returns the deleted dependents
+func cascadeDeleteDeviceProfileByName(conn redis.Conn, name string, revision int64) ([]models.Device, []models.ProvisionWatcher, errors.EdgeX) {
+	deviceIndex := CreateKey(DeviceCollectionProfileName, name)
+	provisionWatcherIndex := CreateKey(ProvisionWatcherCollectionProfileName, name)
+	edgeXerr := watch(conn, DeviceProfileCollectionName, deviceIndex, provisionWatcherIndex)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	dp, edgeXerr := deviceProfileByName(conn, name)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = watchRevision(conn, dp.Id, revision)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	devices, edgeXerr := devicesByProfileName(conn, 0, -1, name)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	provisionWatchers, edgeXerr := provisionWatchersByProfileName(conn, 0, -1, name)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDependentsCmd(conn, devices, provisionWatchers)
+	sendDeleteDeviceProfileCmd(conn, deviceProfileStoredKey(dp.Id), dp)
+	sendDeleteRevisionCmd(conn, dp.Id)
+	edgeXerr = execWatched(conn, "device profile cascade deletion failed")
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return devices, provisionWatchers, nil
+}
+
+func sendDeleteDependentsCmd(conn redis.Conn, devices []models.Device, provisionWatchers []models.ProvisionWatcher) {
+	for _, d := range devices {
+		sendDeleteDeviceCmd(conn, deviceStoredKey(d.Id), d)
+		sendDeleteRevisionCmd(conn, d.Id)
+	}
+	for _, pw := range provisionWatchers {
+		sendDeleteProvisionWatcherCmd(conn, provisionWatcherStoredKey(pw.Id), pw)
+		sendDeleteRevisionCmd(conn, pw.Id)
+	}
+}
+
+// watch marks the keys to be watched, so the following transaction is aborted if any of the keys is modified in the
+// meantime. The watch is released by the EXEC of the transaction or when the connection is returned to the pool.
+func watch(conn redis.Conn, keys ...string) errors.EdgeX {
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+	_, err := conn.Do(WATCH, args...)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to watch the keys for the transaction", err)
+	}
+	return nil
+}
+
+// maxWatchedAttempts is how many times an update guarded by watch is tried before giving up on the concurrent changes
+const maxWatchedAttempts = 3
+
+// execWatchedSet stores the object under the key in a transaction guarded by a previous watch. It returns false if the
+// transaction is aborted since a watched key is modified in the meantime, so that the caller can read and retry.
+func execWatchedSet(conn redis.Conn, storedKey string, obj interface{}, message string) (bool, errors.EdgeX) {
+	m, err := json.Marshal(obj)
+	if err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unable to JSON marshal %T for Redis persistence", obj), err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindDatabaseError, message, err)
+	}
+	return reply != nil, nil
+}
+
+// execWatched executes the transaction and reports a conflict if it is aborted since a watched key is modified
+func execWatched(conn redis.Conn, message string) errors.EdgeX {
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, message, err)
+	}
+	if reply == nil {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, message+", the dependents were changed concurrently, please retry", nil)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
@@ -105,11 +106,11 @@ func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile,
 	return addDeviceProfile(conn, dp)
 }
 
-// UpdateDeviceProfile updates a new device profile
-func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
+// UpdateDeviceProfile updates a new device profile if it is at the expected revision
+func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile, revision int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateDeviceProfile(conn, dp)
+	return updateDeviceProfile(conn, dp, revision)
 }
 
 // DeviceProfileNameExists checks the device profile exists by name
@@ -170,12 +171,12 @@ func (c *Client) DeleteDeviceServiceById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceServiceByName deletes a device service by name
-func (c *Client) DeleteDeviceServiceByName(name string) errors.EdgeX {
+// DeleteDeviceServiceByName deletes a device service by name if it is at the expected revision
+func (c *Client) DeleteDeviceServiceByName(name string, revision int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteDeviceServiceByName(conn, name)
+	edgeXerr := deleteDeviceServiceByName(conn, name, revision)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device service with name %s", name), edgeXerr)
 	}
@@ -183,6 +184,19 @@ func (c *Client) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return nil
 }
 
+// CascadeDeleteDeviceServiceByName deletes a device service by name together with its devices and provision watchers if it is at the
+// expected revision
+func (c *Client) CascadeDeleteDeviceServiceByName(name string, revision int64) ([]model.Device, []model.ProvisionWatcher, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, provisionWatchers, edgeXerr := cascadeDeleteDeviceServiceByName(conn, name, revision)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to cascade delete the device service with name %s", name), edgeXerr)
+	}
+	return devices, provisionWatchers, nil
+}
+
 // DeviceServiceNameExists checks the device service exists by name
 func (c *Client) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -190,11 +204,19 @@ func (c *Client) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return deviceServiceNameExist(conn, name)
 }
 
-// UpdateDeviceService updates a device service
-func (c *Client) UpdateDeviceService(ds model.DeviceService) errors.EdgeX {
+// UpdateDeviceService updates a device service if it is at the expected revision
+func (c *Client) UpdateDeviceService(ds model.DeviceService, revision int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateDeviceService(conn, ds, revision)
+}
+
+// UpdateDeviceServiceLastConnected updates the last connected time of a device service
+func (c *Client) UpdateDeviceServiceLastConnected(name string, lastConnected int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateDeviceService(conn, ds)
+
+	return updateDeviceServiceLastConnected(conn, name, lastConnected)
 }
 
 // DeviceProfileByName gets a device profile by name
@@ -223,12 +245,12 @@ func (c *Client) DeleteDeviceProfileById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceProfileByName deletes a device profile by name
-func (c *Client) DeleteDeviceProfileByName(name string) errors.EdgeX {
+// DeleteDeviceProfileByName deletes a device profile by name if it is at the expected revision
+func (c *Client) DeleteDeviceProfileByName(name string, revision int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteDeviceProfileByName(conn, name)
+	edgeXerr := deleteDeviceProfileByName(conn, name, revision)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device profile with name %s", name), edgeXerr)
 	}
@@ -236,6 +258,19 @@ func (c *Client) DeleteDeviceProfileByName(name string) errors.EdgeX {
 	return nil
 }
 
+// CascadeDeleteDeviceProfileByName deletes a device profile by name together with its devices and provision watchers if it is at the
+// expected revision
+func (c *Client) CascadeDeleteDeviceProfileByName(name string, revision int64) ([]model.Device, []model.ProvisionWatcher, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, provisionWatchers, edgeXerr := cascadeDeleteDeviceProfileByName(conn, name, revision)
+	if edgeXerr != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to cascade delete the device profile with name %s", name), edgeXerr)
+	}
+	return devices, provisionWatchers, nil
+}
+
 // AllDeviceProfiles query device profiles with offset and limit
 func (c *Client) AllDeviceProfiles(offset int, limit int, labels []string) ([]model.DeviceProfile, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -350,12 +385,12 @@ func (c *Client) DeleteDeviceById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceByName deletes a device by name
-func (c *Client) DeleteDeviceByName(name string) errors.EdgeX {
+// DeleteDeviceByName deletes a device by name if it is at the expected revision
+func (c *Client) DeleteDeviceByName(name string, revision int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteDeviceByName(conn, name)
+	edgeXerr := deleteDeviceByName(conn, name, revision)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device with name %s", name), edgeXerr)
 	}
@@ -437,12 +472,20 @@ func (c *Client) DevicesByProfileName(offset int, limit int, profileName string)
 	return devices, nil
 }
 
-// Update a device
-func (c *Client) UpdateDevice(d model.Device) errors.EdgeX {
+// Update a device if it is at the expected revision
+func (c *Client) UpdateDevice(d model.Device, revision int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return updateDevice(conn, d, revision)
+}
+
+// UpdateDeviceLastReported updates the last reported time of a device
+func (c *Client) UpdateDeviceLastReported(name string, lastReported int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return updateDevice(conn, d)
+	return updateDeviceLastReported(conn, name, lastReported)
 }
 
 // AllEvents query events by offset and limit
@@ -666,12 +709,12 @@ func (c *Client) AllProvisionWatchers(offset int, limit int, labels []string) (p
 	return
 }
 
-// DeleteProvisionWatcherByName deletes a provision watcher by name
-func (c *Client) DeleteProvisionWatcherByName(name string) errors.EdgeX {
+// DeleteProvisionWatcherByName deletes a provision watcher by name if it is at the expected revision
+func (c *Client) DeleteProvisionWatcherByName(name string, revision int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteProvisionWatcherByName(conn, name)
+	edgeXerr := deleteProvisionWatcherByName(conn, name, revision)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("failed to delete the provision watcher with name %s", name), edgeXerr)
 	}
@@ -679,12 +722,98 @@ func (c *Client) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	return nil
 }
 
-// Update a provision watcher
-func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher) errors.EdgeX {
+// Update a provision watcher if it is at the expected revision
+func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher, revision int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return updateProvisionWatcher(conn, pw, revision)
+}
+
+// Revision returns the current revision of a metadata entity by id
+func (c *Client) Revision(id string) (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return revision(conn, id)
+}
+
+// SearchMetadata queries the devices, device profiles and device services of the given types whose name starts with
+// the prefix or which match all the terms with offset and limit, and returns the total count of the matched entities as well
+func (c *Client) SearchMetadata(namePrefix string, terms []string, types []pkgModels.SearchEntityType, offset int, limit int) ([]pkgModels.SearchHit, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	hits, totalCount, edgeXerr := searchMetadata(conn, namePrefix, terms, types, offset, limit)
+	if edgeXerr != nil {
+		return hits, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return hits, totalCount, nil
+}
+
+// EnsureSearchIndex indexes the devices, device profiles and device services stored before the search was introduced
+// and returns the count of the indexed entities, nothing is done if the index already exists
+func (c *Client) EnsureSearchIndex() (int, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return updateProvisionWatcher(conn, pw)
+	indexed, edgeXerr := ensureSearchIndex(conn)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return indexed, nil
+}
+
+// DevicesWithinRadius queries the devices located within the radius in meters of the center by offset and limit,
+// nearest first, and returns the total count of the devices within the radius as well
+func (c *Client) DevicesWithinRadius(latitude float64, longitude float64, radius float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, totalCount, edgeXerr := devicesWithinRadius(conn, latitude, longitude, radius, offset, limit)
+	if edgeXerr != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return devices, totalCount, nil
+}
+
+// DevicesWithinBoundingBox queries the devices located within the bounding box by offset and limit, nearest to the
+// center of the box first, and returns the total count of the devices within the box as well
+func (c *Client) DevicesWithinBoundingBox(south float64, west float64, north float64, east float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, totalCount, edgeXerr := devicesWithinBoundingBox(conn, south, west, north, east, offset, limit)
+	if edgeXerr != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return devices, totalCount, nil
+}
+
+// DevicesBySite queries the devices located at the site by offset and limit
+func (c *Client) DevicesBySite(offset int, limit int, site string) ([]model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr := devicesBySite(conn, site, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and site %s", offset, limit, site), edgeXerr)
+	}
+	return devices, nil
+}
+
+// EnsureDeviceGeoIndex indexes the structured locations of the devices stored before the geo indexes were introduced
+// and returns the count of the indexed devices, nothing is done if the index already exists
+func (c *Client) EnsureDeviceGeoIndex() (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	indexed, edgeXerr := ensureDeviceGeoIndex(conn)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return indexed, nil
 }
 
 // AddInterval adds a new interval
@@ -1151,3 +1280,660 @@ func (c *Client) TransmissionsBySubscriptionName(offset int, limit int, subscrip
 	}
 	return transmissions, nil
 }
+
+// AddDeviceServiceCallback adds a new device service callback into the callback outbox
+func (c *Client) AddDeviceServiceCallback(cb pkgModels.DeviceServiceCallback) (pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(cb.Id) == 0 {
+		cb.Id = uuid.New().String()
+	}
+
+	return addDeviceServiceCallback(conn, cb)
+}
+
+// UpdateDeviceServiceCallback updates a device service callback
+func (c *Client) UpdateDeviceServiceCallback(cb pkgModels.DeviceServiceCallback) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateDeviceServiceCallback(conn, cb)
+}
+
+// DeviceServiceCallbackById gets a device service callback by id
+func (c *Client) DeviceServiceCallbackById(id string) (cb pkgModels.DeviceServiceCallback, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	cb, edgeXerr = deviceServiceCallbackById(conn, id)
+	if edgeXerr != nil {
+		return cb, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device service callback by id %s", id), edgeXerr)
+	}
+	return
+}
+
+// DeleteDeviceServiceCallbackById deletes a device service callback by id
+func (c *Client) DeleteDeviceServiceCallbackById(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceServiceCallbackById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device service callback with id %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// AllDeviceServiceCallbacks queries device service callbacks by offset and limit
+func (c *Client) AllDeviceServiceCallbacks(offset int, limit int) ([]pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	callbacks, edgeXerr := allDeviceServiceCallbacks(conn, offset, limit)
+	if edgeXerr != nil {
+		return callbacks, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return callbacks, nil
+}
+
+// DeviceServiceCallbacksByStatus queries device service callbacks by offset, limit and status
+func (c *Client) DeviceServiceCallbacksByStatus(offset int, limit int, status string) ([]pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	callbacks, edgeXerr := deviceServiceCallbacksByStatus(conn, offset, limit, status)
+	if edgeXerr != nil {
+		return callbacks, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device service callbacks by offset %d, limit %d and status %s", offset, limit, status), edgeXerr)
+	}
+	return callbacks, nil
+}
+
+// DeviceServiceCallbacksByServiceName queries device service callbacks by offset, limit and device service name
+func (c *Client) DeviceServiceCallbacksByServiceName(offset int, limit int, name string) ([]pkgModels.DeviceServiceCallback, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	callbacks, edgeXerr := deviceServiceCallbacksByServiceName(conn, offset, limit, name)
+	if edgeXerr != nil {
+		return callbacks, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device service callbacks by offset %d, limit %d and service name %s", offset, limit, name), edgeXerr)
+	}
+	return callbacks, nil
+}
+
+// DeviceServiceHealthByName gets the health record of a device service
+func (c *Client) DeviceServiceHealthByName(name string) (health pkgModels.DeviceServiceHealth, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	health, edgeXerr = deviceServiceHealthByName(conn, name)
+	if edgeXerr != nil {
+		return health, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the health of device service %s", name), edgeXerr)
+	}
+	return
+}
+
+// UpdateDeviceServiceHealth adds or replaces the health record of a device service
+func (c *Client) UpdateDeviceServiceHealth(health pkgModels.DeviceServiceHealth) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return updateDeviceServiceHealth(conn, health)
+}
+
+// AllDeviceServiceHealth queries the health records of device services by offset and limit
+func (c *Client) AllDeviceServiceHealth(offset int, limit int) ([]pkgModels.DeviceServiceHealth, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	health, edgeXerr := allDeviceServiceHealth(conn, offset, limit)
+	if edgeXerr != nil {
+		return health, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return health, nil
+}
+
+// AddDiscoveredDevice adds a discovered device to the approval queue
+func (c *Client) AddDiscoveredDevice(d pkgModels.DiscoveredDevice) (pkgModels.DiscoveredDevice, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(d.Id) == 0 {
+		d.Id = uuid.New().String()
+	}
+
+	return addDiscoveredDevice(conn, d)
+}
+
+// UpdateDiscoveredDevice updates a discovered device
+func (c *Client) UpdateDiscoveredDevice(d pkgModels.DiscoveredDevice) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateDiscoveredDevice(conn, d)
+}
+
+// DiscoveredDeviceById gets a discovered device by id
+func (c *Client) DiscoveredDeviceById(id string) (d pkgModels.DiscoveredDevice, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	d, edgeXerr = discoveredDeviceById(conn, id)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query discovered device by id %s", id), edgeXerr)
+	}
+	return
+}
+
+// DiscoveredDeviceByName gets a discovered device by name
+func (c *Client) DiscoveredDeviceByName(name string) (d pkgModels.DiscoveredDevice, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	d, edgeXerr = discoveredDeviceByName(conn, name)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query discovered device by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// DeleteDiscoveredDeviceById deletes a discovered device by id
+func (c *Client) DeleteDiscoveredDeviceById(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDiscoveredDeviceById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the discovered device with id %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// AllDiscoveredDevices queries discovered devices by offset and limit
+func (c *Client) AllDiscoveredDevices(offset int, limit int) ([]pkgModels.DiscoveredDevice, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr := allDiscoveredDevices(conn, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return devices, nil
+}
+
+// DiscoveredDevicesByStatus queries discovered devices by offset, limit and status
+func (c *Client) DiscoveredDevicesByStatus(offset int, limit int, status string) ([]pkgModels.DiscoveredDevice, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr := discoveredDevicesByStatus(conn, offset, limit, status)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query discovered devices by offset %d, limit %d and status %s", offset, limit, status), edgeXerr)
+	}
+	return devices, nil
+}
+
+// AddDeviceTemplate adds a new device template
+func (c *Client) AddDeviceTemplate(t pkgModels.DeviceTemplate) (pkgModels.DeviceTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	return addDeviceTemplate(conn, t)
+}
+
+// DeviceTemplateByName gets a device template by name
+func (c *Client) DeviceTemplateByName(name string) (template pkgModels.DeviceTemplate, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr = deviceTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device template by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// DeleteDeviceTemplateByName deletes a device template by name
+func (c *Client) DeleteDeviceTemplateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device template with name %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AllDeviceTemplates queries device templates by offset and limit
+func (c *Client) AllDeviceTemplates(offset int, limit int) ([]pkgModels.DeviceTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	templates, edgeXerr := allDeviceTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return templates, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return templates, nil
+}
+
+// AddAuditRecord adds a new audit record
+func (c *Client) AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return addAuditRecord(conn, r)
+}
+
+// AuditRecordsByService queries the audit records recorded by the service with offset and limit
+func (c *Client) AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := auditRecordsByService(conn, service, offset, limit)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query audit records by service %s", service), edgeXerr)
+	}
+	return records, nil
+}
+
+// AuditRecordsByTimeRange queries the audit records recorded by the service within the time range with offset and limit
+func (c *Client) AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := auditRecordsByTimeRange(conn, service, start, end, offset, limit)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query audit records by service %s and time range %v ~ %v", service, start, end), edgeXerr)
+	}
+	return records, nil
+}
+
+// AuditRecordsByEntity queries the audit records of an entity with offset and limit
+func (c *Client) AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := auditRecordsByEntity(conn, entityType, name, offset, limit)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query audit records by %s %s", entityType, name), edgeXerr)
+	}
+	return records, nil
+}
+
+// AuditRecordsByActor queries the audit records of the changes made by the actor through the service with offset and limit
+func (c *Client) AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := auditRecordsByActor(conn, service, actor, offset, limit)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query audit records by actor %s", actor), edgeXerr)
+	}
+	return records, nil
+}
+
+// DeleteAuditRecordsByAge deletes the audit records recorded by the service which are older than age
+func (c *Client) DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := deleteAuditRecordsByAge(conn, service, age)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete audit records older than %d ms", age), edgeXerr)
+	}
+	return count, nil
+}
+
+// AddCommandJob adds a new command job
+func (c *Client) AddCommandJob(job pkgModels.CommandJob) (pkgModels.CommandJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(job.Id) == 0 {
+		job.Id = uuid.New().String()
+	}
+
+	return addCommandJob(conn, job)
+}
+
+// UpdateCommandJob updates a command job
+func (c *Client) UpdateCommandJob(job pkgModels.CommandJob) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return updateCommandJob(conn, job)
+}
+
+// CancelCommandJob stores the cancelled command job if it's still pending or running
+func (c *Client) CancelCommandJob(job pkgModels.CommandJob) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return cancelCommandJob(conn, job)
+}
+
+// CommandJobById gets a command job by id
+func (c *Client) CommandJobById(id string) (job pkgModels.CommandJob, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	job, edgeXerr = commandJobById(conn, id)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command job by id %s", id), edgeXerr)
+	}
+	return
+}
+
+// AllCommandJobs queries command jobs by offset and limit
+func (c *Client) AllCommandJobs(offset int, limit int) ([]pkgModels.CommandJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	jobs, edgeXerr := allCommandJobs(conn, offset, limit)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return jobs, nil
+}
+
+// CommandJobsByStatus queries command jobs by offset, limit and status
+func (c *Client) CommandJobsByStatus(offset int, limit int, status string) ([]pkgModels.CommandJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	jobs, edgeXerr := commandJobsByStatus(conn, offset, limit, status)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command jobs by status %s", status), edgeXerr)
+	}
+	return jobs, nil
+}
+
+// DeleteCommandJobsByAge deletes the completed command jobs which are older than age
+func (c *Client) DeleteCommandJobsByAge(age int64) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := deleteCommandJobsByAge(conn, age)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete command jobs older than %d ms", age), edgeXerr)
+	}
+	return count, nil
+}
+
+// AddCommandRecord adds a new command record
+func (c *Client) AddCommandRecord(record pkgModels.CommandRecord) (pkgModels.CommandRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(record.Id) == 0 {
+		record.Id = uuid.New().String()
+	}
+
+	return addCommandRecord(conn, record)
+}
+
+// CommandRecordById gets a command record by id
+func (c *Client) CommandRecordById(id string) (record pkgModels.CommandRecord, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	record, edgeXerr = commandRecordById(conn, id)
+	if edgeXerr != nil {
+		return record, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command record by id %s", id), edgeXerr)
+	}
+	return
+}
+
+// CommandRecordsByDeviceName queries command records by offset, limit and device name
+func (c *Client) CommandRecordsByDeviceName(offset int, limit int, name string) ([]pkgModels.CommandRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := commandRecordsByDeviceName(conn, offset, limit, name)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command records by device name %s", name), edgeXerr)
+	}
+	return records, nil
+}
+
+// DeleteCommandRecordsByAge deletes the command records which are older than age
+func (c *Client) DeleteCommandRecordsByAge(age int64) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := deleteCommandRecordsByAge(conn, age)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete command records older than %d ms", age), edgeXerr)
+	}
+	return count, nil
+}
+
+// AddRecipe adds a new recipe
+func (c *Client) AddRecipe(r pkgModels.Recipe) (pkgModels.Recipe, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return addRecipe(conn, r)
+}
+
+// RecipeByName gets a recipe by name
+func (c *Client) RecipeByName(name string) (recipe pkgModels.Recipe, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	recipe, edgeXerr = recipeByName(conn, name)
+	if edgeXerr != nil {
+		return recipe, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query recipe by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// DeleteRecipeByName deletes a recipe by name
+func (c *Client) DeleteRecipeByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteRecipeByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the recipe with name %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AllRecipes queries recipes by offset and limit
+func (c *Client) AllRecipes(offset int, limit int) ([]pkgModels.Recipe, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	recipes, edgeXerr := allRecipes(conn, offset, limit)
+	if edgeXerr != nil {
+		return recipes, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return recipes, nil
+}
+
+// AddRecipeRun adds a new recipe run
+func (c *Client) AddRecipeRun(run pkgModels.RecipeRun) (pkgModels.RecipeRun, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(run.Id) == 0 {
+		run.Id = uuid.New().String()
+	}
+
+	return addRecipeRun(conn, run)
+}
+
+// UpdateRecipeRun updates a recipe run
+func (c *Client) UpdateRecipeRun(run pkgModels.RecipeRun) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return updateRecipeRun(conn, run)
+}
+
+// RecipeRunById gets a recipe run by id
+func (c *Client) RecipeRunById(id string) (run pkgModels.RecipeRun, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	run, edgeXerr = recipeRunById(conn, id)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query recipe run by id %s", id), edgeXerr)
+	}
+	return
+}
+
+// RecipeRunsByRecipeName queries recipe runs by offset, limit and recipe name
+func (c *Client) RecipeRunsByRecipeName(offset int, limit int, name string) ([]pkgModels.RecipeRun, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	runs, edgeXerr := recipeRunsByRecipeName(conn, offset, limit, name)
+	if edgeXerr != nil {
+		return runs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query recipe runs by recipe name %s", name), edgeXerr)
+	}
+	return runs, nil
+}
+
+// RecipeRunsByStatus queries recipe runs by offset, limit and status
+func (c *Client) RecipeRunsByStatus(offset int, limit int, status string) ([]pkgModels.RecipeRun, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	runs, edgeXerr := recipeRunsByStatus(conn, offset, limit, status)
+	if edgeXerr != nil {
+		return runs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query recipe runs by status %s", status), edgeXerr)
+	}
+	return runs, nil
+}
+
+// DeleteRecipeRunsByAge deletes the recipe runs which are older than age
+func (c *Client) DeleteRecipeRunsByAge(age int64) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := deleteRecipeRunsByAge(conn, age)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete recipe runs older than %d ms", age), edgeXerr)
+	}
+	return count, nil
+}
+
+// AddSubscriptionTemplate adds a new subscription template
+func (c *Client) AddSubscriptionTemplate(t pkgModels.SubscriptionTemplate) (pkgModels.SubscriptionTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	return addSubscriptionTemplate(conn, t)
+}
+
+// SubscriptionTemplateBySubscriptionName gets the template of the subscription
+func (c *Client) SubscriptionTemplateBySubscriptionName(name string) (template pkgModels.SubscriptionTemplate, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr = subscriptionTemplateBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the template of subscription %s", name), edgeXerr)
+	}
+	return
+}
+
+// DeleteSubscriptionTemplateBySubscriptionName deletes the template of the subscription
+func (c *Client) DeleteSubscriptionTemplateBySubscriptionName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteSubscriptionTemplateBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the template of subscription %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AllSubscriptionTemplates queries subscription templates by offset and limit
+func (c *Client) AllSubscriptionTemplates(offset int, limit int) ([]pkgModels.SubscriptionTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	templates, edgeXerr := allSubscriptionTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return templates, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return templates, nil
+}
+
+// AddSubscriptionDigest adds a new subscription digest
+func (c *Client) AddSubscriptionDigest(d pkgModels.SubscriptionDigest) (pkgModels.SubscriptionDigest, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(d.Id) == 0 {
+		d.Id = uuid.New().String()
+	}
+
+	return addSubscriptionDigest(conn, d)
+}
+
+// SubscriptionDigestBySubscriptionName gets the digest of the subscription
+func (c *Client) SubscriptionDigestBySubscriptionName(name string) (digest pkgModels.SubscriptionDigest, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	digest, edgeXerr = subscriptionDigestBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return digest, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the digest of subscription %s", name), edgeXerr)
+	}
+	return
+}
+
+// UpdateSubscriptionDigest updates the pending notifications of the subscription digest
+func (c *Client) UpdateSubscriptionDigest(d pkgModels.SubscriptionDigest) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return updateSubscriptionDigest(conn, d)
+}
+
+// DeleteSubscriptionDigestBySubscriptionName deletes the digest of the subscription
+func (c *Client) DeleteSubscriptionDigestBySubscriptionName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteSubscriptionDigestBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the digest of subscription %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AllSubscriptionDigests queries subscription digests by offset and limit
+func (c *Client) AllSubscriptionDigests(offset int, limit int) ([]pkgModels.SubscriptionDigest, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	digests, edgeXerr := allSubscriptionDigests(conn, offset, limit)
+	if edgeXerr != nil {
+		return digests, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return digests, nil
+}
@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	CommandJobCollection       = "cmd|job"
+	CommandJobCollectionStatus = CommandJobCollection + DBKeySeparator + common.Status
+)
+
+// commandJobStoredKey return the command job's stored key which combines the collection name and object id
+func commandJobStoredKey(id string) string {
+	return CreateKey(CommandJobCollection, id)
+}
+
+// sendAddCommandJobCmd sends redis command for adding command job
+func sendAddCommandJobCmd(conn redis.Conn, storedKey string, job models.CommandJob) errors.EdgeX {
+	m, err := json.Marshal(job)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal command job for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CommandJobCollection, job.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(CommandJobCollectionStatus, string(job.Status)), job.Created, storedKey)
+	return nil
+}
+
+// sendDeleteCommandJobCmd sends redis command for deleting command job
+func sendDeleteCommandJobCmd(conn redis.Conn, storedKey string, job models.CommandJob) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, CommandJobCollection, storedKey)
+	_ = conn.Send(ZREM, CreateKey(CommandJobCollectionStatus, string(job.Status)), storedKey)
+}
+
+// addCommandJob adds a new command job into DB
+func addCommandJob(conn redis.Conn, job models.CommandJob) (models.CommandJob, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, commandJobStoredKey(job.Id))
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return job, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command job id %s already exists", job.Id), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if job.Created == 0 {
+		job.Created = ts
+	}
+	job.Modified = ts
+
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddCommandJobCmd(conn, commandJobStoredKey(job.Id), job)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindDatabaseError, "command job creation failed", err)
+	}
+	return job, nil
+}
+
+// commandJobById query command job by id from DB
+func commandJobById(conn redis.Conn, id string) (job models.CommandJob, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, commandJobStoredKey(id), &job)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateCommandJob updates a command job
+func updateCommandJob(conn redis.Conn, job models.CommandJob) errors.EdgeX {
+	oldJob, edgeXerr := commandJobById(conn, job.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	// the jobs are enumerated in the order they were created, which should never be changed
+	job.Created = oldJob.Created
+	job.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := commandJobStoredKey(job.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteCommandJobCmd(conn, storedKey, oldJob)
+	edgeXerr = sendAddCommandJobCmd(conn, storedKey, job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command job update failed", err)
+	}
+	return nil
+}
+
+// cancelCommandJob stores the cancelled command job only if the stored one is still pending or running. The stored job
+// is watched, so a job completed in the meantime is reported as a conflict instead of being overwritten as cancelled.
+func cancelCommandJob(conn redis.Conn, job models.CommandJob) errors.EdgeX {
+	storedKey := commandJobStoredKey(job.Id)
+	edgeXerr := watch(conn, storedKey)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	oldJob, edgeXerr := commandJobById(conn, job.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if oldJob.IsCompleted() {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("command job %s is %s already", job.Id, oldJob.Status), nil)
+	}
+	job.Created = oldJob.Created
+	job.Modified = pkgCommon.MakeTimestamp()
+
+	_ = conn.Send(MULTI)
+	sendDeleteCommandJobCmd(conn, storedKey, oldJob)
+	edgeXerr = sendAddCommandJobCmd(conn, storedKey, job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command job cancellation failed", err)
+	}
+	if reply == nil {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("command job %s cancellation failed, the job was changed concurrently", job.Id), nil)
+	}
+	return nil
+}
+
+// allCommandJobs queries command jobs by offset and limit, newest first
+func allCommandJobs(conn redis.Conn, offset int, limit int) ([]models.CommandJob, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CommandJobCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToCommandJobs(objects)
+}
+
+// commandJobsByStatus queries command jobs by offset, limit and status, newest first
+func commandJobsByStatus(conn redis.Conn, offset int, limit int, status string) ([]models.CommandJob, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(CommandJobCollectionStatus, status), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToCommandJobs(objects)
+}
+
+// deleteCommandJobsByAge deletes the completed command jobs which are older than age and returns the count of the
+// deleted jobs, the jobs which are still pending or running are kept no matter how old they are
+func deleteCommandJobsByAge(conn redis.Conn, age int64) (int, errors.EdgeX) {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Values(conn.Do(ZRANGEBYSCORE, CommandJobCollection, InfiniteMin, expireTimestamp))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the command jobs older than %d ms", age), err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, storedKeys)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	jobs, edgeXerr := convertObjectsToCommandJobs(objects)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	count := 0
+	_ = conn.Send(MULTI)
+	for _, job := range jobs {
+		if !job.IsCompleted() {
+			continue
+		}
+		sendDeleteCommandJobCmd(conn, commandJobStoredKey(job.Id), job)
+		count++
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "command jobs deletion failed", err)
+	}
+	return count, nil
+}
+
+func convertObjectsToCommandJobs(objects [][]byte) (jobs []models.CommandJob, edgeXerr errors.EdgeX) {
+	jobs = make([]models.CommandJob, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &jobs[i])
+		if err != nil {
+			return []models.CommandJob{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "command job format parsing failed from the database", err)
+		}
+	}
+	return jobs, nil
+}
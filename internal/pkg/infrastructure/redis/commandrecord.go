@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	CommandRecordCollection       = "cmd|rec"
+	CommandRecordCollectionDevice = CommandRecordCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
+)
+
+// commandRecordStoredKey return the command record's stored key which combines the collection name and object id
+func commandRecordStoredKey(id string) string {
+	return CreateKey(CommandRecordCollection, id)
+}
+
+// addCommandRecord adds a new command record into DB
+func addCommandRecord(conn redis.Conn, record models.CommandRecord) (models.CommandRecord, errors.EdgeX) {
+	ts := pkgCommon.MakeTimestamp()
+	if record.Created == 0 {
+		record.Created = ts
+	}
+	record.Modified = ts
+
+	m, err := json.Marshal(record)
+	if err != nil {
+		return record, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal command record for Redis persistence", err)
+	}
+	storedKey := commandRecordStoredKey(record.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CommandRecordCollection, record.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(CommandRecordCollectionDevice, record.DeviceName), record.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return record, errors.NewCommonEdgeX(errors.KindDatabaseError, "command record creation failed", err)
+	}
+	return record, nil
+}
+
+// commandRecordById query command record by id from DB
+func commandRecordById(conn redis.Conn, id string) (record models.CommandRecord, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, commandRecordStoredKey(id), &record)
+	if edgeXerr != nil {
+		return record, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// commandRecordsByDeviceName queries command records by offset, limit and device name, newest first
+func commandRecordsByDeviceName(conn redis.Conn, offset int, limit int, name string) ([]models.CommandRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(CommandRecordCollectionDevice, name), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToCommandRecords(objects)
+}
+
+// deleteCommandRecordsByAge deletes the command records which are older than age and returns the count of the deleted
+// records
+func deleteCommandRecordsByAge(conn redis.Conn, age int64) (int, errors.EdgeX) {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Values(conn.Do(ZRANGEBYSCORE, CommandRecordCollection, InfiniteMin, expireTimestamp))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the command records older than %d ms", age), err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, storedKeys)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	records, edgeXerr := convertObjectsToCommandRecords(objects)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, record := range records {
+		storedKey := commandRecordStoredKey(record.Id)
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, CommandRecordCollection, storedKey)
+		_ = conn.Send(ZREM, CreateKey(CommandRecordCollectionDevice, record.DeviceName), storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "command records deletion failed", err)
+	}
+	return len(records), nil
+}
+
+func convertObjectsToCommandRecords(objects [][]byte) (records []models.CommandRecord, edgeXerr errors.EdgeX) {
+	records = make([]models.CommandRecord, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &records[i])
+		if err != nil {
+			return []models.CommandRecord{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "command record format parsing failed from the database", err)
+		}
+	}
+	return records, nil
+}
@@ -33,6 +33,15 @@ const (
 	LIMIT            = "LIMIT"
 	ZUNIONSTORE      = "ZUNIONSTORE"
 	ZINTERSTORE      = "ZINTERSTORE"
+	INCR             = "INCR"
+	WATCH            = "WATCH"
+	ZRANGEBYLEX      = "ZRANGEBYLEX"
+	SMEMBERS         = "SMEMBERS"
+	GEOADD           = "GEOADD"
+	GEORADIUS        = "GEORADIUS"
+	WITHDIST         = "WITHDIST"
+	WITHCOORD        = "WITHCOORD"
+	ASC              = "ASC"
 )
 
 const (
@@ -40,4 +49,5 @@ const (
 	InfiniteMax     = "+inf"
 	GreaterThanZero = "(0"
 	DBKeySeparator  = ":"
+	GeoUnitMeter    = "m"
 )
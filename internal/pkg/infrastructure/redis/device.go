@@ -63,6 +63,8 @@ func sendAddDeviceCmd(conn redis.Conn, storedKey string, d models.Device) errors
 	for _, label := range d.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceCollectionLabel, label), d.Modified, storedKey)
 	}
+	sendAddSearchIndexCmd(conn, storedKey, d.Name, deviceSearchTerms(d))
+	sendAddDeviceLocationCmd(conn, storedKey, d)
 	return nil
 }
 
@@ -94,6 +96,7 @@ func addDevice(conn redis.Conn, d models.Device) (models.Device, errors.EdgeX) {
 	if edgeXerr != nil {
 		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendIncrRevisionCmd(conn, d.Id)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device creation failed", err)
@@ -133,12 +136,23 @@ func deleteDeviceById(conn redis.Conn, id string) errors.EdgeX {
 	return nil
 }
 
-// deleteDeviceByName deletes the device by name
-func deleteDeviceByName(conn redis.Conn, name string) errors.EdgeX {
+// deleteDeviceByName deletes the device by name if it is at the expected revision
+func deleteDeviceByName(conn redis.Conn, name string, revision int64) errors.EdgeX {
 	device, err := deviceByName(conn, name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if revision != pkgCommon.AnyRevision {
+		// read the device again once its revision is watched, so the deleted indexes can't be outdated
+		err = watchRevision(conn, device.Id, revision)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		device, err = deviceById(conn, device.Id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	err = deleteDevice(conn, device)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -156,6 +170,8 @@ func sendDeleteDeviceCmd(conn redis.Conn, storedKey string, device models.Device
 	for _, label := range device.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceCollectionLabel, label), storedKey)
 	}
+	sendDeleteSearchIndexCmd(conn, storedKey, device.Name, deviceSearchTerms(device))
+	sendDeleteDeviceLocationCmd(conn, storedKey, device)
 }
 
 // deleteDevice deletes a device
@@ -163,9 +179,10 @@ func deleteDevice(conn redis.Conn, device models.Device) errors.EdgeX {
 	storedKey := deviceStoredKey(device.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceCmd(conn, storedKey, device)
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device deletion failed", err)
+	sendDeleteRevisionCmd(conn, device.Id)
+	edgeXerr := execRevisioned(conn, "device deletion failed")
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 	return nil
 }
@@ -227,26 +244,81 @@ func devicesByProfileName(conn redis.Conn, offset int, limit int, profileName st
 	return devices, nil
 }
 
-func updateDevice(conn redis.Conn, d models.Device) errors.EdgeX {
-	oldDevice, edgexErr := deviceByName(conn, d.Name)
-	if edgexErr != nil {
-		return errors.NewCommonEdgeXWrapper(edgexErr)
-	}
+// updateDevice updates the device if it is at the expected revision, and increases the revision. The stored device is
+// watched along with the revision, since its last reported time is updated without increasing the revision, so the
+// update is retried instead of writing back an older last reported time.
+func updateDevice(conn redis.Conn, d models.Device, revision int64) errors.EdgeX {
+	storedKey := deviceStoredKey(d.Id)
+	var edgexErr errors.EdgeX
+	for attempt := 0; attempt < maxWatchedAttempts; attempt++ {
+		edgexErr = watchRevision(conn, d.Id, revision)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		edgexErr = watch(conn, storedKey)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		var oldDevice models.Device
+		oldDevice, edgexErr = deviceByName(conn, d.Name)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
 
-	ts := pkgCommon.MakeTimestamp()
-	d.Modified = ts
+		ts := pkgCommon.MakeTimestamp()
+		d.Modified = ts
+		// the last reported time is tracked separately and is never moved back by an update of the device
+		if oldDevice.LastReported > d.LastReported {
+			d.LastReported = oldDevice.LastReported
+		}
 
-	storedKey := deviceStoredKey(d.Id)
-	_ = conn.Send(MULTI)
-	sendDeleteDeviceCmd(conn, storedKey, oldDevice)
-	edgexErr = sendAddDeviceCmd(conn, storedKey, d)
-	if edgexErr != nil {
-		return errors.NewCommonEdgeXWrapper(edgexErr)
-	}
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device update failed", err)
+		_ = conn.Send(MULTI)
+		sendDeleteDeviceCmd(conn, storedKey, oldDevice)
+		edgexErr = sendAddDeviceCmd(conn, storedKey, d)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		sendIncrRevisionCmd(conn, d.Id)
+		edgexErr = execRevisioned(conn, "device update failed")
+		if edgexErr == nil {
+			return nil
+		} else if errors.Kind(edgexErr) != pkgCommon.KindRevisionMismatch {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		// the revision is checked again by the next attempt, which only goes on if the last reported time was changed
 	}
+	return errors.NewCommonEdgeXWrapper(edgexErr)
+}
 
-	return nil
+// updateDeviceLastReported updates the last reported time of the device without touching the other fields and the
+// modified timestamp, the time is ignored if it is older than the stored one. The stored device is watched, so the
+// update is retried on a concurrent change instead of overwriting it, and a device deleted meanwhile isn't written back.
+func updateDeviceLastReported(conn redis.Conn, name string, lastReported int64) errors.EdgeX {
+	device, edgeXerr := deviceByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := deviceStoredKey(device.Id)
+	for attempt := 0; attempt < maxWatchedAttempts; attempt++ {
+		edgeXerr = watch(conn, storedKey)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		var stored models.Device
+		edgeXerr = getObjectById(conn, storedKey, &stored)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if lastReported <= stored.LastReported {
+			return nil
+		}
+		stored.LastReported = lastReported
+		updated, edgeXerr := execWatchedSet(conn, storedKey, stored, "device last reported time update failed")
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if updated {
+			return nil
+		}
+	}
+	return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("device %s last reported time update failed, the device was modified concurrently", name), nil)
 }
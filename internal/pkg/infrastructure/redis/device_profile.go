@@ -63,6 +63,7 @@ func sendAddDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Device
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionLabel, label), dp.Modified, storedKey)
 	}
+	sendAddSearchIndexCmd(conn, storedKey, dp.Name, deviceProfileSearchTerms(dp))
 	return nil
 }
 
@@ -97,6 +98,7 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DevicePr
 	if edgeXerr != nil {
 		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendIncrRevisionCmd(conn, dp.Id)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile creation failed", err)
@@ -133,21 +135,23 @@ func sendDeleteDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Dev
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceProfileCollectionLabel, label), storedKey)
 	}
+	sendDeleteSearchIndexCmd(conn, storedKey, dp.Name, deviceProfileSearchTerms(dp))
 }
 
 func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX {
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceProfileCmd(conn, storedKey, dp)
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile deletion failed", err)
+	sendDeleteRevisionCmd(conn, dp.Id)
+	edgeXerr := execRevisioned(conn, "device profile deletion failed")
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 	return nil
 }
 
-// updateDeviceProfile updates a device profile to DB
-func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr errors.EdgeX) {
+// updateDeviceProfile updates a device profile to DB if it is at the expected revision, and increases the revision
+func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile, revision int64) (edgeXerr errors.EdgeX) {
 	var oldDeviceProfile models.DeviceProfile
 	oldDeviceProfile, edgeXerr = deviceProfileById(conn, dp.Id)
 	if edgeXerr == nil {
@@ -160,6 +164,17 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 			return errors.NewCommonEdgeXWrapper(edgeXerr)
 		}
 	}
+	if revision != pkgCommon.AnyRevision {
+		// read the device profile again once its revision is watched, so the replaced indexes can't be outdated
+		edgeXerr = watchRevision(conn, oldDeviceProfile.Id, revision)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		oldDeviceProfile, edgeXerr = deviceProfileById(conn, oldDeviceProfile.Id)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
 
 	dp.Id = oldDeviceProfile.Id
 	dp.Created = oldDeviceProfile.Created
@@ -172,9 +187,10 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile update failed", err)
+	sendIncrRevisionCmd(conn, dp.Id)
+	edgeXerr = execRevisioned(conn, "device profile update failed")
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
 	return nil
@@ -193,12 +209,23 @@ func deleteDeviceProfileById(conn redis.Conn, id string) errors.EdgeX {
 	return nil
 }
 
-// deleteDeviceProfileByName deletes the device profile by name
-func deleteDeviceProfileByName(conn redis.Conn, name string) errors.EdgeX {
+// deleteDeviceProfileByName deletes the device profile by name if it is at the expected revision
+func deleteDeviceProfileByName(conn redis.Conn, name string, revision int64) errors.EdgeX {
 	deviceProfile, err := deviceProfileByName(conn, name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if revision != pkgCommon.AnyRevision {
+		// read the device profile again once its revision is watched, so the deleted indexes can't be outdated
+		err = watchRevision(conn, deviceProfile.Id, revision)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		deviceProfile, err = deviceProfileById(conn, deviceProfile.Id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	err = deleteDeviceProfile(conn, deviceProfile)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -53,6 +53,7 @@ func sendAddDeviceServiceCmd(conn redis.Conn, storedKey string, ds models.Device
 	for _, label := range ds.Labels { // Store the redisKey into Sorted Set of labels with Modified as the score for order
 		_ = conn.Send(ZADD, CreateKey(DeviceServiceCollectionLabel, label), ds.Modified, storedKey)
 	}
+	sendAddSearchIndexCmd(conn, storedKey, ds.Name, deviceServiceSearchTerms(ds))
 	return nil
 }
 
@@ -91,6 +92,7 @@ func addDeviceService(conn redis.Conn, ds models.DeviceService) (addedDeviceServ
 	if edgeXerr != nil {
 		return ds, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendIncrRevisionCmd(conn, ds.Id)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device service creation failed", err)
@@ -125,15 +127,18 @@ func sendDeleteDeviceServiceCmd(conn redis.Conn, storedKey string, ds models.Dev
 	for _, label := range ds.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceServiceCollectionLabel, label), storedKey)
 	}
+	sendDeleteSearchIndexCmd(conn, storedKey, ds.Name, deviceServiceSearchTerms(ds))
 }
 
 func deleteDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX {
 	storedKey := deviceServiceStoredKey(ds.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceServiceCmd(conn, storedKey, ds)
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service deletion failed", err)
+	sendDeleteDeviceServiceHealthCmd(conn, ds.Name)
+	sendDeleteRevisionCmd(conn, ds.Id)
+	edgeXerr := execRevisioned(conn, "device service deletion failed")
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 	return nil
 }
@@ -151,12 +156,23 @@ func deleteDeviceServiceById(conn redis.Conn, id string) errors.EdgeX {
 	return nil
 }
 
-// deleteDeviceServiceByName deletes the device service by name
-func deleteDeviceServiceByName(conn redis.Conn, name string) errors.EdgeX {
+// deleteDeviceServiceByName deletes the device service by name if it is at the expected revision
+func deleteDeviceServiceByName(conn redis.Conn, name string, revision int64) errors.EdgeX {
 	deviceService, err := deviceServiceByName(conn, name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if revision != pkgCommon.AnyRevision {
+		// read the device service again once its revision is watched, so the deleted indexes can't be outdated
+		err = watchRevision(conn, deviceService.Id, revision)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		deviceService, err = deviceServiceById(conn, deviceService.Id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	err = deleteDeviceService(conn, deviceService)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -183,13 +199,22 @@ func deviceServicesByLabels(conn redis.Conn, offset int, limit int, labels []str
 	return deviceServices, nil
 }
 
-func updateDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX {
+// updateDeviceService updates the device service if it is at the expected revision, and increases the revision
+func updateDeviceService(conn redis.Conn, ds models.DeviceService, revision int64) errors.EdgeX {
+	edgeXerr := watchRevision(conn, ds.Id, revision)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 	oldDeviceService, edgeXerr := deviceServiceByName(conn, ds.Name)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
 	ds.Modified = pkgCommon.MakeTimestamp()
+	// the last connected time is tracked separately and is never moved back by an update of the device service
+	if oldDeviceService.LastConnected > ds.LastConnected {
+		ds.LastConnected = oldDeviceService.LastConnected
+	}
 	storedKey := deviceServiceStoredKey(ds.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceServiceCmd(conn, storedKey, oldDeviceService)
@@ -197,10 +222,44 @@ func updateDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service update failed", err)
+	sendIncrRevisionCmd(conn, ds.Id)
+	edgeXerr = execRevisioned(conn, "device service update failed")
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
 	return nil
 }
+
+// updateDeviceServiceLastConnected updates the last connected time of the device service without touching the other
+// fields and the modified timestamp, the time is ignored if it is older than the stored one. The stored device service
+// is watched like updateDeviceLastReported does, so that neither a concurrent change nor a deletion is overwritten.
+func updateDeviceServiceLastConnected(conn redis.Conn, name string, lastConnected int64) errors.EdgeX {
+	ds, edgeXerr := deviceServiceByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := deviceServiceStoredKey(ds.Id)
+	for attempt := 0; attempt < maxWatchedAttempts; attempt++ {
+		edgeXerr = watch(conn, storedKey)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		var stored models.DeviceService
+		edgeXerr = getObjectById(conn, storedKey, &stored)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if lastConnected <= stored.LastConnected {
+			return nil
+		}
+		stored.LastConnected = lastConnected
+		updated, edgeXerr := execWatchedSet(conn, storedKey, stored, "device service last connected time update failed")
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if updated {
+			return nil
+		}
+	}
+	return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("device service %s last connected time update failed, the device service was modified concurrently", name), nil)
+}
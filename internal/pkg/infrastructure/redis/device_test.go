@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// heartbeatConn stores a single device, and reports a heartbeat of the device in the middle of the first transaction
+// so the transaction is aborted as the watched device is modified
+type heartbeatConn struct {
+	redis.Conn
+	t         *testing.T
+	device    models.Device
+	heartbeat int64
+	pending   []byte
+	execs     int
+}
+
+func (c *heartbeatConn) Do(commandName string, args ...interface{}) (interface{}, error) {
+	switch commandName {
+	case WATCH:
+		return "OK", nil
+	case HGET:
+		return []byte(deviceStoredKey(c.device.Id)), nil
+	case GET:
+		if args[0] == revisionStoredKey(c.device.Id) {
+			return nil, nil
+		}
+		bytes, err := json.Marshal(c.device)
+		require.NoError(c.t, err)
+		return bytes, nil
+	case EXEC:
+		c.execs++
+		if c.execs == 1 {
+			c.device.LastReported = c.heartbeat
+			return nil, nil
+		}
+		require.NoError(c.t, json.Unmarshal(c.pending, &c.device))
+		return []interface{}{}, nil
+	}
+	return nil, nil
+}
+
+func (c *heartbeatConn) Send(commandName string, args ...interface{}) error {
+	if commandName == SET && args[0] == deviceStoredKey(c.device.Id) {
+		c.pending = args[1].([]byte)
+	}
+	return nil
+}
+
+func TestUpdateDeviceKeepsConcurrentLastReported(t *testing.T) {
+	stored := models.Device{Id: exampleUUID, Name: "thermostat", Description: "old", LastReported: 1000}
+	conn := &heartbeatConn{t: t, device: stored, heartbeat: 2000}
+
+	patched := stored
+	patched.Description = "new"
+	err := updateDevice(conn, patched, pkgCommon.AnyRevision)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, conn.execs, "the update should be retried once the heartbeat aborts the transaction")
+	assert.Equal(t, "new", conn.device.Description)
+	assert.Equal(t, int64(2000), conn.device.LastReported, "the last reported time of the heartbeat should be kept")
+}
@@ -0,0 +1,248 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// The geo indexes of the devices with a structured location. DeviceCollectionGeo is a geo set of the devices' stored
+// keys, DeviceCollectionSite holds a sorted set of stored keys per site and DeviceCollectionGeoIndexed marks that the
+// devices stored before the geo indexes were introduced are indexed.
+const (
+	DeviceCollectionGeo        = DeviceCollection + DBKeySeparator + "geo"
+	DeviceCollectionSite       = DeviceCollection + DBKeySeparator + pkgModels.GeoSite
+	DeviceCollectionGeoIndexed = DeviceCollectionGeo + DBKeySeparator + "indexed"
+
+	// earthRadius is the radius in meters used by the geo commands of Redis
+	earthRadius = 6372797.560856
+	// geoMargin widens the radius queried for a bounding box to cover the imprecision of the geohashes
+	geoMargin = 1.0
+)
+
+// geoMember is a device found in the geo index
+type geoMember struct {
+	storedKey string
+	distance  float64
+	latitude  float64
+	longitude float64
+}
+
+// sendAddDeviceLocationCmd send redis command for indexing the device's structured location, the free-form
+// locations are not indexed
+func sendAddDeviceLocationCmd(conn redis.Conn, storedKey string, d models.Device) {
+	l, ok, err := pkgModels.ToGeoLocation(d.Location)
+	if err != nil || !ok {
+		return
+	}
+	_ = conn.Send(GEOADD, DeviceCollectionGeo, l.Longitude, l.Latitude, storedKey)
+	if l.Site != "" {
+		_ = conn.Send(ZADD, CreateKey(DeviceCollectionSite, l.Site), d.Modified, storedKey)
+	}
+}
+
+// sendDeleteDeviceLocationCmd send redis command for removing the device's structured location from the geo indexes
+func sendDeleteDeviceLocationCmd(conn redis.Conn, storedKey string, d models.Device) {
+	l, ok, err := pkgModels.ToGeoLocation(d.Location)
+	if err != nil || !ok {
+		return
+	}
+	_ = conn.Send(ZREM, DeviceCollectionGeo, storedKey)
+	if l.Site != "" {
+		_ = conn.Send(ZREM, CreateKey(DeviceCollectionSite, l.Site), storedKey)
+	}
+}
+
+// geoRadius queries the devices within the radius in meters of the center, nearest first
+func geoRadius(conn redis.Conn, latitude float64, longitude float64, radius float64) ([]geoMember, errors.EdgeX) {
+	replies, err := redis.Values(conn.Do(GEORADIUS, DeviceCollectionGeo, longitude, latitude, radius, GeoUnitMeter, WITHDIST, WITHCOORD, ASC))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the devices by location", err)
+	}
+	members := make([]geoMember, len(replies))
+	for i, reply := range replies {
+		// each reply holds the member, the distance and the longitude and latitude
+		values, err := redis.Values(reply, nil)
+		if err != nil || len(values) != 3 {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device location format parsing failed from the database", err)
+		}
+		coordinates, err := redis.Float64s(values[2], nil)
+		if err != nil || len(coordinates) != 2 {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device location format parsing failed from the database", err)
+		}
+		members[i].storedKey, _ = redis.String(values[0], nil)
+		members[i].distance, _ = redis.Float64(values[1], nil)
+		members[i].longitude = coordinates[0]
+		members[i].latitude = coordinates[1]
+	}
+	return members, nil
+}
+
+// nearbyDevices queries the devices of the members by offset and limit and returns them along with the total count
+// of the members
+func nearbyDevices(conn redis.Conn, members []geoMember, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	total := len(members)
+	if offset > total {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", total), nil)
+	}
+	end := total
+	if limit >= 0 && offset+limit < total {
+		end = offset + limit
+	}
+	members = members[offset:end]
+	if len(members) == 0 {
+		return []pkgModels.NearbyDevice{}, uint32(total), nil
+	}
+
+	storedKeys := make([]interface{}, len(members))
+	for i, m := range members {
+		storedKeys[i] = m.storedKey
+	}
+	objects, err := redis.ByteSlices(conn.Do(MGET, storedKeys...))
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "query objects from database failed", err)
+	}
+	devices := make([]pkgModels.NearbyDevice, 0, len(objects))
+	for i, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		d := models.Device{}
+		err = json.Unmarshal(obj, &d)
+		if err != nil {
+			return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices = append(devices, pkgModels.NearbyDevice{Device: d, Distance: members[i].distance})
+	}
+	return devices, uint32(total), nil
+}
+
+// devicesWithinRadius queries the devices within the radius in meters of the center by offset and limit, nearest first
+func devicesWithinRadius(conn redis.Conn, latitude float64, longitude float64, radius float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	members, edgeXerr := geoRadius(conn, latitude, longitude, radius)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nearbyDevices(conn, members, offset, limit)
+}
+
+// devicesWithinBoundingBox queries the devices within the bounding box by offset and limit, nearest to the center of
+// the box first. The box crosses the antimeridian if west is greater than east. Redis queries the circle around the
+// box whose members are then filtered by their coordinates.
+func devicesWithinBoundingBox(conn redis.Conn, south float64, west float64, north float64, east float64, offset int, limit int) ([]pkgModels.NearbyDevice, uint32, errors.EdgeX) {
+	latitude, longitude, radius := boundingCircle(south, west, north, east)
+	members, edgeXerr := geoRadius(conn, latitude, longitude, radius)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	inBox := make([]geoMember, 0, len(members))
+	for _, m := range members {
+		if withinBoundingBox(m.latitude, m.longitude, south, west, north, east) {
+			inBox = append(inBox, m)
+		}
+	}
+	return nearbyDevices(conn, inBox, offset, limit)
+}
+
+// devicesBySite queries the devices located at the site by offset and limit
+func devicesBySite(conn redis.Conn, site string, offset int, limit int) (devices []models.Device, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceCollectionSite, site), offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		d := models.Device{}
+		err := json.Unmarshal(in, &d)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = d
+	}
+	return devices, nil
+}
+
+// boundingCircle returns the center of the bounding box and the radius in meters of the circle covering it
+func boundingCircle(south float64, west float64, north float64, east float64) (latitude float64, longitude float64, radius float64) {
+	span := east - west
+	if span < 0 {
+		span += 360
+	}
+	latitude = (south + north) / 2
+	longitude = west + span/2
+	if longitude > 180 {
+		longitude -= 360
+	}
+	// the farthest point of the box boundary from the center is one of the corners or the middles of the edges
+	for _, lat := range []float64{south, latitude, north} {
+		for _, lon := range []float64{west, longitude, east} {
+			radius = math.Max(radius, geoDistance(latitude, longitude, lat, lon))
+		}
+	}
+	return latitude, longitude, radius + geoMargin
+}
+
+// withinBoundingBox checks whether the coordinates are within the bounding box
+func withinBoundingBox(latitude float64, longitude float64, south float64, west float64, north float64, east float64) bool {
+	if latitude < south || latitude > north {
+		return false
+	}
+	if west <= east {
+		return longitude >= west && longitude <= east
+	}
+	return longitude >= west || longitude <= east
+}
+
+// geoDistance returns the great-circle distance in meters between two points by the haversine formula as Redis does
+func geoDistance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	u := math.Sin((toRadians(lat2) - toRadians(lat1)) / 2)
+	v := math.Sin((toRadians(lon2) - toRadians(lon1)) / 2)
+	a := u*u + math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*v*v
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// ensureDeviceGeoIndex indexes the structured locations of the devices stored before the geo indexes were
+// introduced, which is done only once since the locations are indexed whenever the devices are added or updated
+// afterwards
+func ensureDeviceGeoIndex(conn redis.Conn) (indexed int, edgeXerr errors.EdgeX) {
+	exists, err := redis.Bool(conn.Do(EXISTS, DeviceCollectionGeoIndexed))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to check the device geo index", err)
+	} else if exists {
+		return 0, nil
+	}
+
+	devices, edgeXerr := devicesByLabels(conn, 0, -1, nil)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, d := range devices {
+		if _, ok, err := pkgModels.ToGeoLocation(d.Location); err == nil && ok {
+			sendAddDeviceLocationCmd(conn, deviceStoredKey(d.Id), d)
+			indexed++
+		}
+	}
+	_ = conn.Send(SET, DeviceCollectionGeoIndexed, pkgCommon.MakeTimestamp())
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "device geo index creation failed", err)
+	}
+	return indexed, nil
+}
@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoDistance(t *testing.T) {
+	// Montreal to Toronto is about 504 km
+	distance := geoDistance(45.5017, -73.5673, 43.6532, -79.3832)
+	assert.InDelta(t, 504000, distance, 2000)
+	assert.Zero(t, geoDistance(45.5017, -73.5673, 45.5017, -73.5673))
+}
+
+func TestBoundingCircle(t *testing.T) {
+	tests := []struct {
+		name              string
+		south, west       float64
+		north, east       float64
+		expectedLatitude  float64
+		expectedLongitude float64
+	}{
+		{"box", 45, -74, 46, -73, 45.5, -73.5},
+		{"box crossing the antimeridian", -20, 170, -10, -170, -15, 180},
+		{"box with the center beyond the antimeridian", -20, 175, -10, -165, -15, -175},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			latitude, longitude, radius := boundingCircle(testCase.south, testCase.west, testCase.north, testCase.east)
+			assert.InDelta(t, testCase.expectedLatitude, latitude, 1e-9)
+			assert.InDelta(t, testCase.expectedLongitude, longitude, 1e-9)
+			for _, corner := range [][2]float64{
+				{testCase.south, testCase.west}, {testCase.south, testCase.east},
+				{testCase.north, testCase.west}, {testCase.north, testCase.east},
+			} {
+				assert.GreaterOrEqual(t, radius, geoDistance(latitude, longitude, corner[0], corner[1]), "the circle shall cover the corner %v", corner)
+			}
+		})
+	}
+}
+
+func TestWithinBoundingBox(t *testing.T) {
+	tests := []struct {
+		name                string
+		latitude, longitude float64
+		south, west         float64
+		north, east         float64
+		expected            bool
+	}{
+		{"inside", 45.5, -73.5, 45, -74, 46, -73, true},
+		{"on the edge", 45, -74, 45, -74, 46, -73, true},
+		{"north of the box", 46.1, -73.5, 45, -74, 46, -73, false},
+		{"east of the box", 45.5, -72.9, 45, -74, 46, -73, false},
+		{"inside the box crossing the antimeridian", -15, 179, -20, 170, -10, -170, true},
+		{"inside the box crossing the antimeridian, west longitude", -15, -175, -20, 170, -10, -170, true},
+		{"outside the box crossing the antimeridian", -15, 0, -20, 170, -10, -170, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, withinBoundingBox(testCase.latitude, testCase.longitude, testCase.south, testCase.west, testCase.north, testCase.east))
+		})
+	}
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	DeviceServiceCallbackCollection            = "md|dscb"
+	DeviceServiceCallbackCollectionSequence    = DeviceServiceCallbackCollection + DBKeySeparator + "sequence"
+	DeviceServiceCallbackCollectionStatus      = DeviceServiceCallbackCollection + DBKeySeparator + common.Status
+	DeviceServiceCallbackCollectionServiceName = DeviceServiceCallbackCollection + DBKeySeparator + common.Service + DBKeySeparator + common.Name
+)
+
+// deviceServiceCallbackStoredKey return the device service callback's stored key which combines the collection name and object id
+func deviceServiceCallbackStoredKey(id string) string {
+	return CreateKey(DeviceServiceCallbackCollection, id)
+}
+
+// sendAddDeviceServiceCallbackCmd sends redis command for adding device service callback. The sequence is used as the
+// score of the sorted sets, so the callbacks are always enumerated in the order they were created.
+func sendAddDeviceServiceCallbackCmd(conn redis.Conn, storedKey string, cb models.DeviceServiceCallback) errors.EdgeX {
+	m, err := json.Marshal(cb)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device service callback for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceServiceCallbackCollection, cb.Sequence, storedKey)
+	_ = conn.Send(ZADD, CreateKey(DeviceServiceCallbackCollectionStatus, string(cb.Status)), cb.Sequence, storedKey)
+	_ = conn.Send(ZADD, CreateKey(DeviceServiceCallbackCollectionServiceName, cb.ServiceName), cb.Sequence, storedKey)
+	return nil
+}
+
+// sendDeleteDeviceServiceCallbackCmd sends redis command for deleting device service callback
+func sendDeleteDeviceServiceCallbackCmd(conn redis.Conn, storedKey string, cb models.DeviceServiceCallback) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceServiceCallbackCollection, storedKey)
+	_ = conn.Send(ZREM, CreateKey(DeviceServiceCallbackCollectionStatus, string(cb.Status)), storedKey)
+	_ = conn.Send(ZREM, CreateKey(DeviceServiceCallbackCollectionServiceName, cb.ServiceName), storedKey)
+}
+
+// addDeviceServiceCallback adds a new device service callback into DB
+func addDeviceServiceCallback(conn redis.Conn, cb models.DeviceServiceCallback) (models.DeviceServiceCallback, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceServiceCallbackStoredKey(cb.Id))
+	if edgeXerr != nil {
+		return cb, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return cb, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device service callback id %s already exists", cb.Id), edgeXerr)
+	}
+
+	sequence, err := redis.Int64(conn.Do(INCR, DeviceServiceCallbackCollectionSequence))
+	if err != nil {
+		return cb, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service callback sequence generation failed", err)
+	}
+	cb.Sequence = sequence
+
+	ts := pkgCommon.MakeTimestamp()
+	if cb.Created == 0 {
+		cb.Created = ts
+	}
+	cb.Modified = ts
+
+	storedKey := deviceServiceCallbackStoredKey(cb.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceServiceCallbackCmd(conn, storedKey, cb)
+	if edgeXerr != nil {
+		return cb, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device service callback creation failed", err)
+	}
+
+	return cb, edgeXerr
+}
+
+// deviceServiceCallbackById query device service callback by id from DB
+func deviceServiceCallbackById(conn redis.Conn, id string) (cb models.DeviceServiceCallback, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deviceServiceCallbackStoredKey(id), &cb)
+	if edgeXerr != nil {
+		return cb, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateDeviceServiceCallback updates a device service callback
+func updateDeviceServiceCallback(conn redis.Conn, cb models.DeviceServiceCallback) errors.EdgeX {
+	oldCallback, edgeXerr := deviceServiceCallbackById(conn, cb.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	// the position of the callback in the delivery order should never be changed
+	cb.Sequence = oldCallback.Sequence
+	cb.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := deviceServiceCallbackStoredKey(cb.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceServiceCallbackCmd(conn, storedKey, oldCallback)
+	edgeXerr = sendAddDeviceServiceCallbackCmd(conn, storedKey, cb)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service callback update failed", err)
+	}
+	return nil
+}
+
+// deleteDeviceServiceCallbackById deletes the device service callback by id
+func deleteDeviceServiceCallbackById(conn redis.Conn, id string) errors.EdgeX {
+	cb, edgeXerr := deviceServiceCallbackById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceServiceCallbackCmd(conn, deviceServiceCallbackStoredKey(cb.Id), cb)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service callback deletion failed", err)
+	}
+	return nil
+}
+
+// allDeviceServiceCallbacks queries device service callbacks by offset and limit in the delivery order
+func allDeviceServiceCallbacks(conn redis.Conn, offset int, limit int) ([]models.DeviceServiceCallback, errors.EdgeX) {
+	objects, edgeXerr := getObjectsBySomeRange(conn, ZRANGE, DeviceServiceCallbackCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objectsToDeviceServiceCallbacks(objects)
+}
+
+// deviceServiceCallbacksByStatus queries device service callbacks by offset, limit and status in the delivery order
+func deviceServiceCallbacksByStatus(conn redis.Conn, offset int, limit int, status string) ([]models.DeviceServiceCallback, errors.EdgeX) {
+	objects, edgeXerr := getObjectsBySomeRange(conn, ZRANGE, CreateKey(DeviceServiceCallbackCollectionStatus, status), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objectsToDeviceServiceCallbacks(objects)
+}
+
+// deviceServiceCallbacksByServiceName queries device service callbacks by offset, limit and service name in the delivery order
+func deviceServiceCallbacksByServiceName(conn redis.Conn, offset int, limit int, name string) ([]models.DeviceServiceCallback, errors.EdgeX) {
+	objects, edgeXerr := getObjectsBySomeRange(conn, ZRANGE, CreateKey(DeviceServiceCallbackCollectionServiceName, name), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objectsToDeviceServiceCallbacks(objects)
+}
+
+func objectsToDeviceServiceCallbacks(objects [][]byte) ([]models.DeviceServiceCallback, errors.EdgeX) {
+	callbacks := make([]models.DeviceServiceCallback, len(objects))
+	for i, o := range objects {
+		cb := models.DeviceServiceCallback{}
+		err := json.Unmarshal(o, &cb)
+		if err != nil {
+			return []models.DeviceServiceCallback{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service callback format parsing failed from the database", err)
+		}
+		callbacks[i] = cb
+	}
+	return callbacks, nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const DeviceServiceHealthCollection = "md|dshealth"
+
+// deviceServiceHealthStoredKey return the device service health's stored key which combines the collection name and
+// device service name, since there is at most one health record per device service
+func deviceServiceHealthStoredKey(serviceName string) string {
+	return CreateKey(DeviceServiceHealthCollection, serviceName)
+}
+
+// sendDeleteDeviceServiceHealthCmd sends redis command for deleting the health record of the device service
+func sendDeleteDeviceServiceHealthCmd(conn redis.Conn, serviceName string) {
+	storedKey := deviceServiceHealthStoredKey(serviceName)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceServiceHealthCollection, storedKey)
+}
+
+// deviceServiceHealthByName query the health record of the device service from DB
+func deviceServiceHealthByName(conn redis.Conn, serviceName string) (health models.DeviceServiceHealth, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deviceServiceHealthStoredKey(serviceName), &health)
+	if edgeXerr != nil {
+		return health, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateDeviceServiceHealth adds or replaces the health record of the device service. The records share the same
+// score, so they are enumerated in the order of the device service names.
+func updateDeviceServiceHealth(conn redis.Conn, health models.DeviceServiceHealth) errors.EdgeX {
+	ts := pkgCommon.MakeTimestamp()
+	if health.Created == 0 {
+		health.Created = ts
+	}
+	health.Modified = ts
+
+	m, err := json.Marshal(health)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device service health for Redis persistence", err)
+	}
+	storedKey := deviceServiceHealthStoredKey(health.ServiceName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceServiceHealthCollection, 0, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service health update failed", err)
+	}
+	return nil
+}
+
+// allDeviceServiceHealth queries the health records of the device services by offset and limit
+func allDeviceServiceHealth(conn redis.Conn, offset int, limit int) ([]models.DeviceServiceHealth, errors.EdgeX) {
+	objects, edgeXerr := getObjectsBySomeRange(conn, ZRANGE, DeviceServiceHealthCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	health := make([]models.DeviceServiceHealth, len(objects))
+	for i, o := range objects {
+		h := models.DeviceServiceHealth{}
+		err := json.Unmarshal(o, &h)
+		if err != nil {
+			return []models.DeviceServiceHealth{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service health format parsing failed from the database", err)
+		}
+		health[i] = h
+	}
+	return health, nil
+}
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	DeviceTemplateCollection     = "md|dt"
+	DeviceTemplateCollectionName = DeviceTemplateCollection + DBKeySeparator + common.Name
+)
+
+// deviceTemplateStoredKey return the device template's stored key which combines the collection name and object id
+func deviceTemplateStoredKey(id string) string {
+	return CreateKey(DeviceTemplateCollection, id)
+}
+
+// sendAddDeviceTemplateCmd sends redis command for adding device template
+func sendAddDeviceTemplateCmd(conn redis.Conn, storedKey string, t models.DeviceTemplate) errors.EdgeX {
+	m, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device template for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceTemplateCollection, t.Modified, storedKey)
+	_ = conn.Send(HSET, DeviceTemplateCollectionName, t.Name, storedKey)
+	return nil
+}
+
+// sendDeleteDeviceTemplateCmd sends redis command for deleting device template
+func sendDeleteDeviceTemplateCmd(conn redis.Conn, storedKey string, t models.DeviceTemplate) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceTemplateCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceTemplateCollectionName, t.Name)
+}
+
+// addDeviceTemplate adds a new device template into DB
+func addDeviceTemplate(conn redis.Conn, t models.DeviceTemplate) (models.DeviceTemplate, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceTemplateStoredKey(t.Id))
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template id %s already exists", t.Id), edgeXerr)
+	}
+	exists, edgeXerr = objectNameExists(conn, DeviceTemplateCollectionName, t.Name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template name %s already exists", t.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if t.Created == 0 {
+		t.Created = ts
+	}
+	t.Modified = ts
+
+	storedKey := deviceTemplateStoredKey(t.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceTemplateCmd(conn, storedKey, t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device template creation failed", err)
+	}
+
+	return t, edgeXerr
+}
+
+// deviceTemplateByName query device template by name from DB
+func deviceTemplateByName(conn redis.Conn, name string) (t models.DeviceTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceTemplateCollectionName, name, &t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// deleteDeviceTemplateByName deletes the device template by name
+func deleteDeviceTemplateByName(conn redis.Conn, name string) errors.EdgeX {
+	t, edgeXerr := deviceTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTemplateCmd(conn, deviceTemplateStoredKey(t.Id), t)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device template deletion failed", err)
+	}
+	return nil
+}
+
+// allDeviceTemplates queries device templates by offset and limit
+func allDeviceTemplates(conn redis.Conn, offset int, limit int) ([]models.DeviceTemplate, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceTemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	templates := make([]models.DeviceTemplate, len(objects))
+	for i, o := range objects {
+		t := models.DeviceTemplate{}
+		err := json.Unmarshal(o, &t)
+		if err != nil {
+			return []models.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device template format parsing failed from the database", err)
+		}
+		templates[i] = t
+	}
+	return templates, nil
+}
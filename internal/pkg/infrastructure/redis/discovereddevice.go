@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	DiscoveredDeviceCollection       = "md|dd"
+	DiscoveredDeviceCollectionName   = DiscoveredDeviceCollection + DBKeySeparator + common.Name
+	DiscoveredDeviceCollectionStatus = DiscoveredDeviceCollection + DBKeySeparator + common.Status
+)
+
+// discoveredDeviceStoredKey return the discovered device's stored key which combines the collection name and object id
+func discoveredDeviceStoredKey(id string) string {
+	return CreateKey(DiscoveredDeviceCollection, id)
+}
+
+// sendAddDiscoveredDeviceCmd sends redis command for adding discovered device
+func sendAddDiscoveredDeviceCmd(conn redis.Conn, storedKey string, d models.DiscoveredDevice) errors.EdgeX {
+	m, err := json.Marshal(d)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal discovered device for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DiscoveredDeviceCollection, d.Created, storedKey)
+	_ = conn.Send(HSET, DiscoveredDeviceCollectionName, d.Name, storedKey)
+	_ = conn.Send(ZADD, CreateKey(DiscoveredDeviceCollectionStatus, string(d.Status)), d.Created, storedKey)
+	return nil
+}
+
+// sendDeleteDiscoveredDeviceCmd sends redis command for deleting discovered device
+func sendDeleteDiscoveredDeviceCmd(conn redis.Conn, storedKey string, d models.DiscoveredDevice) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DiscoveredDeviceCollection, storedKey)
+	_ = conn.Send(HDEL, DiscoveredDeviceCollectionName, d.Name)
+	_ = conn.Send(ZREM, CreateKey(DiscoveredDeviceCollectionStatus, string(d.Status)), storedKey)
+}
+
+// addDiscoveredDevice adds a new discovered device into DB
+func addDiscoveredDevice(conn redis.Conn, d models.DiscoveredDevice) (models.DiscoveredDevice, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, discoveredDeviceStoredKey(d.Id))
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("discovered device id %s already exists", d.Id), edgeXerr)
+	}
+	exists, edgeXerr = objectNameExists(conn, DiscoveredDeviceCollectionName, d.Name)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("discovered device name %s already exists", d.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if d.Created == 0 {
+		d.Created = ts
+	}
+	d.Modified = ts
+
+	storedKey := discoveredDeviceStoredKey(d.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDiscoveredDeviceCmd(conn, storedKey, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "discovered device creation failed", err)
+	}
+
+	return d, edgeXerr
+}
+
+// discoveredDeviceById query discovered device by id from DB
+func discoveredDeviceById(conn redis.Conn, id string) (d models.DiscoveredDevice, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, discoveredDeviceStoredKey(id), &d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// discoveredDeviceByName query discovered device by name from DB
+func discoveredDeviceByName(conn redis.Conn, name string) (d models.DiscoveredDevice, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DiscoveredDeviceCollectionName, name, &d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateDiscoveredDevice updates a discovered device
+func updateDiscoveredDevice(conn redis.Conn, d models.DiscoveredDevice) errors.EdgeX {
+	oldDevice, edgeXerr := discoveredDeviceById(conn, d.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	d.Created = oldDevice.Created
+	d.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := discoveredDeviceStoredKey(d.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDiscoveredDeviceCmd(conn, storedKey, oldDevice)
+	edgeXerr = sendAddDiscoveredDeviceCmd(conn, storedKey, d)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "discovered device update failed", err)
+	}
+	return nil
+}
+
+// deleteDiscoveredDeviceById deletes the discovered device by id
+func deleteDiscoveredDeviceById(conn redis.Conn, id string) errors.EdgeX {
+	d, edgeXerr := discoveredDeviceById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_ = conn.Send(MULTI)
+	sendDeleteDiscoveredDeviceCmd(conn, discoveredDeviceStoredKey(d.Id), d)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "discovered device deletion failed", err)
+	}
+	return nil
+}
+
+// allDiscoveredDevices queries discovered devices by offset and limit in the order they were discovered
+func allDiscoveredDevices(conn redis.Conn, offset int, limit int) ([]models.DiscoveredDevice, errors.EdgeX) {
+	objects, edgeXerr := getObjectsBySomeRange(conn, ZRANGE, DiscoveredDeviceCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objectsToDiscoveredDevices(objects)
+}
+
+// discoveredDevicesByStatus queries discovered devices by offset, limit and status in the order they were discovered
+func discoveredDevicesByStatus(conn redis.Conn, offset int, limit int, status string) ([]models.DiscoveredDevice, errors.EdgeX) {
+	objects, edgeXerr := getObjectsBySomeRange(conn, ZRANGE, CreateKey(DiscoveredDeviceCollectionStatus, status), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objectsToDiscoveredDevices(objects)
+}
+
+func objectsToDiscoveredDevices(objects [][]byte) ([]models.DiscoveredDevice, errors.EdgeX) {
+	devices := make([]models.DiscoveredDevice, len(objects))
+	for i, o := range objects {
+		d := models.DiscoveredDevice{}
+		err := json.Unmarshal(o, &d)
+		if err != nil {
+			return []models.DiscoveredDevice{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "discovered device format parsing failed from the database", err)
+		}
+		devices[i] = d
+	}
+	return devices, nil
+}
@@ -74,6 +74,7 @@ func addProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) (addedProv
 	storedKey := provisionWatcherStoredKey(pw.Id)
 	_ = conn.Send(MULTI)
 	edgexErr = sendAddProvisionWatcherCmd(conn, storedKey, pw)
+	sendIncrRevisionCmd(conn, pw.Id)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgexErr = errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher creation failed", err)
@@ -162,12 +163,23 @@ func provisionWatchersByLabels(conn redis.Conn, offset int, limit int, labels []
 	return
 }
 
-// deleteProvisionWatcherByName deletes the provision watcher by name
-func deleteProvisionWatcherByName(conn redis.Conn, name string) errors.EdgeX {
+// deleteProvisionWatcherByName deletes the provision watcher by name if it is at the expected revision
+func deleteProvisionWatcherByName(conn redis.Conn, name string, revision int64) errors.EdgeX {
 	provisionWatcher, err := provisionWatcherByName(conn, name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if revision != pkgCommon.AnyRevision {
+		// read the provision watcher again once its revision is watched, so the deleted indexes can't be outdated
+		err = watchRevision(conn, provisionWatcher.Id, revision)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		provisionWatcher, err = provisionWatcherById(conn, provisionWatcher.Id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 
 	err = deleteProvisionWatcher(conn, provisionWatcher)
 	if err != nil {
@@ -193,15 +205,21 @@ func deleteProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) errors.
 	storedKey := provisionWatcherStoredKey(pw.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteProvisionWatcherCmd(conn, storedKey, pw)
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher deletion failed", err)
+	sendDeleteRevisionCmd(conn, pw.Id)
+	edgexErr := execRevisioned(conn, "provision watcher deletion failed")
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 
 	return nil
 }
 
-func updateProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) errors.EdgeX {
+// updateProvisionWatcher updates the provision watcher if it is at the expected revision, and increases the revision
+func updateProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher, revision int64) errors.EdgeX {
+	edgexErr := watchRevision(conn, pw.Id, revision)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	oldProvisionWatcher, edgexErr := provisionWatcherByName(conn, pw.Name)
 	if edgexErr != nil {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
@@ -215,9 +233,10 @@ func updateProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) errors.
 	if edgexErr != nil {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher update failed", err)
+	sendIncrRevisionCmd(conn, pw.Id)
+	edgexErr = execRevisioned(conn, "provision watcher update failed")
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 
 	return nil
@@ -0,0 +1,248 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	RecipeCollection              = "cmd|rcp"
+	RecipeCollectionName          = RecipeCollection + DBKeySeparator + common.Name
+	RecipeRunCollection           = "cmd|rcp|run"
+	RecipeRunCollectionRecipeName = RecipeRunCollection + DBKeySeparator + pkgCommon.Recipe + DBKeySeparator + common.Name
+	RecipeRunCollectionStatus     = RecipeRunCollection + DBKeySeparator + common.Status
+)
+
+// recipeStoredKey return the recipe's stored key which combines the collection name and object id
+func recipeStoredKey(id string) string {
+	return CreateKey(RecipeCollection, id)
+}
+
+// recipeRunStoredKey return the recipe run's stored key which combines the collection name and object id
+func recipeRunStoredKey(id string) string {
+	return CreateKey(RecipeRunCollection, id)
+}
+
+// addRecipe adds a new recipe into DB
+func addRecipe(conn redis.Conn, r models.Recipe) (models.Recipe, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, recipeStoredKey(r.Id))
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return r, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("recipe id %s already exists", r.Id), edgeXerr)
+	}
+	exists, edgeXerr = objectNameExists(conn, RecipeCollectionName, r.Name)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return r, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("recipe name %s already exists", r.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if r.Created == 0 {
+		r.Created = ts
+	}
+	r.Modified = ts
+
+	m, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal recipe for Redis persistence", err)
+	}
+	storedKey := recipeStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, RecipeCollection, r.Modified, storedKey)
+	_ = conn.Send(HSET, RecipeCollectionName, r.Name, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe creation failed", err)
+	}
+	return r, nil
+}
+
+// recipeByName query recipe by name from DB
+func recipeByName(conn redis.Conn, name string) (r models.Recipe, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, RecipeCollectionName, name, &r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// deleteRecipeByName deletes the recipe by name, the logs of its runs are kept until they expire
+func deleteRecipeByName(conn redis.Conn, name string) errors.EdgeX {
+	r, edgeXerr := recipeByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := recipeStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, RecipeCollection, storedKey)
+	_ = conn.Send(HDEL, RecipeCollectionName, r.Name)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe deletion failed", err)
+	}
+	return nil
+}
+
+// allRecipes queries recipes by offset and limit
+func allRecipes(conn redis.Conn, offset int, limit int) ([]models.Recipe, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, RecipeCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	recipes := make([]models.Recipe, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &recipes[i])
+		if err != nil {
+			return []models.Recipe{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe format parsing failed from the database", err)
+		}
+	}
+	return recipes, nil
+}
+
+// sendAddRecipeRunCmd sends redis command for adding recipe run
+func sendAddRecipeRunCmd(conn redis.Conn, storedKey string, run models.RecipeRun) errors.EdgeX {
+	m, err := json.Marshal(run)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal recipe run for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, RecipeRunCollection, run.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(RecipeRunCollectionRecipeName, run.RecipeName), run.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(RecipeRunCollectionStatus, string(run.Status)), run.Created, storedKey)
+	return nil
+}
+
+// sendDeleteRecipeRunCmd sends redis command for deleting recipe run
+func sendDeleteRecipeRunCmd(conn redis.Conn, storedKey string, run models.RecipeRun) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, RecipeRunCollection, storedKey)
+	_ = conn.Send(ZREM, CreateKey(RecipeRunCollectionRecipeName, run.RecipeName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(RecipeRunCollectionStatus, string(run.Status)), storedKey)
+}
+
+// addRecipeRun adds a new recipe run into DB
+func addRecipeRun(conn redis.Conn, run models.RecipeRun) (models.RecipeRun, errors.EdgeX) {
+	ts := pkgCommon.MakeTimestamp()
+	if run.Created == 0 {
+		run.Created = ts
+	}
+	run.Modified = ts
+
+	_ = conn.Send(MULTI)
+	edgeXerr := sendAddRecipeRunCmd(conn, recipeRunStoredKey(run.Id), run)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return run, errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe run creation failed", err)
+	}
+	return run, nil
+}
+
+// recipeRunById query recipe run by id from DB
+func recipeRunById(conn redis.Conn, id string) (run models.RecipeRun, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, recipeRunStoredKey(id), &run)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateRecipeRun updates the status and the step logs of the recipe run
+func updateRecipeRun(conn redis.Conn, run models.RecipeRun) errors.EdgeX {
+	oldRun, edgeXerr := recipeRunById(conn, run.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	// the runs are enumerated in the order they were created, which should never be changed
+	run.Created = oldRun.Created
+	run.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := recipeRunStoredKey(run.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteRecipeRunCmd(conn, storedKey, oldRun)
+	edgeXerr = sendAddRecipeRunCmd(conn, storedKey, run)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe run update failed", err)
+	}
+	return nil
+}
+
+// recipeRunsByRecipeName queries recipe runs by offset, limit and recipe name, newest first
+func recipeRunsByRecipeName(conn redis.Conn, offset int, limit int, name string) ([]models.RecipeRun, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(RecipeRunCollectionRecipeName, name), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToRecipeRuns(objects)
+}
+
+// recipeRunsByStatus queries recipe runs by offset, limit and status, newest first
+func recipeRunsByStatus(conn redis.Conn, offset int, limit int, status string) ([]models.RecipeRun, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(RecipeRunCollectionStatus, status), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToRecipeRuns(objects)
+}
+
+// deleteRecipeRunsByAge deletes the recipe runs which are older than age and returns the count of the deleted runs
+func deleteRecipeRunsByAge(conn redis.Conn, age int64) (int, errors.EdgeX) {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Values(conn.Do(ZRANGEBYSCORE, RecipeRunCollection, InfiniteMin, expireTimestamp))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the recipe runs older than %d ms", age), err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, storedKeys)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	runs, edgeXerr := convertObjectsToRecipeRuns(objects)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, run := range runs {
+		sendDeleteRecipeRunCmd(conn, recipeRunStoredKey(run.Id), run)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe runs deletion failed", err)
+	}
+	return len(runs), nil
+}
+
+func convertObjectsToRecipeRuns(objects [][]byte) (runs []models.RecipeRun, edgeXerr errors.EdgeX) {
+	runs = make([]models.RecipeRun, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &runs[i])
+		if err != nil {
+			return []models.RecipeRun{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "recipe run format parsing failed from the database", err)
+		}
+	}
+	return runs, nil
+}
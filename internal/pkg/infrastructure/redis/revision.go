@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// RevisionCollection holds the revision of each metadata entity, the revision is increased in the same transaction
+// which adds or updates the entity and removed together with the entity
+const RevisionCollection = "md|rev"
+
+// revisionStoredKey return the stored key of the entity's revision which combines the collection name and entity id
+func revisionStoredKey(id string) string {
+	return CreateKey(RevisionCollection, id)
+}
+
+// revision returns the current revision of the entity, an entity stored before the revisions were introduced has the
+// revision 0
+func revision(conn redis.Conn, id string) (int64, errors.EdgeX) {
+	rev, err := redis.Int64(conn.Do(GET, revisionStoredKey(id)))
+	if err == redis.ErrNil {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the revision of entity %s", id), err)
+	}
+	return rev, nil
+}
+
+// watchRevision watches the revision of the entity and checks it against the expected revision, so the following
+// transaction executed by execRevisioned is aborted if the entity is modified in the meantime. Nothing is checked if
+// the expected revision is pkgCommon.AnyRevision.
+func watchRevision(conn redis.Conn, id string, expected int64) errors.EdgeX {
+	if expected == pkgCommon.AnyRevision {
+		return nil
+	}
+	edgeXerr := watch(conn, revisionStoredKey(id))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	current, edgeXerr := revision(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if current != expected {
+		return errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch,
+			fmt.Sprintf("entity %s is at revision %d rather than the expected revision %d", id, current, expected), nil)
+	}
+	return nil
+}
+
+// sendIncrRevisionCmd send redis command for increasing the revision of the entity
+func sendIncrRevisionCmd(conn redis.Conn, id string) {
+	_ = conn.Send(INCR, revisionStoredKey(id))
+}
+
+// sendDeleteRevisionCmd send redis command for deleting the revision of the entity
+func sendDeleteRevisionCmd(conn redis.Conn, id string) {
+	_ = conn.Send(DEL, revisionStoredKey(id))
+}
+
+// execRevisioned executes the transaction guarded by watchRevision and reports a revision mismatch if it is aborted
+// since the entity is modified concurrently
+func execRevisioned(conn redis.Conn, message string) errors.EdgeX {
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, message, err)
+	}
+	if reply == nil {
+		return errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch, message+", the entity was modified concurrently", nil)
+	}
+	return nil
+}
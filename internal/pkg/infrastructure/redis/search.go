@@ -0,0 +1,288 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// The search indexes of devices, device profiles and device services. SearchCollectionName is a sorted set whose
+// members combine the lower-case entity name and the entity's stored key, so a name prefix is matched by ZRANGEBYLEX.
+// SearchCollectionTerm holds a set of stored keys per term found in the entity's name, description, labels and other
+// attributes. SearchCollectionIndexed marks that the entities stored before the search was introduced are indexed.
+const (
+	SearchCollection        = "md|search"
+	SearchCollectionName    = SearchCollection + DBKeySeparator + "name"
+	SearchCollectionTerm    = SearchCollection + DBKeySeparator + "term"
+	SearchCollectionIndexed = SearchCollection + DBKeySeparator + "indexed"
+
+	searchNameSeparator = "|"
+)
+
+// searchCollections maps the collection of each searchable entity type to the type
+var searchCollections = map[string]pkgModels.SearchEntityType{
+	DeviceCollection:        pkgModels.SearchDevice,
+	DeviceProfileCollection: pkgModels.SearchDeviceProfile,
+	DeviceServiceCollection: pkgModels.SearchDeviceService,
+}
+
+// searchedEntity holds the attributes shared by the searchable entities which make up a search hit
+type searchedEntity struct {
+	Id          string
+	Name        string
+	Description string
+	Labels      []string
+	Modified    int64
+}
+
+// deviceSearchTerms returns the terms indexed for the device, which come from the name, description, labels,
+// protocol property values and location
+func deviceSearchTerms(d models.Device) []string {
+	texts := append([]string{d.Name, d.Description}, d.Labels...)
+	for _, properties := range d.Protocols {
+		for _, value := range properties {
+			texts = append(texts, value)
+		}
+	}
+	texts = append(texts, locationTexts(d.Location)...)
+	return pkgCommon.SearchTerms(texts...)
+}
+
+// deviceProfileSearchTerms returns the terms indexed for the device profile, which come from the name, description,
+// manufacturer, model and labels
+func deviceProfileSearchTerms(dp models.DeviceProfile) []string {
+	texts := append([]string{dp.Name, dp.Description, dp.Manufacturer, dp.Model}, dp.Labels...)
+	return pkgCommon.SearchTerms(texts...)
+}
+
+// deviceServiceSearchTerms returns the terms indexed for the device service, which come from the name, description
+// and labels
+func deviceServiceSearchTerms(ds models.DeviceService) []string {
+	texts := append([]string{ds.Name, ds.Description}, ds.Labels...)
+	return pkgCommon.SearchTerms(texts...)
+}
+
+// locationTexts collects the string and number values of the free-form device location
+func locationTexts(location interface{}) []string {
+	switch l := location.(type) {
+	case string:
+		return []string{l}
+	case float64, int, int64:
+		return []string{fmt.Sprint(l)}
+	case map[string]interface{}:
+		var texts []string
+		for _, v := range l {
+			texts = append(texts, locationTexts(v)...)
+		}
+		return texts
+	case []interface{}:
+		var texts []string
+		for _, v := range l {
+			texts = append(texts, locationTexts(v)...)
+		}
+		return texts
+	}
+	return nil
+}
+
+// sendAddSearchIndexCmd send redis command for indexing the entity's name and terms
+func sendAddSearchIndexCmd(conn redis.Conn, storedKey string, name string, terms []string) {
+	_ = conn.Send(ZADD, SearchCollectionName, 0, strings.ToLower(name)+searchNameSeparator+storedKey)
+	for _, term := range terms {
+		_ = conn.Send(SADD, CreateKey(SearchCollectionTerm, term), storedKey)
+	}
+}
+
+// sendDeleteSearchIndexCmd send redis command for removing the entity's name and terms from the search index
+func sendDeleteSearchIndexCmd(conn redis.Conn, storedKey string, name string, terms []string) {
+	_ = conn.Send(ZREM, SearchCollectionName, strings.ToLower(name)+searchNameSeparator+storedKey)
+	for _, term := range terms {
+		_ = conn.Send(SREM, CreateKey(SearchCollectionTerm, term), storedKey)
+	}
+}
+
+// searchNameStoredKeys adds the stored keys of the entities whose lower-case name starts with the prefix to storedKeys
+func searchNameStoredKeys(conn redis.Conn, prefix string, storedKeys map[string]bool) errors.EdgeX {
+	members, err := redis.Strings(conn.Do(ZRANGEBYLEX, SearchCollectionName, "["+prefix, "["+prefix+"\xff"))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the names matching %s", prefix), err)
+	}
+	for _, member := range members {
+		storedKeys[member[strings.Index(member, searchNameSeparator)+1:]] = true
+	}
+	return nil
+}
+
+// searchTermStoredKeys returns the stored keys of the entities matching the term, either by a name starting with the
+// term or by an indexed term equal to it
+func searchTermStoredKeys(conn redis.Conn, term string) (map[string]bool, errors.EdgeX) {
+	storedKeys := make(map[string]bool)
+	if edgeXerr := searchNameStoredKeys(conn, term, storedKeys); edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	termKeys, err := redis.Strings(conn.Do(SMEMBERS, CreateKey(SearchCollectionTerm, term)))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the entities indexed by %s", term), err)
+	}
+	for _, storedKey := range termKeys {
+		storedKeys[storedKey] = true
+	}
+	return storedKeys, nil
+}
+
+// searchEntityType returns the type of the entity stored with the key
+func searchEntityType(storedKey string) (pkgModels.SearchEntityType, bool) {
+	collection := storedKey
+	if i := strings.LastIndex(storedKey, DBKeySeparator); i >= 0 {
+		collection = storedKey[:i]
+	}
+	entityType, ok := searchCollections[collection]
+	return entityType, ok
+}
+
+// searchMetadata returns the entities of the given types whose name starts with the prefix or which match all the terms
+// along with the total count of the matched entities, all the types are searched if none is given. The name prefix
+// keeps the separators which split the terms, so that a hyphenated or dotted name is found by its leading part. The
+// hits are sorted by type and then by name.
+func searchMetadata(conn redis.Conn, namePrefix string, terms []string, types []pkgModels.SearchEntityType, offset int, limit int) ([]pkgModels.SearchHit, uint32, errors.EdgeX) {
+	var matched map[string]bool
+	for _, term := range terms {
+		storedKeys, edgeXerr := searchTermStoredKeys(conn, term)
+		if edgeXerr != nil {
+			return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if matched == nil {
+			matched = storedKeys
+		} else {
+			for storedKey := range matched {
+				if !storedKeys[storedKey] {
+					delete(matched, storedKey)
+				}
+			}
+		}
+		if len(matched) == 0 {
+			break
+		}
+	}
+	if matched == nil {
+		matched = make(map[string]bool)
+	}
+	if namePrefix != "" {
+		if edgeXerr := searchNameStoredKeys(conn, namePrefix, matched); edgeXerr != nil {
+			return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
+	allowed := make(map[pkgModels.SearchEntityType]bool)
+	for _, t := range types {
+		allowed[t] = true
+	}
+	var storedKeys []interface{}
+	var entityTypes []pkgModels.SearchEntityType
+	for storedKey := range matched {
+		entityType, ok := searchEntityType(storedKey)
+		if !ok || (len(allowed) > 0 && !allowed[entityType]) {
+			continue
+		}
+		storedKeys = append(storedKeys, storedKey)
+		entityTypes = append(entityTypes, entityType)
+	}
+	if len(storedKeys) == 0 {
+		return []pkgModels.SearchHit{}, 0, nil
+	}
+
+	objects, err := redis.ByteSlices(conn.Do(MGET, storedKeys...))
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "query matched objects from database failed", err)
+	}
+	hits := make([]pkgModels.SearchHit, 0, len(objects))
+	for i, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		var e searchedEntity
+		err = json.Unmarshal(obj, &e)
+		if err != nil {
+			return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "matched object format parsing failed from the database", err)
+		}
+		hits = append(hits, pkgModels.SearchHit{
+			Type:        entityTypes[i],
+			Id:          e.Id,
+			Name:        e.Name,
+			Description: e.Description,
+			Labels:      e.Labels,
+			Modified:    e.Modified,
+		})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Type != hits[j].Type {
+			return hits[i].Type < hits[j].Type
+		}
+		return hits[i].Name < hits[j].Name
+	})
+
+	total := len(hits)
+	if offset > total {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", total), nil)
+	}
+	end := total
+	if limit >= 0 && offset+limit < total {
+		end = offset + limit
+	}
+	return hits[offset:end], uint32(total), nil
+}
+
+// ensureSearchIndex indexes the devices, device profiles and device services stored before the search was
+// introduced, which is done only once since the entities are indexed whenever they are added or updated afterwards
+func ensureSearchIndex(conn redis.Conn) (indexed int, edgeXerr errors.EdgeX) {
+	exists, err := redis.Bool(conn.Do(EXISTS, SearchCollectionIndexed))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to check the search index", err)
+	} else if exists {
+		return 0, nil
+	}
+
+	devices, edgeXerr := devicesByLabels(conn, 0, -1, nil)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	profiles, edgeXerr := deviceProfilesByLabels(conn, 0, -1, nil)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	services, edgeXerr := deviceServicesByLabels(conn, 0, -1, nil)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, d := range devices {
+		sendAddSearchIndexCmd(conn, deviceStoredKey(d.Id), d.Name, deviceSearchTerms(d))
+	}
+	for _, dp := range profiles {
+		sendAddSearchIndexCmd(conn, deviceProfileStoredKey(dp.Id), dp.Name, deviceProfileSearchTerms(dp))
+	}
+	for _, ds := range services {
+		sendAddSearchIndexCmd(conn, deviceServiceStoredKey(ds.Id), ds.Name, deviceServiceSearchTerms(ds))
+	}
+	_ = conn.Send(SET, SearchCollectionIndexed, pkgCommon.MakeTimestamp())
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "search index creation failed", err)
+	}
+	return len(devices) + len(profiles) + len(services), nil
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceSearchTerms(t *testing.T) {
+	device := models.Device{
+		Name:        "Thermostat-1",
+		Description: "Lobby thermostat",
+		Labels:      []string{"hvac"},
+		Protocols: map[string]models.ProtocolProperties{
+			"modbus-tcp": {"Address": "10.0.0.1"},
+		},
+		Location: map[string]interface{}{"building": "HQ", "floor": float64(2)},
+	}
+
+	terms := deviceSearchTerms(device)
+
+	assert.ElementsMatch(t, []string{"thermostat", "1", "lobby", "hvac", "10", "0", "hq", "2"}, terms)
+}
+
+func TestSearchEntityType(t *testing.T) {
+	tests := []struct {
+		name         string
+		storedKey    string
+		expectedType pkgModels.SearchEntityType
+		expectedOk   bool
+	}{
+		{"device", deviceStoredKey(exampleUUID), pkgModels.SearchDevice, true},
+		{"device profile", deviceProfileStoredKey(exampleUUID), pkgModels.SearchDeviceProfile, true},
+		{"device service", deviceServiceStoredKey(exampleUUID), pkgModels.SearchDeviceService, true},
+		{"other", CreateKey(RevisionCollection, exampleUUID), "", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			entityType, ok := searchEntityType(testCase.storedKey)
+			assert.Equal(t, testCase.expectedOk, ok)
+			assert.Equal(t, testCase.expectedType, entityType)
+		})
+	}
+}
+
+// searchConn serves the search index of the given devices to the queries issued by searchMetadata
+type searchConn struct {
+	redis.Conn
+	names   []string
+	terms   map[string][]string
+	objects map[string][]byte
+}
+
+func newSearchConn(t *testing.T, devices ...models.Device) *searchConn {
+	conn := &searchConn{terms: make(map[string][]string), objects: make(map[string][]byte)}
+	for _, d := range devices {
+		storedKey := deviceStoredKey(d.Id)
+		conn.names = append(conn.names, strings.ToLower(d.Name)+searchNameSeparator+storedKey)
+		for _, term := range deviceSearchTerms(d) {
+			conn.terms[term] = append(conn.terms[term], storedKey)
+		}
+		bytes, err := json.Marshal(d)
+		require.NoError(t, err)
+		conn.objects[storedKey] = bytes
+	}
+	return conn
+}
+
+func (c *searchConn) Do(command string, args ...interface{}) (interface{}, error) {
+	var reply []interface{}
+	switch command {
+	case ZRANGEBYLEX:
+		prefix := strings.TrimPrefix(args[1].(string), "[")
+		for _, name := range c.names {
+			if strings.HasPrefix(name, prefix) {
+				reply = append(reply, []byte(name))
+			}
+		}
+	case SMEMBERS:
+		for _, storedKey := range c.terms[strings.TrimPrefix(args[0].(string), SearchCollectionTerm+DBKeySeparator)] {
+			reply = append(reply, []byte(storedKey))
+		}
+	case MGET:
+		for _, storedKey := range args {
+			reply = append(reply, c.objects[storedKey.(string)])
+		}
+	}
+	return reply, nil
+}
+
+func TestSearchMetadata(t *testing.T) {
+	conn := newSearchConn(t,
+		models.Device{Id: "1", Name: "modbus-device-01", Description: "Boiler"},
+		models.Device{Id: "2", Name: "modbus.gateway", Labels: []string{"device"}},
+		models.Device{Id: "3", Name: "thermostat-1", Description: "Modbus thermostat"},
+	)
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedNames []string
+	}{
+		{"hyphenated name prefix", "modbus-dev", []string{"modbus-device-01"}},
+		{"dotted name prefix", "modbus.gat", []string{"modbus.gateway"}},
+		{"whole terms", "modbus device", []string{"modbus-device-01", "modbus.gateway"}},
+		{"name term", "modbus", []string{"modbus-device-01", "modbus.gateway", "thermostat-1"}},
+		{"no match", "modbus-gat", []string{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			hits, total, err := searchMetadata(conn, testCase.query, pkgCommon.SearchTerms(testCase.query), nil, 0, -1)
+			require.NoError(t, err)
+			names := make([]string, len(hits))
+			for i, hit := range hits {
+				names[i] = hit.Name
+			}
+			assert.Equal(t, testCase.expectedNames, names)
+			assert.Equal(t, uint32(len(testCase.expectedNames)), total)
+		})
+	}
+}
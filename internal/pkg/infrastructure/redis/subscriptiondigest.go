@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	SubscriptionDigestCollection                 = "sn|sub|dig"
+	SubscriptionDigestCollectionSubscriptionName = SubscriptionDigestCollection + DBKeySeparator + common.Subscription + DBKeySeparator + common.Name
+)
+
+// subscriptionDigestStoredKey return the subscription digest's stored key which combines the collection name and object id
+func subscriptionDigestStoredKey(id string) string {
+	return CreateKey(SubscriptionDigestCollection, id)
+}
+
+// sendAddSubscriptionDigestCmd sends redis command for adding subscription digest
+func sendAddSubscriptionDigestCmd(conn redis.Conn, storedKey string, d models.SubscriptionDigest) errors.EdgeX {
+	m, err := json.Marshal(d)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription digest for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	// the digests are enumerated by creation as their pending notifications change all the time
+	_ = conn.Send(ZADD, SubscriptionDigestCollection, d.Created, storedKey)
+	_ = conn.Send(HSET, SubscriptionDigestCollectionSubscriptionName, d.SubscriptionName, storedKey)
+	return nil
+}
+
+// sendDeleteSubscriptionDigestCmd sends redis command for deleting subscription digest
+func sendDeleteSubscriptionDigestCmd(conn redis.Conn, storedKey string, d models.SubscriptionDigest) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, SubscriptionDigestCollection, storedKey)
+	_ = conn.Send(HDEL, SubscriptionDigestCollectionSubscriptionName, d.SubscriptionName)
+}
+
+// addSubscriptionDigest adds a new subscription digest into DB, a subscription has at most one digest
+func addSubscriptionDigest(conn redis.Conn, d models.SubscriptionDigest) (models.SubscriptionDigest, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, subscriptionDigestStoredKey(d.Id))
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription digest id %s already exists", d.Id), edgeXerr)
+	}
+	exists, edgeXerr = objectNameExists(conn, SubscriptionDigestCollectionSubscriptionName, d.SubscriptionName)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription %s already has a digest", d.SubscriptionName), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if d.Created == 0 {
+		d.Created = ts
+	}
+	d.Modified = ts
+
+	storedKey := subscriptionDigestStoredKey(d.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddSubscriptionDigestCmd(conn, storedKey, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription digest creation failed", err)
+	}
+	return d, nil
+}
+
+// subscriptionDigestBySubscriptionName query the digest of the subscription from DB
+func subscriptionDigestBySubscriptionName(conn redis.Conn, name string) (d models.SubscriptionDigest, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, SubscriptionDigestCollectionSubscriptionName, name, &d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateSubscriptionDigest updates the pending notifications of the digest
+func updateSubscriptionDigest(conn redis.Conn, d models.SubscriptionDigest) errors.EdgeX {
+	oldDigest, edgeXerr := subscriptionDigestBySubscriptionName(conn, d.SubscriptionName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	d.Created = oldDigest.Created
+	d.Modified = pkgCommon.MakeTimestamp()
+
+	_ = conn.Send(MULTI)
+	sendDeleteSubscriptionDigestCmd(conn, subscriptionDigestStoredKey(oldDigest.Id), oldDigest)
+	edgeXerr = sendAddSubscriptionDigestCmd(conn, subscriptionDigestStoredKey(d.Id), d)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription digest update failed", err)
+	}
+	return nil
+}
+
+// deleteSubscriptionDigestBySubscriptionName deletes the digest of the subscription along with its pending notifications
+func deleteSubscriptionDigestBySubscriptionName(conn redis.Conn, name string) errors.EdgeX {
+	d, edgeXerr := subscriptionDigestBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_ = conn.Send(MULTI)
+	sendDeleteSubscriptionDigestCmd(conn, subscriptionDigestStoredKey(d.Id), d)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription digest deletion failed", err)
+	}
+	return nil
+}
+
+// allSubscriptionDigests queries subscription digests by offset and limit
+func allSubscriptionDigests(conn redis.Conn, offset int, limit int) ([]models.SubscriptionDigest, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, SubscriptionDigestCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	digests := make([]models.SubscriptionDigest, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &digests[i])
+		if err != nil {
+			return []models.SubscriptionDigest{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription digest format parsing failed from the database", err)
+		}
+	}
+	return digests, nil
+}
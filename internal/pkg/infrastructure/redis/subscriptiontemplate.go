@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	SubscriptionTemplateCollection                 = "sn|sub|tpl"
+	SubscriptionTemplateCollectionSubscriptionName = SubscriptionTemplateCollection + DBKeySeparator + common.Subscription + DBKeySeparator + common.Name
+)
+
+// subscriptionTemplateStoredKey return the subscription template's stored key which combines the collection name and object id
+func subscriptionTemplateStoredKey(id string) string {
+	return CreateKey(SubscriptionTemplateCollection, id)
+}
+
+// addSubscriptionTemplate adds a new subscription template into DB, a subscription has at most one template
+func addSubscriptionTemplate(conn redis.Conn, t models.SubscriptionTemplate) (models.SubscriptionTemplate, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, subscriptionTemplateStoredKey(t.Id))
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription template id %s already exists", t.Id), edgeXerr)
+	}
+	exists, edgeXerr = objectNameExists(conn, SubscriptionTemplateCollectionSubscriptionName, t.SubscriptionName)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription %s already has a template", t.SubscriptionName), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if t.Created == 0 {
+		t.Created = ts
+	}
+	t.Modified = ts
+
+	m, err := json.Marshal(t)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription template for Redis persistence", err)
+	}
+	storedKey := subscriptionTemplateStoredKey(t.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, SubscriptionTemplateCollection, t.Modified, storedKey)
+	_ = conn.Send(HSET, SubscriptionTemplateCollectionSubscriptionName, t.SubscriptionName, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription template creation failed", err)
+	}
+	return t, nil
+}
+
+// subscriptionTemplateBySubscriptionName query the template of the subscription from DB
+func subscriptionTemplateBySubscriptionName(conn redis.Conn, name string) (t models.SubscriptionTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, SubscriptionTemplateCollectionSubscriptionName, name, &t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// deleteSubscriptionTemplateBySubscriptionName deletes the template of the subscription
+func deleteSubscriptionTemplateBySubscriptionName(conn redis.Conn, name string) errors.EdgeX {
+	t, edgeXerr := subscriptionTemplateBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := subscriptionTemplateStoredKey(t.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, SubscriptionTemplateCollection, storedKey)
+	_ = conn.Send(HDEL, SubscriptionTemplateCollectionSubscriptionName, t.SubscriptionName)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription template deletion failed", err)
+	}
+	return nil
+}
+
+// allSubscriptionTemplates queries subscription templates by offset and limit
+func allSubscriptionTemplates(conn redis.Conn, offset int, limit int) ([]models.SubscriptionTemplate, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, SubscriptionTemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	templates := make([]models.SubscriptionTemplate, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &templates[i])
+		if err != nil {
+			return []models.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription template format parsing failed from the database", err)
+		}
+	}
+	return templates, nil
+}
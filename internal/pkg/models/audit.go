@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// AuditRecord records an administrative change made to an entity of a core service, i.e. who added, updated or
+// deleted which entity and what was changed.
+type AuditRecord struct {
+	Id            string
+	Created       int64
+	Service       string
+	Actor         string
+	CorrelationId string
+	Action        AuditAction
+	EntityType    AuditEntityType
+	EntityName    string
+	// Changes holds the previous and new value of each changed field of the entity
+	Changes map[string]AuditChange
+}
+
+// AuditChange holds the previous and new value of a field, the previous value is nil when the entity is added and the
+// new value is nil when the entity is deleted.
+type AuditChange struct {
+	Before interface{}
+	After  interface{}
+}
+
+// AuditAction indicates how the entity is changed.
+type AuditAction string
+
+// Constants for AuditAction
+const (
+	AuditAdd    AuditAction = "ADD"
+	AuditUpdate AuditAction = "UPDATE"
+	AuditDelete AuditAction = "DELETE"
+)
+
+// AuditEntityType indicates the kind of the changed entity.
+type AuditEntityType string
+
+// Constants for AuditEntityType
+const (
+	AuditDevice               AuditEntityType = "device"
+	AuditDeviceProfile        AuditEntityType = "deviceprofile"
+	AuditDeviceService        AuditEntityType = "deviceservice"
+	AuditProvisionWatcher     AuditEntityType = "provisionwatcher"
+	AuditInterval             AuditEntityType = "interval"
+	AuditIntervalAction       AuditEntityType = "intervalaction"
+	AuditSubscription         AuditEntityType = "subscription"
+	AuditSubscriptionTemplate AuditEntityType = "subscriptiontemplate"
+	AuditSubscriptionDigest   AuditEntityType = "subscriptiondigest"
+)
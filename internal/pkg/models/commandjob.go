@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// CommandJob is a command which core-command issues to the device in the background, so that the long-running
+// device operations don't time out the request which issued them.
+type CommandJob struct {
+	models.DBTimestamp
+	Id          string
+	DeviceName  string
+	CommandName string
+	// Method is GET to read the command and PUT to write it with the Settings
+	Method      string
+	QueryParams string
+	Settings    map[string]string
+	// CallbackUrl is notified with the job once it's completed, nothing is notified if it is empty
+	CallbackUrl string
+	Status      CommandJobStatus
+	StatusCode  int
+	Message     string
+	// Event is the JSON of the event read by a GET command
+	Event         []byte
+	Started       int64
+	Completed     int64
+	CorrelationId string
+	// Caller is the identity which issued the job, the command is recorded in the command history on its behalf
+	Caller string
+}
+
+// CommandJobStatus indicates the execution state of the command job.
+type CommandJobStatus string
+
+// Constants for CommandJobStatus
+const (
+	JobPending   = "PENDING"
+	JobRunning   = "RUNNING"
+	JobSucceeded = "SUCCEEDED"
+	JobFailed    = "FAILED"
+	JobCancelled = "CANCELLED"
+)
+
+// IsCompleted tells whether the job will never be executed again
+func (j CommandJob) IsCompleted() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed || j.Status == JobCancelled
+}
@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// CommandRecord is the history of a command which core-command issued to a device, along with its outcome
+type CommandRecord struct {
+	models.DBTimestamp
+	Id          string
+	DeviceName  string
+	CommandName string
+	// Method is GET if the command was read and PUT if it was written with the Settings
+	Method      string
+	QueryParams string
+	Settings    map[string]string
+	// Caller is the identity which issued the command, anonymous if the request carried no identity
+	Caller        string
+	CorrelationId string
+	StatusCode    int
+	Message       string
+	// Latency is how long the command took in milliseconds
+	Latency int64
+	// ReplayOf is the id of the record whose command was replayed by this one
+	ReplayOf string
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DeviceServiceCallback is an entry of the core-metadata callback outbox. Each entry holds a change which must be
+// delivered to the device service, and is kept until the delivery succeeds.
+type DeviceServiceCallback struct {
+	models.DBTimestamp
+	Id            string
+	ServiceName   string
+	Action        CallbackAction
+	EntityName    string
+	Payload       []byte
+	Status        CallbackStatus
+	RetryCount    int
+	NextRetry     int64
+	LastError     string
+	CorrelationId string
+	// BaseAddress is used to reach the device service which is already deleted from core-metadata, the address of the
+	// registered device service is used if it is empty
+	BaseAddress string
+	// Sequence keeps the order in which the callbacks of the same device service were created
+	Sequence int64
+}
+
+// CallbackAction indicates which device service callback API should be invoked.
+type CallbackAction string
+
+// CallbackStatus indicates the delivery state of the device service callback.
+type CallbackStatus string
+
+// Constants for CallbackAction
+const (
+	AddDeviceAction              = "ADD_DEVICE"
+	UpdateDeviceAction           = "UPDATE_DEVICE"
+	DeleteDeviceAction           = "DELETE_DEVICE"
+	UpdateDeviceProfileAction    = "UPDATE_DEVICE_PROFILE"
+	AddProvisionWatcherAction    = "ADD_PROVISION_WATCHER"
+	UpdateProvisionWatcherAction = "UPDATE_PROVISION_WATCHER"
+	DeleteProvisionWatcherAction = "DELETE_PROVISION_WATCHER"
+	UpdateDeviceServiceAction    = "UPDATE_DEVICE_SERVICE"
+)
+
+// Constants for CallbackStatus
+const (
+	CallbackPending = "PENDING"
+	CallbackFailed  = "FAILED"
+)
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DeviceServiceHealth records the result of pinging a device service by the core-metadata liveness monitor.
+type DeviceServiceHealth struct {
+	models.DBTimestamp
+	ServiceName         string
+	Status              DeviceServiceHealthStatus
+	LastChecked         int64
+	ConsecutiveFailures int
+	LastError           string
+	// DownDevices are the devices marked DOWN because the device service became unreachable, they are brought UP
+	// again when the device service recovers
+	DownDevices []string
+}
+
+// DeviceServiceHealthStatus indicates whether the device service responds to the ping.
+type DeviceServiceHealthStatus string
+
+// Constants for DeviceServiceHealthStatus
+const (
+	ServiceReachable   = "REACHABLE"
+	ServiceUnreachable = "UNREACHABLE"
+)
@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DeviceTemplate holds what many similar devices have in common, the devices are instantiated from the template and
+// a set of per-device variables. The protocol property values may contain placeholders such as {{address}}, which are
+// replaced with the variables of each device.
+type DeviceTemplate struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	ServiceName string
+	ProfileName string
+	Labels      []string
+	AdminState  models.AdminState
+	Protocols   map[string]models.ProtocolProperties
+	AutoEvents  []models.AutoEvent
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DiscoveredDevice is a device submitted by a device service which matches a provision watcher, and is parked in the
+// approval queue of core-metadata until it is approved as a device or rejected.
+type DiscoveredDevice struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	Labels      []string
+	ServiceName string
+	Protocols   map[string]models.ProtocolProperties
+	// ProvisionWatcherName is the provision watcher matched, the device is created from its profile, admin state
+	// and auto events when it is approved
+	ProvisionWatcherName string
+	ProfileName          string
+	Status               DiscoveredDeviceStatus
+}
+
+// DiscoveredDeviceStatus indicates whether the discovered device is waiting for the approval or already rejected.
+type DiscoveredDeviceStatus string
+
+// DiscoveryResult indicates what core-metadata did with a discovered device submitted by the device service.
+type DiscoveryResult string
+
+// Constants for DiscoveredDeviceStatus
+const (
+	DiscoveredDevicePending  = "PENDING"
+	DiscoveredDeviceRejected = "REJECTED"
+)
+
+// Constants for DiscoveryResult
+const (
+	// DiscoveryCreated means the device matched a provision watcher and is created
+	DiscoveryCreated = "CREATED"
+	// DiscoveryPending means the device matched a provision watcher and waits for the approval
+	DiscoveryPending = "PENDING"
+	// DiscoveryRejected means the device was rejected before and is ignored
+	DiscoveryRejected = "REJECTED"
+	// DiscoveryBlocked means the device matched a provision watcher but also its blocking identifiers
+	DiscoveryBlocked = "BLOCKED"
+	// DiscoveryExists means a device with the same name already exists and the discovered device is ignored
+	DiscoveryExists = "EXISTS"
+	// DiscoveryNotMatched means the device matched none of the provision watchers of the device service
+	DiscoveryNotMatched = "NOT_MATCHED"
+)
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// The keys of the structured device location, e.g.
+// {"latitude": 45.50, "longitude": -73.56, "altitude": 35, "site": "plant-2"}
+const (
+	GeoLatitude  = "latitude"
+	GeoLongitude = "longitude"
+	GeoAltitude  = "altitude"
+	GeoSite      = "site"
+)
+
+// The bounds of the coordinates which can be indexed, the latitudes closer to the poles are not supported by the geo
+// index of the database
+const (
+	MaxGeoLatitude  = 85.05112878
+	MaxGeoLongitude = 180.0
+)
+
+// GeoLocation is the structured form of the free-form device location, the altitude is in meters and the site refers
+// to the building or plant where the device is installed.
+type GeoLocation struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  *float64
+	Site      string
+}
+
+// NearbyDevice is a device found by a geospatial query along with its distance in meters from the center of the
+// queried area.
+type NearbyDevice struct {
+	Device   models.Device
+	Distance float64
+}
+
+// ToGeoLocation returns the structured form of the device location. False is returned if the location doesn't have a
+// latitude nor a longitude, which means it's kept free-form. An error is returned if the location has a latitude or a
+// longitude but isn't a valid structured location.
+func ToGeoLocation(location interface{}) (l GeoLocation, ok bool, edgeXerr errors.EdgeX) {
+	m, isMap := location.(map[string]interface{})
+	if !isMap {
+		return l, false, nil
+	}
+	_, hasLatitude := m[GeoLatitude]
+	_, hasLongitude := m[GeoLongitude]
+	if !hasLatitude && !hasLongitude {
+		return l, false, nil
+	}
+
+	var isNumber bool
+	if l.Latitude, isNumber = geoNumber(m[GeoLatitude]); !isNumber || l.Latitude < -MaxGeoLatitude || l.Latitude > MaxGeoLatitude {
+		return l, false, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("location %s %v is not a number between %v and %v", GeoLatitude, m[GeoLatitude], -MaxGeoLatitude, MaxGeoLatitude), nil)
+	}
+	if l.Longitude, isNumber = geoNumber(m[GeoLongitude]); !isNumber || l.Longitude < -MaxGeoLongitude || l.Longitude > MaxGeoLongitude {
+		return l, false, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("location %s %v is not a number between %v and %v", GeoLongitude, m[GeoLongitude], -MaxGeoLongitude, MaxGeoLongitude), nil)
+	}
+	if v, exists := m[GeoAltitude]; exists && v != nil {
+		altitude, isNumber := geoNumber(v)
+		if !isNumber {
+			return l, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("location %s %v is not a number", GeoAltitude, v), nil)
+		}
+		l.Altitude = &altitude
+	}
+	if v, exists := m[GeoSite]; exists && v != nil {
+		site, isString := v.(string)
+		if !isString {
+			return l, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("location %s %v is not a string", GeoSite, v), nil)
+		}
+		l.Site = site
+	}
+	return l, true, nil
+}
+
+func geoNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
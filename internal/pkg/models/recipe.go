@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// Recipe is an ordered sequence of commands which core-command issues to the devices on demand, e.g. from an interval
+// action of support-scheduler
+type Recipe struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	Steps       []RecipeStep
+}
+
+// RecipeStep is a command of the recipe, which is issued once the Delay has elapsed and only if the Condition is met
+type RecipeStep struct {
+	// Name identifies the step in the conditions of the later steps
+	Name        string
+	DeviceName  string
+	CommandName string
+	// Method is GET to read the command and PUT to write it with the Settings
+	Method      string
+	QueryParams string
+	Settings    map[string]string
+	// Delay is the duration waited before the step, e.g. 5s
+	Delay     string
+	Condition *RecipeCondition
+}
+
+// RecipeCondition compares a reading returned by an earlier GET step with the Value
+type RecipeCondition struct {
+	Step         string
+	ResourceName string
+	Operator     string
+	Value        string
+}
+
+// RecipeRun is the log of an execution of the recipe
+type RecipeRun struct {
+	models.DBTimestamp
+	Id         string
+	RecipeName string
+	Status     RecipeRunStatus
+	// Message tells why the run failed
+	Message       string
+	Steps         []RecipeStepLog
+	Caller        string
+	CorrelationId string
+	Started       int64
+	Completed     int64
+}
+
+// RecipeStepLog is the outcome of a step of the recipe run
+type RecipeStepLog struct {
+	Name        string
+	DeviceName  string
+	CommandName string
+	Method      string
+	// Skipped tells the step wasn't issued since its condition isn't met
+	Skipped    bool
+	StatusCode int
+	Message    string
+	// Readings are the values read by a GET step by resource name
+	Readings  map[string]string
+	Started   int64
+	Completed int64
+}
+
+// RecipeRunStatus indicates the execution state of the recipe run.
+type RecipeRunStatus string
+
+// Constants for RecipeRunStatus
+const (
+	RecipeRunRunning   = "RUNNING"
+	RecipeRunSucceeded = "SUCCEEDED"
+	RecipeRunFailed    = "FAILED"
+)
+
+// Constants for the operators of RecipeCondition
+const (
+	OperatorEqual          = "=="
+	OperatorNotEqual       = "!="
+	OperatorGreater        = ">"
+	OperatorGreaterOrEqual = ">="
+	OperatorLess           = "<"
+	OperatorLessOrEqual    = "<="
+)
@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// SearchHit is a metadata entity matched by the core-metadata search.
+type SearchHit struct {
+	Type        SearchEntityType
+	Id          string
+	Name        string
+	Description string
+	Labels      []string
+	Modified    int64
+}
+
+// SearchEntityType indicates the kind of the metadata entity matched by the search.
+type SearchEntityType string
+
+// Constants for SearchEntityType
+const (
+	SearchDevice        SearchEntityType = "device"
+	SearchDeviceProfile SearchEntityType = "deviceprofile"
+	SearchDeviceService SearchEntityType = "deviceservice"
+)
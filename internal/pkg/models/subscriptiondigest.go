@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// SubscriptionDigest groups the notifications of a subscription into one transmission per channel, which is sent once
+// the first pending notification is Interval old or MaxNotifications are pending, whichever comes first. The pending
+// notifications are persisted along with the digest so that they survive the restarts of the service.
+type SubscriptionDigest struct {
+	models.DBTimestamp
+	Id               string
+	SubscriptionName string
+	// Interval is the duration string, e.g. 10m, the digest is not sent by time if it is blank
+	Interval string
+	// MaxNotifications is the number of pending notifications which triggers the digest, 0 means no limit
+	MaxNotifications int
+	// PendingNotificationIds are the ids of the notifications to be sent with the next digest
+	PendingNotificationIds []string
+	// PendingNotifications are the copies of the pending notifications, so that they are sent even if the processed
+	// notifications are purged by age before the digest
+	PendingNotifications []models.Notification
+	// PendingSince is the time in milliseconds when the first pending notification was added
+	PendingSince int64
+}
+
+// IntervalDuration parses the interval of the digest, zero means the digest is not sent by time
+func (d SubscriptionDigest) IntervalDuration() (time.Duration, error) {
+	if d.Interval == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(d.Interval)
+}
+
+// Due reports whether the pending notifications are to be sent at the time in milliseconds
+func (d SubscriptionDigest) Due(now int64) bool {
+	if len(d.PendingNotificationIds) == 0 {
+		return false
+	}
+	if d.MaxNotifications > 0 && len(d.PendingNotificationIds) >= d.MaxNotifications {
+		return true
+	}
+	interval, err := d.IntervalDuration()
+	if err != nil || interval <= 0 {
+		return false
+	}
+	return now-d.PendingSince >= interval.Milliseconds()
+}
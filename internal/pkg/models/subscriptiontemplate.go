@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// SubscriptionTemplate holds the templates rendering the notifications transmitted to the channels of a subscription,
+// so that each receiver gets the content in the shape it expects
+type SubscriptionTemplate struct {
+	models.DBTimestamp
+	Id               string
+	SubscriptionName string
+	Templates        []ChannelTemplate
+}
+
+// ChannelTemplate renders the notifications sent via the channels of the ChannelType, i.e. REST, EMAIL, MQTT or
+// MESSAGEBUS for the MQTT addresses which publish on the message bus. The template with a blank ChannelType applies to
+// the channels without a template of their own.
+type ChannelTemplate struct {
+	ChannelType string
+	// Engine is either text for text/template or html for html/template, which escapes the notification fields
+	Engine string
+	// Subject is the template of the email subject, the configured subject applies if it is blank
+	Subject string
+	// Body is the template of the content, the notification content is sent verbatim if it is blank
+	Body string
+	// ContentType is the content type of the rendered body, the one of the notification applies if it is blank
+	ContentType string
+}
+
+// Constants for the Engine of the ChannelTemplate
+const (
+	TemplateEngineText = "text"
+	TemplateEngineHTML = "html"
+)
+
+// TemplateOf returns the template of the channel type, falling back to the template for all channels
+func (t SubscriptionTemplate) TemplateOf(channelType string) (ChannelTemplate, bool) {
+	var fallback *ChannelTemplate
+	for i, ct := range t.Templates {
+		if ct.ChannelType == channelType {
+			return ct, true
+		}
+		if ct.ChannelType == "" {
+			fallback = &t.Templates[i]
+		}
+	}
+	if fallback != nil {
+		return *fallback, true
+	}
+	return ChannelTemplate{}, false
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi defines the subset of the OpenAPI 3.0 document which the services generate to describe their APIs
+// at runtime, e.g. the commands of a device.
+package openapi
+
+// Version is the version of the OpenAPI specification the documents conform to
+const Version = "3.0.3"
+
+// Document is the root object of the OpenAPI document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Servers    []Server            `json:"servers,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	Url string `json:"url"`
+}
+
+// PathItem holds the operations of a path, the methods other than GET and PUT are not generated
+type PathItem struct {
+	Get *Operation `json:"get,omitempty"`
+	Put *Operation `json:"put,omitempty"`
+}
+
+type Operation struct {
+	OperationId string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+// Schema is the JSON schema of a value. The properties which JSON schema doesn't define are carried as extensions.
+type Schema struct {
+	Ref         string             `json:"$ref,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Minimum     *float64           `json:"minimum,omitempty"`
+	Maximum     *float64           `json:"maximum,omitempty"`
+	Enum        []string           `json:"enum,omitempty"`
+	Default     string             `json:"default,omitempty"`
+	ReadOnly    bool               `json:"readOnly,omitempty"`
+	WriteOnly   bool               `json:"writeOnly,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	// Extensions of the EdgeX value
+	Units     string `json:"x-units,omitempty"`
+	ValueType string `json:"x-valueType,omitempty"`
+	MediaType string `json:"x-mediaType,omitempty"`
+}
+
+// RefSchema returns the schema referring to the schema of the components by name
+func RefSchema(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
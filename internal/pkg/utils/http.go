@@ -10,11 +10,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
@@ -38,11 +40,44 @@ func WriteErrorResponse(w http.ResponseWriter, ctx context.Context, lc logger.Lo
 		lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 	}
 	lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
-	errResponses := commonDTO.NewBaseResponse(requestId, err.Message(), err.Code())
-	WriteHttpHeader(w, ctx, err.Code())
+	errResponses := commonDTO.NewBaseResponse(requestId, err.Message(), ErrorCode(err))
+	WriteHttpHeader(w, ctx, ErrorCode(err))
 	pkg.Encode(errResponses, w, lc)
 }
 
+// ErrorCode returns the HTTP status code of the error, the error kinds defined by edgex-go which are unknown to
+// go-mod-core-contracts are mapped here
+func ErrorCode(err errors.EdgeX) int {
+	switch errors.Kind(err) {
+	case pkgCommon.KindRevisionMismatch:
+		return http.StatusPreconditionFailed
+	case pkgCommon.KindForbidden:
+		return http.StatusForbidden
+	case pkgCommon.KindUnauthorized:
+		return http.StatusUnauthorized
+	}
+	return err.Code()
+}
+
+// WriteETagHeader writes the revision of the entity as the ETag header
+func WriteETagHeader(w http.ResponseWriter, revision int64) {
+	w.Header().Set(pkgCommon.ETag, strconv.Quote(strconv.FormatInt(revision, 10)))
+}
+
+// ParseIfMatchHeader parses the expected revision of the entity from the If-Match header, which holds the ETag returned
+// when querying the entity. pkgCommon.AnyRevision is returned if the header is absent or "*".
+func ParseIfMatchHeader(r *http.Request) (int64, errors.EdgeX) {
+	value := strings.TrimSpace(r.Header.Get(pkgCommon.IfMatch))
+	if value == "" || value == "*" {
+		return pkgCommon.AnyRevision, nil
+	}
+	revision, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+	if err != nil || revision < 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s header '%s', the ETag of the entity is expected", pkgCommon.IfMatch, value), err)
+	}
+	return revision, nil
+}
+
 // ParseGetAllObjectsRequestQueryString parses offset, limit and labels from the query parameters. And use maximum and minimum to check whether the offset and limit are valid.
 func ParseGetAllObjectsRequestQueryString(r *http.Request, minOffset int, maxOffset int, minLimit int, maxLimit int) (offset int, limit int, labels []string, err errors.EdgeX) {
 	offset, err = ParseQueryStringToInt(r, common.Offset, common.DefaultOffset, minOffset, maxOffset)
@@ -92,6 +127,22 @@ func ParseQueryStringToInt(r *http.Request, queryStringKey string, defaultValue
 	return result, nil
 }
 
+// Parse the specified query string key to a boolean.  If specified query string key is found more than once in the
+// http request, only the first specified query string will be parsed and converted to a boolean.  If no specified
+// query string key could be found in the http request, specified default value will be returned.  EdgeX error will be
+// returned if any parsing error occurs.
+func ParseQueryStringToBool(r *http.Request, queryStringKey string, defaultValue bool) (bool, errors.EdgeX) {
+	values, ok := r.URL.Query()[queryStringKey]
+	if !ok || len(values) == 0 {
+		return defaultValue, nil
+	}
+	result, err := strconv.ParseBool(strings.TrimSpace(values[0]))
+	if err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s into boolean. Error:%s", queryStringKey, values[0], err.Error()), nil)
+	}
+	return result, nil
+}
+
 // Parse the specified query string key to an array of string.  If specified query string key is found more than once in
 // the http request, only the first specified query string will be parsed and converted to an array of string.  The
 // value of query string will be split into an array of string by the passing separator.  If separator is passed in as
@@ -161,6 +212,21 @@ func ParsePathParamToInt(r *http.Request, pathKey string) (int, errors.EdgeX) {
 	return result, nil
 }
 
+// Parse the specified path parameter to a float.  EdgeX error will be returned if any parsing error occurs or
+// specified path parameter is empty.
+func ParsePathParamToFloat(r *http.Request, pathKey string) (float64, errors.EdgeX) {
+	vars := mux.Vars(r)
+	val := vars[pathKey]
+	if len(val) == 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("empty path param %s is not allowed", pathKey), nil)
+	}
+	result, parsingErr := strconv.ParseFloat(val, 64)
+	if parsingErr != nil || math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse path param %s's value %s into float", pathKey, val), parsingErr)
+	}
+	return result, nil
+}
+
 // Parse the body of http request to a map[string]string.  EdgeX error will be returned if any parsing error occurs.
 func ParseBodyToMap(r *http.Request) (map[string]string, errors.EdgeX) {
 	defer r.Body.Close()
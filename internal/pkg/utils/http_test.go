@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"testing"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 
@@ -69,3 +71,85 @@ func TestParseGetAllObjectsRequestQueryString(t *testing.T) {
 	}
 
 }
+
+func TestParseQueryStringToBool(t *testing.T) {
+	testKey := "testKey"
+	tests := []struct {
+		name          string
+		value         string
+		present       bool
+		defaultValue  bool
+		expected      bool
+		errorExpected bool
+	}{
+		{"absent uses the default value", "", false, true, true, false},
+		{"true", "true", true, false, true, false},
+		{"false", "false", true, true, false, false},
+		{"invalid value", "yes", true, false, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+			require.NoError(t, err)
+			if testCase.present {
+				query := req.URL.Query()
+				query.Add(testKey, testCase.value)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			result, edgexErr := ParseQueryStringToBool(req, testKey, testCase.defaultValue)
+
+			if testCase.errorExpected {
+				require.Error(t, edgexErr)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestParseIfMatchHeader(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      int64
+		errorExpected bool
+	}{
+		{"absent means any revision", "", pkgCommon.AnyRevision, false},
+		{"wildcard means any revision", "*", pkgCommon.AnyRevision, false},
+		{"quoted revision", `"3"`, 3, false},
+		{"unquoted revision", "3", 3, false},
+		{"invalid revision", `"abc"`, 0, true},
+		{"negative revision", `"-3"`, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPatch, "/", http.NoBody)
+			require.NoError(t, err)
+			if testCase.value != "" {
+				req.Header.Set(pkgCommon.IfMatch, testCase.value)
+			}
+
+			revision, edgexErr := ParseIfMatchHeader(req)
+
+			if testCase.errorExpected {
+				require.Error(t, edgexErr)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, testCase.expected, revision)
+		})
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	mismatch := errors.NewCommonEdgeXWrapper(errors.NewCommonEdgeX(pkgCommon.KindRevisionMismatch, "revision mismatch", nil))
+	assert.Equal(t, http.StatusPreconditionFailed, ErrorCode(mismatch))
+	forbidden := errors.NewCommonEdgeXWrapper(errors.NewCommonEdgeX(pkgCommon.KindForbidden, "access denied", nil))
+	assert.Equal(t, http.StatusForbidden, ErrorCode(forbidden))
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	assert.Equal(t, http.StatusNotFound, ErrorCode(notFound))
+}
@@ -15,6 +15,12 @@ var RESTSenderName = di.TypeInstanceToName(RESTSender{})
 // EmailSenderName contains the name of the channel.EmailSender implementation in the DIC.
 var EmailSenderName = di.TypeInstanceToName(EmailSender{})
 
+// MQTTSenderName contains the name of the channel.MQTTSender implementation in the DIC.
+var MQTTSenderName = di.TypeInstanceToName(MQTTSender{})
+
+// MessageBusSenderName contains the name of the channel.MessageBusSender implementation in the DIC.
+var MessageBusSenderName = di.TypeInstanceToName(MessageBusSender{})
+
 // RESTSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
 func RESTSenderFrom(get di.Get) Sender {
 	return get(RESTSenderName).(Sender)
@@ -24,3 +30,18 @@ func RESTSenderFrom(get di.Get) Sender {
 func EmailSenderFrom(get di.Get) Sender {
 	return get(EmailSenderName).(Sender)
 }
+
+// MQTTSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func MQTTSenderFrom(get di.Get) Sender {
+	return get(MQTTSenderName).(Sender)
+}
+
+// MessageBusSenderFrom helper function queries the DIC and returns the channel.Sender implementation, nil if the
+// message bus channel is disabled.
+func MessageBusSenderFrom(get di.Get) Sender {
+	sender, ok := get(MessageBusSenderName).(Sender)
+	if !ok {
+		return nil
+	}
+	return sender
+}
@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MessageBus is the type of the channels which publish the notifications on the EdgeX MessageBus
+	MessageBus = "MESSAGEBUS"
+	// MessageBusHost is the host of the MQTT address of a subscription channel which publishes on the EdgeX MessageBus
+	// rather than to an MQTT broker, since go-mod-core-contracts accepts no other address type. The topic of the
+	// address is appended to the MessageQueue.PublishTopicPrefix, the other fields are checked by
+	// ValidateMessageBusAddress when the subscription is added or patched.
+	MessageBusHost = "edgex-messagebus"
+	// MessageBusPublisher is the publisher of the message bus channels, which is support-notifications itself
+	MessageBusPublisher = common.SupportNotificationsServiceKey
+)
+
+// Type returns the type of the channel of the address, i.e. MessageBus or the address type
+func Type(address models.Address) string {
+	if a, ok := address.(models.MQTTPubAddress); ok && a.Host == MessageBusHost {
+		return MessageBus
+	}
+	return address.GetBaseAddress().Type
+}
+
+// ValidateMessageBusAddress rejects the address with the MessageBusHost unless it follows the convention of the message
+// bus channels, so that an MQTT broker reachable under that host isn't silently replaced by the message bus. The port
+// has to be the one of the MessageQueue and the publisher MessageBusPublisher, the MQTT options have to be unset. The
+// addresses of the other hosts are always accepted.
+func ValidateMessageBusAddress(address models.Address, messageQueue bootstrapConfig.MessageBusInfo) errors.EdgeX {
+	if Type(address) != MessageBus {
+		return nil
+	}
+	a := address.(models.MQTTPubAddress)
+	if messageQueue.PublishTopicPrefix == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the address with host %s is a message bus channel, which is disabled since MessageQueue.PublishTopicPrefix is blank", MessageBusHost), nil)
+	}
+	if a.Port != messageQueue.Port {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the port of the message bus channel has to be the MessageQueue port %d rather than %d", messageQueue.Port, a.Port), nil)
+	}
+	if a.Publisher != MessageBusPublisher {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the publisher of the message bus channel has to be %s rather than %s", MessageBusPublisher, a.Publisher), nil)
+	}
+	if a.QoS != 0 || a.KeepAlive != 0 || a.Retained || a.AutoReconnect || a.ConnectTimeout != 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			"the message bus channel doesn't take the qos, keepAlive, retained, autoReconnect and connectTimeout of an MQTT broker", nil)
+	}
+	return nil
+}
+
+// MessagePublisher publishes the message envelopes on the MessageBus, it is satisfied by messaging.MessageClient
+type MessagePublisher interface {
+	Publish(message types.MessageEnvelope, topic string) error
+}
+
+// MessageBusSender is the implementation of the interfaces.ChannelSender, which is used to publish the notifications on
+// the EdgeX MessageBus, so that the app services and rules engines consume them without exposing a REST endpoint
+type MessageBusSender struct {
+	dic       *di.Container
+	publisher MessagePublisher
+}
+
+// NewMessageBusSender creates the MessageBusSender instance publishing through the connected MessageBus client
+func NewMessageBusSender(dic *di.Container, publisher MessagePublisher) Sender {
+	return &MessageBusSender{dic: dic, publisher: publisher}
+}
+
+// Send publishes the notification DTO as a JSON MessageEnvelope. The subject is not used, the topic is the configured
+// MessageQueue.PublishTopicPrefix followed by the topic of the address.
+func (sender *MessageBusSender) Send(notification models.Notification, _ string, address models.Address) (res string, err errors.EdgeX) {
+	mqttAddress, ok := address.(models.MQTTPubAddress)
+	if !ok || mqttAddress.Host != MessageBusHost {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%v is not a message bus channel", address.GetBaseAddress()), nil)
+	}
+	prefix := notificationContainer.ConfigurationFrom(sender.dic.Get).MessageQueue.PublishTopicPrefix
+	topic := MessageBusTopic(prefix, mqttAddress.Topic)
+
+	payload, jsonErr := json.Marshal(dtos.FromNotificationModelToDTO(notification))
+	if jsonErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to encode the notification", jsonErr)
+	}
+	envelope := types.MessageEnvelope{
+		CorrelationID: uuid.New().String(),
+		ContentType:   common.ContentTypeJSON,
+		Payload:       payload,
+	}
+	if publishErr := sender.publisher.Publish(envelope, topic); publishErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to publish the notification to topic %s", topic), publishErr)
+	}
+	return "", nil
+}
+
+// MessageBusTopic returns the topic the notification of the channel is published to, the prefix itself if the channel
+// has no topic
+func MessageBusTopic(prefix string, topic string) string {
+	topic = strings.Trim(topic, "/")
+	if topic == "" {
+		return prefix
+	}
+	return prefix + "/" + topic
+}
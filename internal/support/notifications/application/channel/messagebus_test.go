@@ -0,0 +1,141 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPublishTopicPrefix = "edgex/notifications"
+
+type publisherStub struct {
+	topic    string
+	envelope types.MessageEnvelope
+	err      error
+}
+
+func (p *publisherStub) Publish(message types.MessageEnvelope, topic string) error {
+	p.topic = topic
+	p.envelope = message
+	return p.err
+}
+
+func TestMessageBusSender(t *testing.T) {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				MessageQueue: bootstrapConfig.MessageBusInfo{PublishTopicPrefix: testPublishTopicPrefix},
+			}
+		},
+	})
+	notification := models.Notification{
+		Id:       "3c5badcb-2008-47f2-ba78-eb2d992f8422",
+		Category: "health-check",
+		Severity: models.Critical,
+		Labels:   []string{"ahu"},
+		Content:  "fan stopped",
+		Sender:   "device-virtual",
+	}
+
+	address := models.MQTTPubAddress{
+		BaseAddress: models.BaseAddress{Type: common.MQTT, Host: MessageBusHost, Port: 1},
+		Publisher:   "support-notifications",
+		Topic:       "alerts",
+	}
+
+	publisher := &publisherStub{}
+	_, err := NewMessageBusSender(dic, publisher).Send(notification, "", address)
+	require.NoError(t, err)
+	assert.Equal(t, testPublishTopicPrefix+"/alerts", publisher.topic)
+	assert.Equal(t, common.ContentTypeJSON, publisher.envelope.ContentType)
+	assert.NotEmpty(t, publisher.envelope.CorrelationID)
+	var published dtos.Notification
+	require.NoError(t, json.Unmarshal(publisher.envelope.Payload, &published))
+	assert.Equal(t, dtos.FromNotificationModelToDTO(notification), published)
+
+	failing := &publisherStub{err: errors.New("connection refused")}
+	_, err = NewMessageBusSender(dic, failing).Send(notification, "", address)
+	assert.Error(t, err)
+
+	// the MQTT channels of a broker are not published on the MessageBus
+	address.Host = "broker"
+	_, err = NewMessageBusSender(dic, publisher).Send(notification, "", address)
+	assert.Error(t, err)
+}
+
+func TestMessageBusTopic(t *testing.T) {
+	assert.Equal(t, testPublishTopicPrefix+"/security", MessageBusTopic(testPublishTopicPrefix, "security"))
+	assert.Equal(t, testPublishTopicPrefix+"/security/high", MessageBusTopic(testPublishTopicPrefix, "/security/high/"))
+	assert.Equal(t, testPublishTopicPrefix, MessageBusTopic(testPublishTopicPrefix, ""))
+}
+
+func TestType(t *testing.T) {
+	assert.Equal(t, MessageBus, Type(models.MQTTPubAddress{BaseAddress: models.BaseAddress{Type: common.MQTT, Host: MessageBusHost}}))
+	assert.Equal(t, common.MQTT, Type(models.MQTTPubAddress{BaseAddress: models.BaseAddress{Type: common.MQTT, Host: "broker"}}))
+	assert.Equal(t, common.REST, Type(models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: MessageBusHost}}))
+}
+
+func TestValidateMessageBusAddress(t *testing.T) {
+	messageQueue := bootstrapConfig.MessageBusInfo{Port: 6379, PublishTopicPrefix: testPublishTopicPrefix}
+	valid := models.MQTTPubAddress{
+		BaseAddress: models.BaseAddress{Type: common.MQTT, Host: MessageBusHost, Port: 6379},
+		Publisher:   MessageBusPublisher,
+		Topic:       "alerts",
+	}
+	otherPort := valid
+	otherPort.Port = 1883
+	otherPublisher := valid
+	otherPublisher.Publisher = "broker-client"
+	qos := valid
+	qos.QoS = 1
+	retained := valid
+	retained.Retained = true
+	broker := valid
+	broker.Host = "mqtt-broker"
+	broker.Port = 1883
+	broker.QoS = 1
+
+	tests := []struct {
+		name          string
+		address       models.Address
+		messageQueue  bootstrapConfig.MessageBusInfo
+		errorExpected bool
+	}{
+		{"valid", valid, messageQueue, false},
+		{"MQTT broker", broker, messageQueue, false},
+		{"REST", models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: MessageBusHost, Port: 80}}, messageQueue, false},
+		{"message bus channel disabled", valid, bootstrapConfig.MessageBusInfo{Port: 6379}, true},
+		{"other port", otherPort, messageQueue, true},
+		{"other publisher", otherPublisher, messageQueue, true},
+		{"QoS", qos, messageQueue, true},
+		{"retained", retained, messageQueue, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateMessageBusAddress(testCase.address, testCase.messageQueue)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
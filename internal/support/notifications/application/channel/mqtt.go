@@ -0,0 +1,221 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	// MqttAuthModeNone connects to the broker without credentials
+	MqttAuthModeNone = "none"
+	// MqttAuthModeUsernamePassword connects to the broker with the username and password read from the secret path
+	MqttAuthModeUsernamePassword = "usernamepassword"
+
+	defaultMqttTimeout = 5 * time.Second
+)
+
+// MQTTSender is the implementation of the interfaces.ChannelSender, which is used to publish the notifications to a
+// MQTT broker. The connections are kept by broker, publisher and connection options, so that the subscriptions
+// publishing through the same client don't reconnect for every notification.
+type MQTTSender struct {
+	dic     *di.Container
+	mutex   sync.Mutex
+	clients map[string]mqttClient
+	// connecting holds a lock per publisher, so a slow broker only stalls the notifications of its own publisher
+	connecting map[string]*sync.Mutex
+}
+
+// mqttClient is a connected client along with the broker and publisher it connects as
+type mqttClient struct {
+	mqtt.Client
+	publisher string
+}
+
+// NewMQTTSender creates the MQTTSender instance
+func NewMQTTSender(dic *di.Container) *MQTTSender {
+	return &MQTTSender{dic: dic, clients: make(map[string]mqttClient), connecting: make(map[string]*sync.Mutex)}
+}
+
+// Close disconnects the clients kept by the sender, it's called once the service is shutting down
+func (sender *MQTTSender) Close() {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	for key, client := range sender.clients {
+		client.Disconnect(uint(defaultMqttTimeout / time.Millisecond))
+		delete(sender.clients, key)
+	}
+}
+
+// Send publishes the notification content to the topic of the specified address
+func (sender *MQTTSender) Send(notification models.Notification, _ string, address models.Address) (res string, err errors.EdgeX) {
+	mqttAddress, ok := address.(models.MQTTPubAddress)
+	if !ok {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to MQTTPubAddress", nil)
+	}
+	if mqttAddress.QoS < 0 || mqttAddress.QoS > 2 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid QoS %d, it should be 0, 1 or 2", mqttAddress.QoS), nil)
+	}
+
+	client, err := sender.client(mqttAddress)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	token := client.Publish(mqttAddress.Topic, byte(mqttAddress.QoS), mqttAddress.Retained, notification.Content)
+	if !token.WaitTimeout(timeoutOf(mqttAddress)) {
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("timed out publishing to topic %s", mqttAddress.Topic), nil)
+	}
+	if token.Error() != nil {
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to publish to topic %s", mqttAddress.Topic), token.Error())
+	}
+	return "", nil
+}
+
+// publisherKey returns the broker and the publisher of the address, which the broker keeps one connection for
+func publisherKey(address models.MQTTPubAddress) string {
+	return fmt.Sprintf("%s:%d/%s", address.Host, address.Port, address.Publisher)
+}
+
+// clientKey returns the key of the client of the address, which tells the broker, the publisher and the options the
+// client is connected with
+func clientKey(address models.MQTTPubAddress) string {
+	return fmt.Sprintf("%s?keepAlive=%d&autoReconnect=%t&connectTimeout=%d",
+		publisherKey(address), address.KeepAlive, address.AutoReconnect, address.ConnectTimeout)
+}
+
+// client returns the connected client of the address, connecting it if there is none or the previous one has lost its
+// connection and doesn't reconnect automatically. A client of the same publisher connected with other options is
+// disconnected, since the broker only keeps one connection per client id. The connection is made under the lock of the
+// publisher rather than the one of the sender, so the clients of the other publishers are returned meanwhile.
+func (sender *MQTTSender) client(address models.MQTTPubAddress) (mqtt.Client, errors.EdgeX) {
+	key := clientKey(address)
+	publisher := publisherKey(address)
+
+	lock := sender.publisherLock(publisher)
+	lock.Lock()
+	defer lock.Unlock()
+
+	sender.mutex.Lock()
+	if client, ok := sender.clients[key]; ok {
+		if client.IsConnectionOpen() || (address.AutoReconnect && client.IsConnected()) {
+			sender.mutex.Unlock()
+			return client.Client, nil
+		}
+	}
+	var stale []mqtt.Client
+	for k, client := range sender.clients {
+		if client.publisher == publisher {
+			stale = append(stale, client.Client)
+			delete(sender.clients, k)
+		}
+	}
+	sender.mutex.Unlock()
+	for _, client := range stale {
+		client.Disconnect(0)
+	}
+
+	mqttInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Mqtt
+	opts, err := buildMqttOptions(sender.dic, mqttInfo, address)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	if !token.WaitTimeout(timeoutOf(address)) {
+		client.Disconnect(0)
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("timed out connecting to the MQTT broker %s", opts.Servers[0]), nil)
+	}
+	if token.Error() != nil {
+		client.Disconnect(0)
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to connect to the MQTT broker %s", opts.Servers[0]), token.Error())
+	}
+	sender.mutex.Lock()
+	sender.clients[key] = mqttClient{Client: client, publisher: publisher}
+	sender.mutex.Unlock()
+	return client, nil
+}
+
+// publisherLock returns the lock which serializes the connections of the publisher
+func (sender *MQTTSender) publisherLock(publisher string) *sync.Mutex {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	lock, ok := sender.connecting[publisher]
+	if !ok {
+		lock = &sync.Mutex{}
+		sender.connecting[publisher] = lock
+	}
+	return lock
+}
+
+func buildMqttOptions(dic *di.Container, m config.MqttInfo, address models.MQTTPubAddress) (*mqtt.ClientOptions, errors.EdgeX) {
+	scheme := strings.ToLower(m.Protocol)
+	if scheme == "" {
+		scheme = "tcp"
+	}
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(fmt.Sprintf("%s://%s:%d", scheme, address.Host, address.Port))
+	opts.SetClientID(address.Publisher)
+	opts.SetAutoReconnect(address.AutoReconnect)
+	opts.SetConnectTimeout(timeoutOf(address))
+	if address.KeepAlive > 0 {
+		opts.SetKeepAlive(time.Duration(address.KeepAlive) * time.Second)
+	}
+	if scheme == "ssl" || scheme == "tls" || scheme == "mqtts" {
+		opts.SetTLSConfig(&tls.Config{InsecureSkipVerify: m.SkipCertVerify})
+	}
+
+	switch strings.ToLower(m.AuthMode) {
+	case "", MqttAuthModeNone:
+	case MqttAuthModeUsernamePassword:
+		username, password, err := mqttCredentials(dic, m.SecretPath)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		opts.SetUsername(username)
+		opts.SetPassword(password)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported MQTT AuthMode %s", m.AuthMode), nil)
+	}
+	return opts, nil
+}
+
+func mqttCredentials(dic *di.Container, secretPath string) (string, string, errors.EdgeX) {
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing. Make sure it is specified to be used in bootstrap.Run()", nil)
+	}
+	secrets, err := secretProvider.GetSecret(secretPath, secretKeyUsername, secretKeyPassword)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the secrets from the secret store", err)
+	}
+	username, exists := secrets[secretKeyUsername]
+	if !exists || username == "" {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, "username doesn't exist for MQTT auth", nil)
+	}
+	return username, secrets[secretKeyPassword], nil
+}
+
+// timeoutOf returns the ConnectTimeout in seconds of the address, which also limits how long a publish may take
+func timeoutOf(address models.MQTTPubAddress) time.Duration {
+	if address.ConnectTimeout > 0 {
+		return time.Duration(address.ConnectTimeout) * time.Second
+	}
+	return defaultMqttTimeout
+}
@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretPath        = "mqtt"
+	testInvalidSecretPath = "invalid"
+)
+
+func TestBuildMqttOptions(t *testing.T) {
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("GetSecret", testSecretPath, secretKeyUsername, secretKeyPassword).
+		Return(map[string]string{secretKeyUsername: "user", secretKeyPassword: "pass"}, nil)
+	secretProvider.On("GetSecret", testInvalidSecretPath, secretKeyUsername, secretKeyPassword).
+		Return(nil, errors.New("secret not found"))
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+	address := models.MQTTPubAddress{
+		BaseAddress: models.BaseAddress{Type: common.MQTT, Host: "localhost", Port: 1883},
+		Publisher:   "notifications",
+		Topic:       "edgex/alerts",
+		KeepAlive:   30,
+	}
+
+	tests := []struct {
+		name             string
+		mqttInfo         config.MqttInfo
+		expectedBroker   string
+		expectedUsername string
+		errorExpected    bool
+	}{
+		{"without auth", config.MqttInfo{}, "tcp://localhost:1883", "", false},
+		{"ssl", config.MqttInfo{Protocol: "SSL", AuthMode: MqttAuthModeNone}, "ssl://localhost:1883", "", false},
+		{"username and password", config.MqttInfo{AuthMode: MqttAuthModeUsernamePassword, SecretPath: testSecretPath}, "tcp://localhost:1883", "user", false},
+		{"secret not found", config.MqttInfo{AuthMode: MqttAuthModeUsernamePassword, SecretPath: testInvalidSecretPath}, "", "", true},
+		{"unsupported auth mode", config.MqttInfo{AuthMode: "clientcert"}, "", "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts, err := buildMqttOptions(dic, testCase.mqttInfo, address)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, opts.Servers, 1)
+			assert.Equal(t, testCase.expectedBroker, opts.Servers[0].String())
+			assert.Equal(t, address.Publisher, opts.ClientID)
+			assert.Equal(t, testCase.expectedUsername, opts.Username)
+			assert.EqualValues(t, 30, opts.KeepAlive)
+			assert.Equal(t, defaultMqttTimeout, opts.ConnectTimeout)
+		})
+	}
+}
+
+func TestMQTTSenderInvalidAddress(t *testing.T) {
+	sender := NewMQTTSender(di.NewContainer(di.ServiceConstructorMap{}))
+	_, err := sender.Send(models.Notification{}, "", models.EmailAddress{})
+	assert.Error(t, err)
+
+	_, err = sender.Send(models.Notification{}, "", models.MQTTPubAddress{Topic: "edgex/alerts", QoS: 3})
+	assert.Error(t, err)
+}
+
+// clientStub is a connected client which records its disconnection, the other methods are not called
+type clientStub struct {
+	mqtt.Client
+	disconnected bool
+}
+
+func (c *clientStub) IsConnected() bool      { return !c.disconnected }
+func (c *clientStub) IsConnectionOpen() bool { return !c.disconnected }
+func (c *clientStub) Disconnect(uint)        { c.disconnected = true }
+
+func TestMQTTSenderClient(t *testing.T) {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+	})
+	address := models.MQTTPubAddress{
+		BaseAddress:    models.BaseAddress{Type: common.MQTT, Host: "127.0.0.1", Port: 1},
+		Publisher:      "notifications",
+		Topic:          "edgex/alerts",
+		KeepAlive:      30,
+		ConnectTimeout: 1,
+	}
+	sender := NewMQTTSender(dic)
+	cached := &clientStub{}
+	sender.clients[clientKey(address)] = mqttClient{Client: cached, publisher: publisherKey(address)}
+
+	// the connected client of the same options is reused
+	client, err := sender.client(address)
+	require.NoError(t, err)
+	assert.Equal(t, cached, client)
+
+	// the client of other options replaces the one of the same publisher
+	other := address
+	other.KeepAlive = 60
+	assert.NotEqual(t, clientKey(address), clientKey(other))
+	_, err = sender.client(other)
+	require.Error(t, err, "no broker listens on the port")
+	assert.True(t, cached.disconnected)
+	assert.Empty(t, sender.clients)
+}
+
+func TestMQTTSenderClientWhileConnecting(t *testing.T) {
+	// the broker accepts the connection but never acknowledges it
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+	})
+	stalled := models.MQTTPubAddress{
+		BaseAddress:    models.BaseAddress{Type: common.MQTT, Host: "127.0.0.1", Port: listener.Addr().(*net.TCPAddr).Port},
+		Publisher:      "stalled",
+		Topic:          "edgex/alerts",
+		ConnectTimeout: 2,
+	}
+	connected := models.MQTTPubAddress{
+		BaseAddress: models.BaseAddress{Type: common.MQTT, Host: "127.0.0.1", Port: 1},
+		Publisher:   "connected",
+		Topic:       "edgex/alerts",
+	}
+	sender := NewMQTTSender(dic)
+	cached := &clientStub{}
+	sender.clients[clientKey(connected)] = mqttClient{Client: cached, publisher: publisherKey(connected)}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := sender.client(stalled)
+		assert.Error(t, err, "the broker never acknowledges the connection")
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// the client of another publisher is returned while the stalled broker is being connected
+	started := time.Now()
+	client, err := sender.client(connected)
+	require.NoError(t, err)
+	assert.Equal(t, cached, client)
+	assert.Less(t, int64(time.Since(started)), int64(time.Second))
+	<-done
+}
+
+func TestMQTTSenderClose(t *testing.T) {
+	sender := NewMQTTSender(di.NewContainer(di.ServiceConstructorMap{}))
+	first, second := &clientStub{}, &clientStub{}
+	sender.clients["first"] = mqttClient{Client: first, publisher: "first"}
+	sender.clients["second"] = mqttClient{Client: second, publisher: "second"}
+
+	sender.Close()
+	assert.True(t, first.disconnected)
+	assert.True(t, second.disconnected)
+	assert.Empty(t, sender.clients)
+}
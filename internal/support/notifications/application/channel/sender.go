@@ -16,9 +16,10 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 )
 
-// Sender abstracts the notification sending via specified channel
+// Sender abstracts the notification sending via specified channel. The subject is rendered by the template of the
+// subscription, the channels whose messages have a subject fall back to the configured one if it is blank.
 type Sender interface {
-	Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX)
+	Send(notification models.Notification, subject string, address models.Address) (res string, err errors.EdgeX)
 }
 
 // RESTSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via REST
@@ -32,7 +33,7 @@ func NewRESTSender(dic *di.Container) Sender {
 }
 
 // Send sends the REST request to the specified address
-func (sender *RESTSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+func (sender *RESTSender) Send(notification models.Notification, _ string, address models.Address) (res string, err errors.EdgeX) {
 	lc := container.LoggingClientFrom(sender.dic.Get)
 
 	restAddress, ok := address.(models.RESTAddress)
@@ -53,15 +54,18 @@ func NewEmailSender(dic *di.Container) Sender {
 }
 
 // Send sends the email to the specified address
-func (sender *EmailSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+func (sender *EmailSender) Send(notification models.Notification, subject string, address models.Address) (res string, err errors.EdgeX) {
 	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Smtp
+	if subject == "" {
+		subject = smtpInfo.Subject
+	}
 
 	emailAddress, ok := address.(models.EmailAddress)
 	if !ok {
 		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to EmailAddress", nil)
 	}
 
-	msg := buildSmtpMessage(notification.Sender, smtpInfo.Subject, emailAddress.Recipients, notification.ContentType, notification.Content)
+	msg := buildSmtpMessage(notification.Sender, subject, emailAddress.Recipients, notification.ContentType, notification.Content)
 	auth, err := deduceAuth(sender.dic, smtpInfo)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
@@ -0,0 +1,286 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+const (
+	// digestResolution is how often the digester looks for the digests which are due to be sent
+	digestResolution = 10 * time.Second
+	// DigestCategory is the category of the digest notification whose notifications have different categories
+	DigestCategory = "digest"
+)
+
+// DigesterName contains the name of the application.Digester implementation in the DIC.
+var DigesterName = di.TypeInstanceToName(Digester{})
+
+// DigesterFrom helper function queries the DIC and returns the application.Digester, nil if the digest mode is not
+// available
+func DigesterFrom(get di.Get) *Digester {
+	digester, ok := get(DigesterName).(*Digester)
+	if !ok {
+		return nil
+	}
+	return digester
+}
+
+// Digester holds back the notifications of the subscriptions in digest mode and sends them as one digest notification
+// per subscription, which is transmitted to each channel of the subscription like any other notification. The pending
+// notifications are kept in the database so the digests which fell due during a restart are sent once the service is up.
+type Digester struct {
+	dic *di.Container
+	// mutex serializes the changes of the pending notifications, so that a digest is never sent twice
+	mutex sync.Mutex
+}
+
+// NewDigester creates the digester of the subscriptions
+func NewDigester(dic *di.Container) *Digester {
+	return &Digester{
+		dic: dic,
+	}
+}
+
+// Start sends the digests which are due in the background until the context is done
+func (d *Digester) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(digestResolution)
+		defer ticker.Stop()
+		for {
+			d.sendDueDigests()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Digest adds the notification to the pending notifications of the subscription if it is in digest mode, the digest is
+// sent right away once it holds MaxNotifications. The returned bool tells whether the notification is held back.
+func (d *Digester) Digest(n models.Notification, sub models.Subscription) (bool, errors.EdgeX) {
+	dbClient := container.DBClientFrom(d.dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(d.dic.Get)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	digest, err := dbClient.SubscriptionDigestBySubscriptionName(sub.Name)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return false, nil
+	} else if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	now := pkgCommon.MakeTimestamp()
+	if len(digest.PendingNotificationIds) == 0 {
+		digest.PendingSince = now
+	}
+	digest.PendingNotificationIds = append(digest.PendingNotificationIds, n.Id)
+	digest.PendingNotifications = append(digest.PendingNotifications, n)
+	err = dbClient.UpdateSubscriptionDigest(digest)
+	if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("notification %s is pending in the digest of subscription %s", n.Id, sub.Name)
+
+	if digest.Due(now) {
+		// the notification is pending already, the digest is retried on the next round if it fails
+		if err = d.send(digest, sub); err != nil {
+			lc.Errorf("fail to send the digest of subscription %s, err: %v", sub.Name, err)
+		}
+	}
+	return true, nil
+}
+
+// Remove deletes the digest of the subscription after sending its pending notifications. A failure to send them is
+// only logged, so that the digest is deleted anyway rather than left behind to be sent again.
+func (d *Digester) Remove(subscriptionName string) errors.EdgeX {
+	dbClient := container.DBClientFrom(d.dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(d.dic.Get)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	digest, err := dbClient.SubscriptionDigestBySubscriptionName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(digest.PendingNotificationIds) > 0 {
+		sub, err := dbClient.SubscriptionByName(subscriptionName)
+		if err == nil {
+			err = d.send(digest, sub)
+		}
+		if err != nil {
+			lc.Errorf("fail to send the %d pending notifications of the digest of subscription %s before deleting it, err: %v",
+				len(digest.PendingNotificationIds), subscriptionName, err)
+		}
+	}
+	err = dbClient.DeleteSubscriptionDigestBySubscriptionName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// sendDueDigests sends the digests whose first pending notification is Interval old, the digests of the locked
+// subscriptions are kept until the subscriptions are unlocked
+func (d *Digester) sendDueDigests() {
+	dbClient := container.DBClientFrom(d.dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(d.dic.Get)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	digests, err := dbClient.AllSubscriptionDigests(0, -1)
+	if err != nil {
+		lc.Errorf("fail to query the subscription digests, err: %v", err)
+		return
+	}
+	now := pkgCommon.MakeTimestamp()
+	for _, digest := range digests {
+		if !digest.Due(now) {
+			continue
+		}
+		sub, err := dbClient.SubscriptionByName(digest.SubscriptionName)
+		if err != nil {
+			lc.Errorf("fail to query the subscription of digest %s, err: %v", digest.Id, err)
+			continue
+		}
+		if sub.AdminState == models.Locked {
+			lc.Debugf("subscription %s is locked, skip the digest transmission", sub.Name)
+			continue
+		}
+		if err = d.send(digest, sub); err != nil {
+			lc.Errorf("fail to send the digest of subscription %s, err: %v", sub.Name, err)
+		}
+	}
+}
+
+// send adds the digest notification of the pending notifications and transmits it to the channels of the subscription.
+// The pending notifications are cleared before the transmissions, which are recorded and resent as usual. The copies
+// held by the digest are sent, only the notifications pending without a copy are queried by id.
+func (d *Digester) send(digest pkgModels.SubscriptionDigest, sub models.Subscription) errors.EdgeX {
+	dbClient := container.DBClientFrom(d.dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(d.dic.Get)
+
+	copies := make(map[string]models.Notification, len(digest.PendingNotifications))
+	for _, n := range digest.PendingNotifications {
+		copies[n.Id] = n
+	}
+	var notifications []models.Notification
+	for _, id := range digest.PendingNotificationIds {
+		if n, ok := copies[id]; ok {
+			notifications = append(notifications, n)
+			continue
+		}
+		n, err := dbClient.NotificationById(id)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Warnf("notification %s pending in the digest of subscription %s no longer exists", id, sub.Name)
+			continue
+		} else if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		notifications = append(notifications, n)
+	}
+
+	var n models.Notification
+	var err errors.EdgeX
+	if len(notifications) > 0 {
+		n, err = dbClient.AddNotification(digestNotification(sub.Name, notifications))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "fail to create the digest notification", err)
+		}
+	}
+
+	digest.PendingNotificationIds = nil
+	digest.PendingNotifications = nil
+	digest.PendingSince = 0
+	err = dbClient.UpdateSubscriptionDigest(digest)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	lc.Debugf("sending the digest notification %s of %d notifications to subscription %s", n.Id, len(notifications), sub.Name)
+	for _, address := range sub.Channels {
+		go transmit(d.dic, n, sub, address)
+	}
+	return nil
+}
+
+// digestNotification groups the notifications into one notification. It has the highest severity of the notifications,
+// their category if they share one and all their labels. The content lists the notifications in the order they came.
+func digestNotification(subscriptionName string, notifications []models.Notification) models.Notification {
+	var severity models.NotificationSeverity = models.Normal
+	category := notifications[0].Category
+	labels := make(map[string]bool)
+	var content strings.Builder
+	for _, n := range notifications {
+		if severityRank(n.Severity) > severityRank(severity) {
+			severity = n.Severity
+		}
+		if n.Category != category {
+			category = DigestCategory
+		}
+		for _, label := range n.Labels {
+			labels[label] = true
+		}
+		created := time.Unix(0, n.Created*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+		fmt.Fprintf(&content, "%s [%s] %s: %s\n", created, n.Severity, n.Category, n.Content)
+	}
+	if category == "" {
+		category = DigestCategory
+	}
+
+	digest := models.Notification{
+		Category:    category,
+		Content:     content.String(),
+		ContentType: common.ContentTypeText,
+		Description: fmt.Sprintf("Digest of %d notifications of subscription %s", len(notifications), subscriptionName),
+		Sender:      common.SupportNotificationsServiceKey,
+		Severity:    severity,
+		Status:      models.Processed,
+	}
+	for label := range labels {
+		digest.Labels = append(digest.Labels, label)
+	}
+	sort.Strings(digest.Labels)
+	return digest
+}
+
+func severityRank(severity models.NotificationSeverity) int {
+	switch severity {
+	case models.Critical:
+		return 2
+	case models.Minor:
+		return 1
+	default:
+		return 0
+	}
+}
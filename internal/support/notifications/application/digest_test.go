@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDigestSubscriptionName = "digestSubscription"
+	testDigestNotificationId   = "digestNotification"
+)
+
+func digestDic(dbClientMock *dbMock.DBClient) (*di.Container, *Digester) {
+	dic := mockDic()
+	digester := NewDigester(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		DigesterName: func(get di.Get) interface{} {
+			return digester
+		},
+	})
+	return dic, digester
+}
+
+func pendingIdsAre(ids ...string) interface{} {
+	return mock.MatchedBy(func(d pkgModels.SubscriptionDigest) bool {
+		if len(d.PendingNotifications) != len(ids) {
+			return false
+		}
+		for i, n := range d.PendingNotifications {
+			if n.Id != ids[i] {
+				return false
+			}
+		}
+		return assert.ObjectsAreEqual(ids, d.PendingNotificationIds)
+	})
+}
+
+func TestDigest(t *testing.T) {
+	digestSub := models.Subscription{Name: testDigestSubscriptionName}
+	first := models.Notification{Id: "first", Category: "health-check", Severity: models.Minor, Content: "down"}
+	second := models.Notification{Id: "second", Category: "health-check", Severity: models.Critical, Content: "still down"}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", sub.Name).Return(pkgModels.SubscriptionDigest{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription digest doesn't exist", nil))
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", testDigestSubscriptionName).Return(pkgModels.SubscriptionDigest{
+		SubscriptionName: testDigestSubscriptionName, Interval: "10m", MaxNotifications: 2,
+	}, nil).Once()
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", testDigestSubscriptionName).Return(pkgModels.SubscriptionDigest{
+		SubscriptionName: testDigestSubscriptionName, Interval: "10m", MaxNotifications: 2,
+		PendingNotificationIds: []string{first.Id}, PendingNotifications: []models.Notification{first}, PendingSince: pkgCommon.MakeTimestamp(),
+	}, nil).Once()
+	dbClientMock.On("UpdateSubscriptionDigest", pendingIdsAre(first.Id)).Return(nil).Once()
+	dbClientMock.On("UpdateSubscriptionDigest", pendingIdsAre(first.Id, second.Id)).Return(nil).Once()
+	dbClientMock.On("UpdateSubscriptionDigest", pendingIdsAre()).Return(nil).Once()
+	dbClientMock.On("AddNotification", mock.MatchedBy(func(n models.Notification) bool {
+		return n.Severity == models.Critical && n.Category == "health-check"
+	})).Return(models.Notification{Id: testDigestNotificationId}, nil).Once()
+	_, digester := digestDic(dbClientMock)
+
+	pending, err := digester.Digest(notification, sub)
+	require.NoError(t, err)
+	assert.False(t, pending, "the notification of the subscription without digest should be transmitted")
+
+	// the first notification is held back, the second one reaches MaxNotifications and sends the digest
+	pending, err = digester.Digest(first, digestSub)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	pending, err = digester.Digest(second, digestSub)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	dbClientMock.AssertExpectations(t)
+}
+
+func TestSendDueDigests(t *testing.T) {
+	now := pkgCommon.MakeTimestamp()
+	due := pkgModels.SubscriptionDigest{SubscriptionName: testDigestSubscriptionName, Interval: "1m",
+		PendingNotificationIds: []string{"gone", notification.Id}, PendingSince: now - time.Hour.Milliseconds()}
+	notDue := pkgModels.SubscriptionDigest{SubscriptionName: "notDue", Interval: "1h",
+		PendingNotificationIds: []string{notification.Id}, PendingSince: now}
+	locked := pkgModels.SubscriptionDigest{SubscriptionName: "locked", Interval: "1m",
+		PendingNotificationIds: []string{notification.Id}, PendingSince: now - time.Hour.Milliseconds()}
+	empty := pkgModels.SubscriptionDigest{SubscriptionName: "empty", Interval: "1m"}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptionDigests", 0, -1).Return([]pkgModels.SubscriptionDigest{due, notDue, locked, empty}, nil)
+	dbClientMock.On("SubscriptionByName", testDigestSubscriptionName).Return(models.Subscription{Name: testDigestSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "locked").Return(models.Subscription{Name: "locked", AdminState: models.Locked}, nil)
+	dbClientMock.On("NotificationById", "gone").Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification doesn't exist", nil))
+	dbClientMock.On("NotificationById", notification.Id).Return(notification, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(models.Notification{Id: testDigestNotificationId}, nil).Once()
+	dbClientMock.On("UpdateSubscriptionDigest", mock.MatchedBy(func(d pkgModels.SubscriptionDigest) bool {
+		return d.SubscriptionName == testDigestSubscriptionName && len(d.PendingNotificationIds) == 0 && d.PendingSince == 0
+	})).Return(nil).Once()
+	_, digester := digestDic(dbClientMock)
+
+	digester.sendDueDigests()
+	dbClientMock.AssertExpectations(t)
+}
+
+func TestSendDigestOfPurgedNotification(t *testing.T) {
+	digest := pkgModels.SubscriptionDigest{SubscriptionName: testDigestSubscriptionName, Interval: "1m",
+		PendingNotificationIds: []string{notification.Id}, PendingNotifications: []models.Notification{notification},
+		PendingSince: pkgCommon.MakeTimestamp() - time.Hour.Milliseconds()}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptionDigests", 0, -1).Return([]pkgModels.SubscriptionDigest{digest}, nil)
+	dbClientMock.On("SubscriptionByName", testDigestSubscriptionName).Return(models.Subscription{Name: testDigestSubscriptionName}, nil)
+	dbClientMock.On("AddNotification", mock.MatchedBy(func(n models.Notification) bool {
+		return strings.Contains(n.Content, notification.Content)
+	})).Return(models.Notification{Id: testDigestNotificationId}, nil).Once()
+	dbClientMock.On("UpdateSubscriptionDigest", mock.MatchedBy(func(d pkgModels.SubscriptionDigest) bool {
+		return len(d.PendingNotificationIds) == 0 && len(d.PendingNotifications) == 0
+	})).Return(nil).Once()
+	_, digester := digestDic(dbClientMock)
+
+	// the copy held by the digest is sent although the notification was purged
+	digester.sendDueDigests()
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNotCalled(t, "NotificationById", notification.Id)
+}
+
+func TestDigesterRemove(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", testDigestSubscriptionName).Return(pkgModels.SubscriptionDigest{
+		SubscriptionName: testDigestSubscriptionName, Interval: "1h",
+		PendingNotificationIds: []string{notification.Id}, PendingSince: pkgCommon.MakeTimestamp(),
+	}, nil)
+	dbClientMock.On("SubscriptionByName", testDigestSubscriptionName).Return(models.Subscription{Name: testDigestSubscriptionName}, nil)
+	dbClientMock.On("NotificationById", notification.Id).Return(notification, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(models.Notification{Id: testDigestNotificationId}, nil).Once()
+	dbClientMock.On("UpdateSubscriptionDigest", pendingIdsAre()).Return(nil).Once()
+	dbClientMock.On("DeleteSubscriptionDigestBySubscriptionName", testDigestSubscriptionName).Return(nil).Once()
+	_, digester := digestDic(dbClientMock)
+
+	// the pending notifications are sent before the digest is deleted
+	err := digester.Remove(testDigestSubscriptionName)
+	require.NoError(t, err)
+	dbClientMock.AssertExpectations(t)
+}
+
+func TestDigesterRemoveSendFailed(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", testDigestSubscriptionName).Return(pkgModels.SubscriptionDigest{
+		SubscriptionName: testDigestSubscriptionName, Interval: "1h",
+		PendingNotificationIds: []string{notification.Id}, PendingSince: pkgCommon.MakeTimestamp(),
+	}, nil)
+	dbClientMock.On("SubscriptionByName", testDigestSubscriptionName).Return(models.Subscription{Name: testDigestSubscriptionName}, nil)
+	dbClientMock.On("NotificationById", notification.Id).Return(notification, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "database unavailable", nil))
+	dbClientMock.On("DeleteSubscriptionDigestBySubscriptionName", testDigestSubscriptionName).Return(nil).Once()
+	_, digester := digestDic(dbClientMock)
+
+	// the digest is deleted even though its pending notifications fail to be sent
+	err := digester.Remove(testDigestSubscriptionName)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteSubscriptionDigestBySubscriptionName", testDigestSubscriptionName)
+}
+
+func TestDigestNotification(t *testing.T) {
+	notifications := []models.Notification{
+		{Category: "health-check", Labels: []string{"b", "a"}, Severity: models.Minor, Content: "down"},
+		{Category: "health-check", Labels: []string{"a"}, Severity: models.Normal, Content: "up"},
+	}
+	notifications[0].Created = 1600000000000
+	notifications[1].Created = 1600000060000
+
+	digest := digestNotification(testDigestSubscriptionName, notifications)
+	assert.Equal(t, "health-check", digest.Category)
+	assert.Equal(t, []string{"a", "b"}, digest.Labels)
+	assert.Equal(t, models.NotificationSeverity(models.Minor), digest.Severity)
+	assert.Equal(t, common.ContentTypeText, digest.ContentType)
+	assert.Equal(t, common.SupportNotificationsServiceKey, digest.Sender)
+	assert.Equal(t, models.NotificationStatus(models.Processed), digest.Status)
+	assert.Equal(t, "2020-09-13T12:26:40Z [MINOR] health-check: down\n2020-09-13T12:27:40Z [NORMAL] health-check: up\n", digest.Content)
+
+	notifications[1].Category = "other"
+	notifications[1].Severity = models.Critical
+	digest = digestNotification(testDigestSubscriptionName, notifications)
+	assert.Equal(t, DigestCategory, digest.Category)
+	assert.Equal(t, models.NotificationSeverity(models.Critical), digest.Severity)
+}
+
+func TestSubscriptionDigestDue(t *testing.T) {
+	now := pkgCommon.MakeTimestamp()
+	tests := []struct {
+		name     string
+		digest   pkgModels.SubscriptionDigest
+		expected bool
+	}{
+		{"nothing pending", pkgModels.SubscriptionDigest{Interval: "1m", MaxNotifications: 1}, false},
+		{"interval elapsed", pkgModels.SubscriptionDigest{Interval: "1m", PendingNotificationIds: []string{"1"}, PendingSince: now - time.Minute.Milliseconds()}, true},
+		{"interval not elapsed", pkgModels.SubscriptionDigest{Interval: "1m", PendingNotificationIds: []string{"1"}, PendingSince: now}, false},
+		{"max notifications reached", pkgModels.SubscriptionDigest{MaxNotifications: 2, PendingNotificationIds: []string{"1", "2"}, PendingSince: now}, true},
+		{"max notifications not reached", pkgModels.SubscriptionDigest{MaxNotifications: 3, PendingNotificationIds: []string{"1", "2"}, PendingSince: now}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.digest.Due(now))
+		})
+	}
+}
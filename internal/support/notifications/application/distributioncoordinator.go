@@ -29,11 +29,20 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	digester := DigesterFrom(dic.Get)
 	for _, sub := range subs {
 		if sub.AdminState == models.Locked {
 			lc.Debugf("subscription %s is locked, skip the notification transmission", sub.Name)
 			continue
 		}
+		if digester != nil {
+			pending, err := digester.Digest(n, sub)
+			if err != nil {
+				lc.Errorf("fail to add the notification to the digest of subscription %s, transmit it right away, err: %v", sub.Name, err)
+			} else if pending {
+				continue
+			}
+		}
 		for _, address := range sub.Channels {
 			// Async transmit the notification to improve the performance
 			go transmit(dic, n, sub, address)
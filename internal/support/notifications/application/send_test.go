@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"testing"
 
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
@@ -66,15 +67,49 @@ var testEmailAddress2 = models.EmailAddress{
 	BaseAddress: models.BaseAddress{Type: common.EMAIL, Host: testHost, Port: testPort},
 	Recipients:  []string{"test2@gamil.com"},
 }
+var testMqttAddress = models.MQTTPubAddress{
+	BaseAddress: models.BaseAddress{Type: common.MQTT, Host: testHost, Port: testPort},
+	Publisher:   "publisher",
+	Topic:       "topic1",
+}
+var testMqttAddress2 = models.MQTTPubAddress{
+	BaseAddress: models.BaseAddress{Type: common.MQTT, Host: testHost, Port: testPort},
+	Publisher:   "publisher",
+	Topic:       "topic2",
+}
+
+var testMessageBusAddress = models.MQTTPubAddress{
+	BaseAddress: models.BaseAddress{Type: common.MQTT, Host: channel.MessageBusHost, Port: testPort},
+	Publisher:   "publisher",
+	Topic:       "alerts",
+}
+var testMessageBusAddress2 = models.MQTTPubAddress{
+	BaseAddress: models.BaseAddress{Type: common.MQTT, Host: channel.MessageBusHost, Port: testPort},
+	Publisher:   "publisher",
+	Topic:       "alerts2",
+}
 
 func TestFirstSend(t *testing.T) {
 	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", sub.Name).Return(pkgModels.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription template doesn't exist", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
 	restSender := &senderMock.Sender{}
-	restSender.On("Send", notification, testRestAddress).Return("", nil)
-	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	restSender.On("Send", notification, "", testRestAddress).Return("", nil)
+	restSender.On("Send", notification, "", testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
 	emailSender := &senderMock.Sender{}
-	emailSender.On("Send", notification, testEmailAddress).Return("", nil)
-	emailSender.On("Send", notification, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	emailSender.On("Send", notification, "", testEmailAddress).Return("", nil)
+	emailSender.On("Send", notification, "", testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	mqttSender := &senderMock.Sender{}
+	mqttSender.On("Send", notification, "", testMqttAddress).Return("", nil)
+	mqttSender.On("Send", notification, "", testMqttAddress2).Return("", errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to publish", nil))
+	messageBusSender := &senderMock.Sender{}
+	messageBusSender.On("Send", notification, "", testMessageBusAddress).Return("", nil)
+	messageBusSender.On("Send", notification, "", testMessageBusAddress2).Return("", errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to publish", nil))
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -82,6 +117,12 @@ func TestFirstSend(t *testing.T) {
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return mqttSender
+		},
+		channel.MessageBusSenderName: func(get di.Get) interface{} {
+			return messageBusSender
+		},
 	})
 
 	tests := []struct {
@@ -93,6 +134,10 @@ func TestFirstSend(t *testing.T) {
 		{"sent email address successful", testEmailAddress, false},
 		{"sent rest failed", testRestAddress2, true},
 		{"sent email failed", testEmailAddress2, true},
+		{"sent mqtt address successful", testMqttAddress, false},
+		{"sent mqtt failed", testMqttAddress2, true},
+		{"sent message bus address successful", testMessageBusAddress, false},
+		{"sent message bus failed", testMessageBusAddress2, true},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -116,6 +161,7 @@ func TestReSend(t *testing.T) {
 	config := notificationContainer.ConfigurationFrom(dic.Get)
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", sub.Name).Return(pkgModels.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription template doesn't exist", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -123,11 +169,17 @@ func TestReSend(t *testing.T) {
 	})
 
 	restSender := &senderMock.Sender{}
-	restSender.On("Send", notification, testRestAddress).Return("", nil)
-	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	restSender.On("Send", notification, "", testRestAddress).Return("", nil)
+	restSender.On("Send", notification, "", testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
 	emailSender := &senderMock.Sender{}
-	emailSender.On("Send", notification, testEmailAddress).Return("", nil)
-	emailSender.On("Send", notification, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	emailSender.On("Send", notification, "", testEmailAddress).Return("", nil)
+	emailSender.On("Send", notification, "", testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	mqttSender := &senderMock.Sender{}
+	mqttSender.On("Send", notification, "", testMqttAddress).Return("", nil)
+	mqttSender.On("Send", notification, "", testMqttAddress2).Return("", errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to publish", nil))
+	messageBusSender := &senderMock.Sender{}
+	messageBusSender.On("Send", notification, "", testMessageBusAddress).Return("", nil)
+	messageBusSender.On("Send", notification, "", testMessageBusAddress2).Return("", errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to publish", nil))
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -135,6 +187,12 @@ func TestReSend(t *testing.T) {
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return mqttSender
+		},
+		channel.MessageBusSenderName: func(get di.Get) interface{} {
+			return messageBusSender
+		},
 	})
 
 	tests := []struct {
@@ -146,6 +204,10 @@ func TestReSend(t *testing.T) {
 		{"sent email address successful", testEmailAddress, false},
 		{"sent rest failed", testRestAddress2, true},
 		{"sent email failed", testEmailAddress2, true},
+		{"sent mqtt address successful", testMqttAddress, false},
+		{"sent mqtt failed", testMqttAddress2, true},
+		{"sent message bus address successful", testMessageBusAddress, false},
+		{"sent message bus failed", testMessageBusAddress2, true},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -167,3 +229,82 @@ func TestReSend(t *testing.T) {
 		})
 	}
 }
+
+func TestSendNotificationViaChannelWithTemplate(t *testing.T) {
+	templated := "templated"
+	brokenTemplate := "brokenTemplate"
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", templated).Return(pkgModels.SubscriptionTemplate{
+		SubscriptionName: templated,
+		Templates: []pkgModels.ChannelTemplate{
+			{ChannelType: common.EMAIL, Engine: pkgModels.TemplateEngineHTML, Subject: "[{{upper .Severity}}] {{.Category}}", Body: "<p>{{.Content}}</p>"},
+			{ChannelType: common.MQTT, Engine: pkgModels.TemplateEngineText, Body: "mqtt {{.Content}}"},
+			{ChannelType: channel.MessageBus, Engine: pkgModels.TemplateEngineText, Body: "{{.Content}} via {{.ChannelType}}"},
+			{Engine: pkgModels.TemplateEngineText, Body: `{"text":{{json .Content}}}`},
+		},
+	}, nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", brokenTemplate).Return(pkgModels.SubscriptionTemplate{
+		SubscriptionName: brokenTemplate,
+		Templates:        []pkgModels.ChannelTemplate{{Engine: pkgModels.TemplateEngineText, Body: "{{.Unknown}}"}},
+	}, nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", sub.Name).Return(pkgModels.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription template doesn't exist", nil))
+
+	emailNotification := notification
+	emailNotification.Content = "<p>test</p>"
+	emailNotification.ContentType = "text/html"
+	restNotification := notification
+	restNotification.Content = `{"text":"test"}`
+	emailSender := &senderMock.Sender{}
+	emailSender.On("Send", emailNotification, "[NORMAL] health-check", testEmailAddress).Return("", nil)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", restNotification, "", testRestAddress).Return("", nil)
+	restSender.On("Send", notification, "", testRestAddress).Return("", nil)
+	messageBusNotification := notification
+	messageBusNotification.Content = notification.Content + " via " + channel.MessageBus
+	messageBusSender := &senderMock.Sender{}
+	messageBusSender.On("Send", messageBusNotification, "", testMessageBusAddress).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+		channel.EmailSenderName: func(get di.Get) interface{} {
+			return emailSender
+		},
+		channel.MessageBusSenderName: func(get di.Get) interface{} {
+			return messageBusSender
+		},
+	})
+
+	tests := []struct {
+		name             string
+		subscriptionName string
+		address          models.Address
+		expectedStatus   models.TransmissionStatus
+	}{
+		{"template of the channel type", templated, testEmailAddress, models.Sent},
+		{"template for all channels", templated, testRestAddress, models.Sent},
+		{"template of the message bus channel", templated, testMessageBusAddress, models.Sent},
+		{"no template", sub.Name, testRestAddress, models.Sent},
+		{"template failed to render", brokenTemplate, testRestAddress, models.Failed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			record := sendNotificationViaChannel(dic, notification, testCase.subscriptionName, testCase.address)
+			assert.Equal(t, testCase.expectedStatus, record.Status)
+		})
+	}
+	emailSender.AssertExpectations(t)
+	restSender.AssertExpectations(t)
+	messageBusSender.AssertExpectations(t)
+}
+
+func TestSendViaDisabledMessageBus(t *testing.T) {
+	dic := mockDic()
+	record := sendNotificationViaChannel(dic, notification, sub.Name, testMessageBusAddress)
+	assert.EqualValues(t, models.Failed, record.Status)
+	assert.NotEmpty(t, record.Response)
+}
@@ -9,7 +9,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -27,6 +31,9 @@ func AddSubscription(d models.Subscription, ctx context.Context, dic *di.Contain
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
+	if err := validateChannels(d, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 	addedSubscription, err := dbClient.AddSubscription(d)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
@@ -35,6 +42,7 @@ func AddSubscription(d models.Subscription, ctx context.Context, dic *di.Contain
 	lc.Debugf("Subscription created on DB successfully. Subscription ID: %s, Correlation-ID: %s ",
 		addedSubscription.Id,
 		correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditSubscription, addedSubscription.Name, nil, dtos.FromSubscriptionModelToDTO(addedSubscription))
 
 	return addedSubscription.Id, nil
 }
@@ -124,10 +132,34 @@ func DeleteSubscriptionByName(name string, ctx context.Context, dic *di.Containe
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
 	dbClient := container.DBClientFrom(dic.Get)
-	err := dbClient.DeleteSubscriptionByName(name)
+	subscription, err := dbClient.SubscriptionByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the template of the subscription goes along with it, and is deleted first so that a failure leaves the
+	// subscription in place to be deleted again rather than an orphan template
+	err = DeleteSubscriptionTemplateBySubscriptionName(name, ctx, dic)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to delete the template of subscription %s", name), err)
+	}
+	// so does the digest, its pending notifications have no channel to go to anymore
+	digest, err := dbClient.SubscriptionDigestBySubscriptionName(name)
+	if err == nil {
+		err = dbClient.DeleteSubscriptionDigestBySubscriptionName(name)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to delete the digest of subscription %s", name), err)
+		}
+		audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditSubscriptionDigest, name, pkgDtos.FromSubscriptionDigestModelToDTO(digest), nil)
+	} else if errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.DeleteSubscriptionByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditSubscription, name, dtos.FromSubscriptionModelToDTO(subscription), nil)
 	return nil
 }
 
@@ -153,11 +185,15 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription name '%s' not match the existing '%s' ", *dto.Name, subscription.Name), nil)
 	}
 
+	before := dtos.FromSubscriptionModelToDTO(subscription)
 	requests.ReplaceSubscriptionModelFieldsWithDTO(&subscription, dto)
 
 	if len(subscription.Categories) == 0 && len(subscription.Labels) == 0 {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription categories and labels can not be both empty", nil)
 	}
+	if edgexErr = validateChannels(subscription, dic); edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 
 	edgexErr = dbClient.UpdateSubscription(subscription)
 	if edgexErr != nil {
@@ -165,5 +201,17 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 	}
 
 	lc.Debugf("Subscription patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditSubscription, subscription.Name, before, dtos.FromSubscriptionModelToDTO(subscription))
+	return nil
+}
+
+// validateChannels checks the channels of the subscription which publish on the message bus
+func validateChannels(subscription models.Subscription, dic *di.Container) errors.EdgeX {
+	messageQueue := container.ConfigurationFrom(dic.Get).MessageQueue
+	for _, address := range subscription.Channels {
+		if err := channel.ValidateMessageBusAddress(address, messageQueue); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid channel of subscription %s", subscription.Name), err)
+		}
+	}
 	return nil
 }
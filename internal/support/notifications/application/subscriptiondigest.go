@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddSubscriptionDigest puts an existing subscription in digest mode, its notifications are held back from then on
+func AddSubscriptionDigest(d pkgModels.SubscriptionDigest, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, err := dbClient.SubscriptionByName(d.SubscriptionName); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	added, err := dbClient.AddSubscriptionDigest(d)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Subscription digest created on DB successfully. Subscription digest ID: %s, Correlation-ID: %s ",
+		added.Id,
+		correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditSubscriptionDigest, added.SubscriptionName, nil, pkgDtos.FromSubscriptionDigestModelToDTO(added))
+
+	return added.Id, nil
+}
+
+// SubscriptionDigestBySubscriptionName queries the digest of the subscription along with its pending notifications
+func SubscriptionDigestBySubscriptionName(name string, dic *di.Container) (d pkgDtos.SubscriptionDigest, err errors.EdgeX) {
+	if name == "" {
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	model, err := dbClient.SubscriptionDigestBySubscriptionName(name)
+	if err != nil {
+		return d, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromSubscriptionDigestModelToDTO(model), nil
+}
+
+// AllSubscriptionDigests queries subscription digests by offset and limit
+func AllSubscriptionDigests(offset, limit int, dic *di.Container) (digests []pkgDtos.SubscriptionDigest, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	models, err := dbClient.AllSubscriptionDigests(offset, limit)
+	if err != nil {
+		return digests, errors.NewCommonEdgeXWrapper(err)
+	}
+	digests = make([]pkgDtos.SubscriptionDigest, len(models))
+	for i, d := range models {
+		digests[i] = pkgDtos.FromSubscriptionDigestModelToDTO(d)
+	}
+	return digests, nil
+}
+
+// DeleteSubscriptionDigestBySubscriptionName takes the subscription out of digest mode, the pending notifications are
+// sent in a last digest if they can be
+func DeleteSubscriptionDigestBySubscriptionName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	d, err := dbClient.SubscriptionDigestBySubscriptionName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if digester := DigesterFrom(dic.Get); digester != nil {
+		err = digester.Remove(name)
+	} else {
+		err = dbClient.DeleteSubscriptionDigestBySubscriptionName(name)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditSubscriptionDigest, name, pkgDtos.FromSubscriptionDigestModelToDTO(d), nil)
+	return nil
+}
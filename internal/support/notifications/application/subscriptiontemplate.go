@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/template"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// AddSubscriptionTemplate adds the template of an existing subscription, the templates are parsed so that the
+// transmissions don't fail on a malformed template
+func AddSubscriptionTemplate(t pkgModels.SubscriptionTemplate, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, err := dbClient.SubscriptionByName(t.SubscriptionName); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, ct := range t.Templates {
+		if err := template.Validate(ct); err != nil {
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	added, err := dbClient.AddSubscriptionTemplate(t)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Subscription template created on DB successfully. Subscription template ID: %s, Correlation-ID: %s ",
+		added.Id,
+		correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditSubscriptionTemplate, added.SubscriptionName, nil, pkgDtos.FromSubscriptionTemplateModelToDTO(added))
+
+	return added.Id, nil
+}
+
+// SubscriptionTemplateBySubscriptionName queries the template of the subscription
+func SubscriptionTemplateBySubscriptionName(name string, dic *di.Container) (t pkgDtos.SubscriptionTemplate, err errors.EdgeX) {
+	if name == "" {
+		return t, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	model, err := dbClient.SubscriptionTemplateBySubscriptionName(name)
+	if err != nil {
+		return t, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromSubscriptionTemplateModelToDTO(model), nil
+}
+
+// AllSubscriptionTemplates queries subscription templates by offset and limit
+func AllSubscriptionTemplates(offset, limit int, dic *di.Container) (templates []pkgDtos.SubscriptionTemplate, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	models, err := dbClient.AllSubscriptionTemplates(offset, limit)
+	if err != nil {
+		return templates, errors.NewCommonEdgeXWrapper(err)
+	}
+	templates = make([]pkgDtos.SubscriptionTemplate, len(models))
+	for i, t := range models {
+		templates[i] = pkgDtos.FromSubscriptionTemplateModelToDTO(t)
+	}
+	return templates, nil
+}
+
+// DeleteSubscriptionTemplateBySubscriptionName deletes the template of the subscription, its notifications are sent
+// verbatim afterwards
+func DeleteSubscriptionTemplateBySubscriptionName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	t, err := dbClient.SubscriptionTemplateBySubscriptionName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteSubscriptionTemplateBySubscriptionName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditSubscriptionTemplate, name, pkgDtos.FromSubscriptionTemplateModelToDTO(t), nil)
+	return nil
+}
+
+// renderNotification renders the notification with the template of the subscription for the channel type of the
+// address, so that the message bus channels have templates apart from the MQTT ones, and returns it along with the
+// rendered subject. The notification is sent verbatim if there is no such template.
+func renderNotification(dic *di.Container, n models.Notification, subscriptionName string, address models.Address) (models.Notification, string, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	t, err := dbClient.SubscriptionTemplateBySubscriptionName(subscriptionName)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return n, "", nil
+	} else if err != nil {
+		return n, "", errors.NewCommonEdgeXWrapper(err)
+	}
+	channelType := channel.Type(address)
+	ct, ok := t.TemplateOf(channelType)
+	if !ok {
+		return n, "", nil
+	}
+
+	message, err := template.Render(ct, template.Data{
+		Notification:     dtos.FromNotificationModelToDTO(n),
+		SubscriptionName: subscriptionName,
+		ChannelType:      channelType,
+	})
+	if err != nil {
+		return n, "", errors.NewCommonEdgeXWrapper(err)
+	}
+	n.Content = message.Content
+	n.ContentType = message.ContentType
+	return n, message.Subject, nil
+}
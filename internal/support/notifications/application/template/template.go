@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package template renders the notifications with the templates of the subscriptions, so that one notification is
+// transmitted in the shape each receiver expects, e.g. a Slack message for a REST channel and an HTML email.
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	htmlTemplate "html/template"
+	"io"
+	"strings"
+	textTemplate "text/template"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const htmlContentType = "text/html"
+
+// Data is what the templates are executed with, the fields of the notification are promoted so that the templates
+// refer to them directly, e.g. {{.Severity}} or {{join .Labels ", "}}
+type Data struct {
+	dtos.Notification
+	SubscriptionName string
+	ChannelType      string
+}
+
+// Message is the notification rendered by a template
+type Message struct {
+	Subject     string
+	Content     string
+	ContentType string
+}
+
+// funcs are the functions available to the templates besides the builtin ones
+var funcs = map[string]interface{}{
+	// json encodes the value as JSON, e.g. to embed the content in the JSON body of a webhook
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	// time formats a timestamp in milliseconds as RFC 3339 in UTC
+	"time": func(millis int64) string {
+		return time.Unix(0, millis*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+	},
+}
+
+// executor is satisfied by both the text and the HTML templates
+type executor interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+func parse(engine string, name string, text string) (executor, error) {
+	if engine == models.TemplateEngineHTML {
+		return htmlTemplate.New(name).Funcs(funcs).Parse(text)
+	}
+	return textTemplate.New(name).Funcs(funcs).Parse(text)
+}
+
+// Validate parses the subject and body of the template
+func Validate(t models.ChannelTemplate) errors.EdgeX {
+	if _, err := parse(models.TemplateEngineText, "subject", t.Subject); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the subject template of channel type '"+t.ChannelType+"'", err)
+	}
+	if _, err := parse(t.Engine, "body", t.Body); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the body template of channel type '"+t.ChannelType+"'", err)
+	}
+	return nil
+}
+
+// Render executes the template with the data. The blank templates leave the subject to the channel and the content to
+// the notification, the content type of the notification applies unless the template has one, the HTML templates
+// default to text/html. The engine only applies to the body, the subject is a plain text header which the HTML engine
+// would escape, so it is always rendered as text.
+func Render(t models.ChannelTemplate, data Data) (Message, errors.EdgeX) {
+	message := Message{Content: data.Content, ContentType: data.ContentType}
+	var err errors.EdgeX
+	if t.Subject != "" {
+		if message.Subject, err = execute(models.TemplateEngineText, "subject", t.Subject, data); err != nil {
+			return message, errors.NewCommonEdgeXWrapper(err)
+		}
+		// the subject is a header, a line break would end it
+		message.Subject = strings.Join(strings.Fields(message.Subject), " ")
+	}
+	if t.Body != "" {
+		if message.Content, err = execute(t.Engine, "body", t.Body, data); err != nil {
+			return message, errors.NewCommonEdgeXWrapper(err)
+		}
+		if t.Engine == models.TemplateEngineHTML {
+			message.ContentType = htmlContentType
+		}
+	}
+	if t.ContentType != "" {
+		message.ContentType = t.ContentType
+	}
+	return message, nil
+}
+
+func execute(engine string, name string, text string, data Data) (string, errors.EdgeX) {
+	e, err := parse(engine, name, text)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the "+name+" template", err)
+	}
+	var buf bytes.Buffer
+	if err = e.Execute(&buf, data); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to render the "+name+" template", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestData() Data {
+	return Data{
+		Notification: dtos.Notification{
+			Category:    "health-check",
+			Labels:      []string{"a", "b"},
+			Content:     `<b>"down"</b>`,
+			ContentType: common.ContentTypeText,
+			Severity:    "CRITICAL",
+			DBTimestamp: dtos.DBTimestamp{Created: 1600000000000},
+		},
+		SubscriptionName: "ops",
+		ChannelType:      common.REST,
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		template models.ChannelTemplate
+		expected Message
+	}{
+		{"blank templates",
+			models.ChannelTemplate{Engine: models.TemplateEngineText},
+			Message{Content: `<b>"down"</b>`, ContentType: common.ContentTypeText}},
+		{"text",
+			models.ChannelTemplate{Engine: models.TemplateEngineText, Subject: "{{lower .Severity}}: {{.Category}}", Body: "{{.SubscriptionName}} {{join .Labels \",\"}} {{.Content}}"},
+			Message{Subject: "critical: health-check", Content: `ops a,b <b>"down"</b>`, ContentType: common.ContentTypeText}},
+		{"multi-line subject",
+			models.ChannelTemplate{Engine: models.TemplateEngineText, Subject: "{{.Category}}\n  at {{time .Created}}"},
+			Message{Subject: "health-check at 2020-09-13T12:26:40Z", Content: `<b>"down"</b>`, ContentType: common.ContentTypeText}},
+		{"html escapes the content",
+			models.ChannelTemplate{Engine: models.TemplateEngineHTML, Body: "<p>{{.Content}}</p>"},
+			Message{Content: "<p>&lt;b&gt;&#34;down&#34;&lt;/b&gt;</p>", ContentType: htmlContentType}},
+		{"html leaves the subject unescaped",
+			models.ChannelTemplate{Engine: models.TemplateEngineHTML, Subject: "{{.Category}} & {{.SubscriptionName}}: {{.Content}}", Body: "<p>{{.Content}}</p>"},
+			Message{Subject: `health-check & ops: <b>"down"</b>`, Content: "<p>&lt;b&gt;&#34;down&#34;&lt;/b&gt;</p>", ContentType: htmlContentType}},
+		{"json with content type",
+			models.ChannelTemplate{Engine: models.TemplateEngineText, Body: `{"text":{{json .Content}},"channel":"{{.ChannelType}}"}`, ContentType: common.ContentTypeJSON},
+			Message{Content: `{"text":"\u003cb\u003e\"down\"\u003c/b\u003e","channel":"REST"}`, ContentType: common.ContentTypeJSON}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			message, err := Render(testCase.template, buildTestData())
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, message)
+		})
+	}
+}
+
+func TestRenderFailed(t *testing.T) {
+	_, err := Render(models.ChannelTemplate{Engine: models.TemplateEngineText, Body: "{{.Unknown}}"}, buildTestData())
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		template      models.ChannelTemplate
+		errorExpected bool
+	}{
+		{"valid", models.ChannelTemplate{Engine: models.TemplateEngineHTML, Subject: "{{.Category}}", Body: "<p>{{.Content}}</p>"}, false},
+		{"invalid subject", models.ChannelTemplate{Engine: models.TemplateEngineText, Subject: "{{.Category"}, true},
+		{"unknown function", models.ChannelTemplate{Engine: models.TemplateEngineText, Body: "{{title .Content}}"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := Validate(testCase.template)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
 )
 
@@ -26,7 +28,14 @@ type ConfigurationStruct struct {
 	Registry    bootstrapConfig.RegistryInfo
 	Service     bootstrapConfig.ServiceInfo
 	Smtp        SmtpInfo
+	Mqtt        MqttInfo
 	SecretStore bootstrapConfig.SecretStoreInfo
+	Audit       audit.TrailInfo
+
+	// MessageQueue configures the message bus channel, which publishes the notifications of the subscriptions whose
+	// MQTT channel has the host edgex-messagebus on the PublishTopicPrefix followed by the topic of the channel. The
+	// channel is disabled if the prefix is blank, and its port has to be the Port of the MessageQueue.
+	MessageQueue bootstrapConfig.MessageBusInfo
 }
 
 type WritableInfo struct {
@@ -53,6 +62,20 @@ type SmtpInfo struct {
 	AuthMode string
 }
 
+// MqttInfo configures how the notifications are published to the brokers of the MQTT addresses, the brokers, topics and
+// QoS are given by the addresses themselves.
+type MqttInfo struct {
+	// Protocol is the scheme of the broker URL, i.e. tcp or ssl
+	Protocol string
+	// SkipCertVerify skips the verification of the broker certificate when Protocol is ssl
+	SkipCertVerify bool
+	// SecretPath is used to specify the secret path to store the credential(username and password) for connecting the MQTT brokers
+	// User need to store the credential via the /secret API before sending the MQTT notification
+	SecretPath string
+	// AuthMode is the MQTT authentication mechanism, either 'none' or 'usernamepassword' with the secret keys 'username' and 'password'.
+	AuthMode string
+}
+
 // The earlier releases do not have Username field and are using Sender field where Usename will
 // be used now, to make it backward compatible fallback to Sender, which is signified by the empty
 // Username field.
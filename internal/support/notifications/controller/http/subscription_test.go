@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
@@ -547,6 +548,7 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 	subscription := dtos.ToSubscriptionModel(addSubscriptionRequestData().Subscription)
 	noName := ""
 	notFoundName := "notFoundName"
+	templateFailedName := "templateFailedName"
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
@@ -554,6 +556,11 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 	dbClientMock.On("DeleteSubscriptionByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", notFoundName).Return(subscription, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", subscription.Name).Return(pkgModels.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription template doesn't exist in the database", nil))
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", subscription.Name).Return(pkgModels.SubscriptionDigest{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription digest doesn't exist in the database", nil))
+	dbClientMock.On("SubscriptionByName", templateFailedName).Return(subscription, nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", templateFailedName).Return(pkgModels.SubscriptionTemplate{SubscriptionName: templateFailedName}, nil)
+	dbClientMock.On("DeleteSubscriptionTemplateBySubscriptionName", templateFailedName).Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "database unavailable", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -571,6 +578,7 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 		{"Valid - delete subscription by name", subscription.Name, http.StatusOK},
 		{"Invalid - name parameter is empty", noName, http.StatusBadRequest},
 		{"Invalid - subscription not found by name", notFoundName, http.StatusNotFound},
+		{"Invalid - subscription template failed to delete", templateFailedName, http.StatusInternalServerError},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -598,6 +606,7 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 			}
 		})
 	}
+	dbClientMock.AssertNotCalled(t, "DeleteSubscriptionByName", templateFailedName)
 }
 
 func TestPatchSubscription(t *testing.T) {
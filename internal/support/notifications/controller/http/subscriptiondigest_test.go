@@ -0,0 +1,215 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSubscriptionDigestId = "6c5b4a39-2817-4f6e-9d5c-4b3a29180f7e"
+
+func buildTestSubscriptionDigest() pkgDtos.SubscriptionDigest {
+	return pkgDtos.SubscriptionDigest{
+		SubscriptionName: testSubscriptionName,
+		Interval:         "15m",
+		MaxNotifications: 50,
+	}
+}
+
+func TestAddSubscriptionDigest(t *testing.T) {
+	valid := pkgRequests.NewAddSubscriptionDigestRequest(buildTestSubscriptionDigest())
+	intervalOnly := pkgRequests.NewAddSubscriptionDigestRequest(buildTestSubscriptionDigest())
+	intervalOnly.SubscriptionDigest.MaxNotifications = 0
+	unknownSubscription := pkgRequests.NewAddSubscriptionDigestRequest(buildTestSubscriptionDigest())
+	unknownSubscription.SubscriptionDigest.SubscriptionName = "unknown"
+	noTrigger := pkgRequests.NewAddSubscriptionDigestRequest(pkgDtos.SubscriptionDigest{SubscriptionName: testSubscriptionName})
+	invalidInterval := pkgRequests.NewAddSubscriptionDigestRequest(buildTestSubscriptionDigest())
+	invalidInterval.SubscriptionDigest.Interval = "soon"
+	negativeInterval := pkgRequests.NewAddSubscriptionDigestRequest(buildTestSubscriptionDigest())
+	negativeInterval.SubscriptionDigest.Interval = "-1m"
+	negativeMax := pkgRequests.NewAddSubscriptionDigestRequest(buildTestSubscriptionDigest())
+	negativeMax.SubscriptionDigest.MaxNotifications = -1
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "unknown").Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("AddSubscriptionDigest", mock.Anything).Return(func(d pkgModels.SubscriptionDigest) pkgModels.SubscriptionDigest {
+		d.Id = testSubscriptionDigestId
+		return d
+	}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionDigestController(dic)
+
+	tests := []struct {
+		name                   string
+		request                pkgRequests.AddSubscriptionDigestRequest
+		isValidRequest         bool
+		expectedHttpStatusCode int
+	}{
+		{"Valid", valid, true, http.StatusCreated},
+		{"Valid - interval only", intervalOnly, true, http.StatusCreated},
+		{"Valid - subscription not found", unknownSubscription, true, http.StatusNotFound},
+		{"Invalid - neither interval nor max notifications", noTrigger, false, http.StatusBadRequest},
+		{"Invalid - malformed interval", invalidInterval, false, http.StatusBadRequest},
+		{"Invalid - negative interval", negativeInterval, false, http.StatusBadRequest},
+		{"Invalid - negative max notifications", negativeMax, false, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]pkgRequests.AddSubscriptionDigestRequest{testCase.request})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiSubscriptionDigestRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddSubscriptionDigest)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.isValidRequest {
+				var res []commonDTO.BaseWithIdResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				require.Len(t, res, 1)
+				assert.Equal(t, testCase.expectedHttpStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+				if testCase.expectedHttpStatusCode == http.StatusCreated {
+					assert.Equal(t, testSubscriptionDigestId, res[0].Id)
+				} else {
+					assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+				}
+			} else {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedHttpStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedHttpStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestSubscriptionDigestBySubscriptionName(t *testing.T) {
+	digest := pkgDtos.ToSubscriptionDigestModel(buildTestSubscriptionDigest())
+	digest.PendingNotificationIds = []string{"pending"}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", testSubscriptionName).Return(digest, nil)
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", "notFound").Return(pkgModels.SubscriptionDigest{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription digest doesn't exist in the database", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionDigestController(dic)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - digest found", testSubscriptionName, http.StatusOK},
+		{"Invalid - digest not found", "notFound", http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiSubscriptionDigestBySubscriptionNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.subscriptionName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.SubscriptionDigestBySubscriptionName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.SubscriptionDigestResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, "15m", res.SubscriptionDigest.Interval)
+				assert.Equal(t, []string{"pending"}, res.SubscriptionDigest.PendingNotificationIds)
+			}
+		})
+	}
+}
+
+func TestDeleteSubscriptionDigestBySubscriptionName(t *testing.T) {
+	digest := pkgDtos.ToSubscriptionDigestModel(buildTestSubscriptionDigest())
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", testSubscriptionName).Return(digest, nil)
+	dbClientMock.On("SubscriptionDigestBySubscriptionName", "notFound").Return(pkgModels.SubscriptionDigest{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription digest doesn't exist in the database", nil))
+	dbClientMock.On("DeleteSubscriptionDigestBySubscriptionName", testSubscriptionName).Return(nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionDigestController(dic)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - digest deleted", testSubscriptionName, http.StatusOK},
+		{"Invalid - digest not found", "notFound", http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, pkgCommon.ApiSubscriptionDigestBySubscriptionNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.subscriptionName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeleteSubscriptionDigestBySubscriptionName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
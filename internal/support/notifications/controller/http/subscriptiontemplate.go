@@ -0,0 +1,145 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/gorilla/mux"
+)
+
+type SubscriptionTemplateController struct {
+	dic *di.Container
+}
+
+// NewSubscriptionTemplateController creates and initializes an SubscriptionTemplateController
+func NewSubscriptionTemplateController(dic *di.Container) *SubscriptionTemplateController {
+	return &SubscriptionTemplateController{
+		dic: dic,
+	}
+}
+
+func (stc *SubscriptionTemplateController) AddSubscriptionTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(stc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqs []pkgRequests.AddSubscriptionTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		edgeXerr, ok := err.(errors.EdgeX)
+		if !ok {
+			edgeXerr = errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription template json decoding failed", err)
+		}
+		utils.WriteErrorResponse(w, ctx, lc, edgeXerr, "")
+		return
+	}
+
+	var addResponses []interface{}
+	for _, req := range reqs {
+		var response interface{}
+		reqId := req.RequestId
+		newId, err := application.AddSubscriptionTemplate(pkgDtos.ToSubscriptionTemplateModel(req.SubscriptionTemplate), ctx, stc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				err.Message(),
+				err.Code())
+		} else {
+			response = commonDTO.NewBaseWithIdResponse(
+				reqId,
+				"",
+				http.StatusCreated,
+				newId)
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	pkg.Encode(addResponses, w, lc)
+}
+
+func (stc *SubscriptionTemplateController) AllSubscriptionTemplates(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(stc.dic.Get)
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(stc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	templates, err := application.AllSubscriptionTemplates(offset, limit, stc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewMultiSubscriptionTemplatesResponse("", "", http.StatusOK, templates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (stc *SubscriptionTemplateController) SubscriptionTemplateBySubscriptionName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(stc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	template, err := application.SubscriptionTemplateBySubscriptionName(name, stc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := pkgResponses.NewSubscriptionTemplateResponse("", "", http.StatusOK, template)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
+
+func (stc *SubscriptionTemplateController) DeleteSubscriptionTemplateBySubscriptionName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(stc.dic.Get)
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	err := application.DeleteSubscriptionTemplateBySubscriptionName(name, ctx, stc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(response, w, lc)
+}
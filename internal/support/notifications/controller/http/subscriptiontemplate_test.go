@@ -0,0 +1,211 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSubscriptionTemplateId = "3f2e1d0c-9b8a-4765-8432-1f0e9d8c7b6a"
+
+func buildTestSubscriptionTemplate() pkgDtos.SubscriptionTemplate {
+	return pkgDtos.SubscriptionTemplate{
+		SubscriptionName: testSubscriptionName,
+		Templates: []pkgDtos.ChannelTemplate{
+			{ChannelType: common.EMAIL, Engine: pkgModels.TemplateEngineHTML, Subject: "[{{.Severity}}] {{.Category}}", Body: "<p>{{.Content}}</p>"},
+			{Body: `{"text":{{json .Content}}}`, ContentType: common.ContentTypeJSON},
+		},
+	}
+}
+
+func TestAddSubscriptionTemplate(t *testing.T) {
+	valid := pkgRequests.NewAddSubscriptionTemplateRequest(buildTestSubscriptionTemplate())
+	unknownSubscription := pkgRequests.NewAddSubscriptionTemplateRequest(buildTestSubscriptionTemplate())
+	unknownSubscription.SubscriptionTemplate.SubscriptionName = "unknown"
+	malformed := pkgRequests.NewAddSubscriptionTemplateRequest(buildTestSubscriptionTemplate())
+	malformed.SubscriptionTemplate.Templates[0].Body = "{{.Content"
+	duplicateChannel := pkgRequests.NewAddSubscriptionTemplateRequest(buildTestSubscriptionTemplate())
+	duplicateChannel.SubscriptionTemplate.Templates[1].ChannelType = common.EMAIL
+	invalidEngine := pkgRequests.NewAddSubscriptionTemplateRequest(buildTestSubscriptionTemplate())
+	invalidEngine.SubscriptionTemplate.Templates[0].Engine = "markdown"
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "unknown").Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("AddSubscriptionTemplate", mock.Anything).Return(func(t pkgModels.SubscriptionTemplate) pkgModels.SubscriptionTemplate {
+		t.Id = testSubscriptionTemplateId
+		return t
+	}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionTemplateController(dic)
+
+	tests := []struct {
+		name                   string
+		request                pkgRequests.AddSubscriptionTemplateRequest
+		isValidRequest         bool
+		expectedHttpStatusCode int
+	}{
+		{"Valid", valid, true, http.StatusCreated},
+		{"Valid - subscription not found", unknownSubscription, true, http.StatusNotFound},
+		{"Valid - malformed template", malformed, true, http.StatusBadRequest},
+		{"Invalid - two templates of a channel type", duplicateChannel, false, http.StatusBadRequest},
+		{"Invalid - unknown engine", invalidEngine, false, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]pkgRequests.AddSubscriptionTemplateRequest{testCase.request})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiSubscriptionTemplateRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddSubscriptionTemplate)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.isValidRequest {
+				var res []commonDTO.BaseWithIdResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				require.Len(t, res, 1)
+				assert.Equal(t, testCase.expectedHttpStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+				if testCase.expectedHttpStatusCode == http.StatusCreated {
+					assert.Equal(t, testSubscriptionTemplateId, res[0].Id)
+				} else {
+					assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+				}
+			} else {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedHttpStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedHttpStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestSubscriptionTemplateBySubscriptionName(t *testing.T) {
+	template := pkgDtos.ToSubscriptionTemplateModel(buildTestSubscriptionTemplate())
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", testSubscriptionName).Return(template, nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", "notFound").Return(pkgModels.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription template doesn't exist in the database", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionTemplateController(dic)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - template found", testSubscriptionName, http.StatusOK},
+		{"Invalid - template not found", "notFound", http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiSubscriptionTemplateBySubscriptionNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.subscriptionName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.SubscriptionTemplateBySubscriptionName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res pkgResponses.SubscriptionTemplateResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testSubscriptionName, res.SubscriptionTemplate.SubscriptionName)
+				assert.Len(t, res.SubscriptionTemplate.Templates, 2)
+			}
+		})
+	}
+}
+
+func TestDeleteSubscriptionTemplateBySubscriptionName(t *testing.T) {
+	template := pkgDtos.ToSubscriptionTemplateModel(buildTestSubscriptionTemplate())
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", testSubscriptionName).Return(template, nil)
+	dbClientMock.On("SubscriptionTemplateBySubscriptionName", "notFound").Return(pkgModels.SubscriptionTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription template doesn't exist in the database", nil))
+	dbClientMock.On("DeleteSubscriptionTemplateBySubscriptionName", testSubscriptionName).Return(nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionTemplateController(dic)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - template deleted", testSubscriptionName, http.StatusOK},
+		{"Invalid - template not found", "notFound", http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, pkgCommon.ApiSubscriptionTemplateBySubscriptionNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.subscriptionName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeleteSubscriptionTemplateBySubscriptionName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
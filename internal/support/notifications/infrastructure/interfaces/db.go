@@ -6,6 +6,8 @@
 package interfaces
 
 import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 )
@@ -44,4 +46,22 @@ type DBClient interface {
 	TransmissionsByStatus(offset, limit int, status string) ([]models.Transmission, errors.EdgeX)
 	DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX
 	TransmissionsBySubscriptionName(offset, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX)
+
+	AddSubscriptionTemplate(t pkgModels.SubscriptionTemplate) (pkgModels.SubscriptionTemplate, errors.EdgeX)
+	SubscriptionTemplateBySubscriptionName(name string) (pkgModels.SubscriptionTemplate, errors.EdgeX)
+	DeleteSubscriptionTemplateBySubscriptionName(name string) errors.EdgeX
+	AllSubscriptionTemplates(offset int, limit int) ([]pkgModels.SubscriptionTemplate, errors.EdgeX)
+
+	AddSubscriptionDigest(d pkgModels.SubscriptionDigest) (pkgModels.SubscriptionDigest, errors.EdgeX)
+	SubscriptionDigestBySubscriptionName(name string) (pkgModels.SubscriptionDigest, errors.EdgeX)
+	UpdateSubscriptionDigest(d pkgModels.SubscriptionDigest) errors.EdgeX
+	DeleteSubscriptionDigestBySubscriptionName(name string) errors.EdgeX
+	AllSubscriptionDigests(offset int, limit int) ([]pkgModels.SubscriptionDigest, errors.EdgeX)
+
+	AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX)
 }
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,6 +17,29 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddAuditRecord provides a mock function with given fields: r
+func (_m *DBClient) AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	var r0 pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(pkgModels.AuditRecord) pkgModels.AuditRecord); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(pkgModels.AuditRecord)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.AuditRecord) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddNotification provides a mock function with given fields: n
 func (_m *DBClient) AddNotification(n models.Notification) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(n)
@@ -61,6 +86,52 @@ func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription,
 	return r0, r1
 }
 
+// AddSubscriptionDigest provides a mock function with given fields: d
+func (_m *DBClient) AddSubscriptionDigest(d pkgModels.SubscriptionDigest) (pkgModels.SubscriptionDigest, errors.EdgeX) {
+	ret := _m.Called(d)
+
+	var r0 pkgModels.SubscriptionDigest
+	if rf, ok := ret.Get(0).(func(pkgModels.SubscriptionDigest) pkgModels.SubscriptionDigest); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Get(0).(pkgModels.SubscriptionDigest)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.SubscriptionDigest) errors.EdgeX); ok {
+		r1 = rf(d)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddSubscriptionTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddSubscriptionTemplate(t pkgModels.SubscriptionTemplate) (pkgModels.SubscriptionTemplate, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	var r0 pkgModels.SubscriptionTemplate
+	if rf, ok := ret.Get(0).(func(pkgModels.SubscriptionTemplate) pkgModels.SubscriptionTemplate); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(pkgModels.SubscriptionTemplate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.SubscriptionTemplate) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddTransmission provides a mock function with given fields: trans
 func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX) {
 	ret := _m.Called(trans)
@@ -84,6 +155,56 @@ func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmiss
 	return r0, r1
 }
 
+// AllSubscriptionDigests provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllSubscriptionDigests(offset int, limit int) ([]pkgModels.SubscriptionDigest, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgModels.SubscriptionDigest
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.SubscriptionDigest); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.SubscriptionDigest)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSubscriptionTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllSubscriptionTemplates(offset int, limit int) ([]pkgModels.SubscriptionTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgModels.SubscriptionTemplate
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.SubscriptionTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.SubscriptionTemplate)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllSubscriptions provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -134,6 +255,106 @@ func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmissi
 	return r0, r1
 }
 
+// AuditRecordsByActor provides a mock function with given fields: service, actor, offset, limit
+func (_m *DBClient) AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, actor, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, actor, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(service, actor, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByEntity provides a mock function with given fields: entityType, name, offset, limit
+func (_m *DBClient) AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(entityType, name, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(pkgModels.AuditEntityType, string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(entityType, name, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.AuditEntityType, string, int, int) errors.EdgeX); ok {
+		r1 = rf(entityType, name, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByService provides a mock function with given fields: service, offset, limit
+func (_m *DBClient) AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int) errors.EdgeX); ok {
+		r1 = rf(service, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByTimeRange provides a mock function with given fields: service, start, end, offset, limit
+func (_m *DBClient) AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, start, end, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int, int, int) errors.EdgeX); ok {
+		r1 = rf(service, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CleanupNotificationsByAge provides a mock function with given fields: age
 func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
 	ret := _m.Called(age)
@@ -155,6 +376,29 @@ func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteAuditRecordsByAge provides a mock function with given fields: service, age
+func (_m *DBClient) DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX) {
+	ret := _m.Called(service, age)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int64) int); ok {
+		r0 = rf(service, age)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int64) errors.EdgeX); ok {
+		r1 = rf(service, age)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteNotificationById provides a mock function with given fields: id
 func (_m *DBClient) DeleteNotificationById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -219,6 +463,38 @@ func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteSubscriptionDigestBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSubscriptionDigestBySubscriptionName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionTemplateBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSubscriptionTemplateBySubscriptionName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NotificationById provides a mock function with given fields: id
 func (_m *DBClient) NotificationById(id string) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -413,6 +689,52 @@ func (_m *DBClient) SubscriptionByName(name string) (models.Subscription, errors
 	return r0, r1
 }
 
+// SubscriptionDigestBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) SubscriptionDigestBySubscriptionName(name string) (pkgModels.SubscriptionDigest, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 pkgModels.SubscriptionDigest
+	if rf, ok := ret.Get(0).(func(string) pkgModels.SubscriptionDigest); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(pkgModels.SubscriptionDigest)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SubscriptionTemplateBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) SubscriptionTemplateBySubscriptionName(name string) (pkgModels.SubscriptionTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 pkgModels.SubscriptionTemplate
+	if rf, ok := ret.Get(0).(func(string) pkgModels.SubscriptionTemplate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(pkgModels.SubscriptionTemplate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubscriptionsByCategoriesAndLabels provides a mock function with given fields: offset, limit, categories, labels
 func (_m *DBClient) SubscriptionsByCategoriesAndLabels(offset int, limit int, categories []string, labels []string) ([]models.Subscription, errors.EdgeX) {
 	ret := _m.Called(offset, limit, categories, labels)
@@ -643,6 +965,22 @@ func (_m *DBClient) UpdateSubscription(s models.Subscription) errors.EdgeX {
 	return r0
 }
 
+// UpdateSubscriptionDigest provides a mock function with given fields: d
+func (_m *DBClient) UpdateSubscriptionDigest(d pkgModels.SubscriptionDigest) errors.EdgeX {
+	ret := _m.Called(d)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgModels.SubscriptionDigest) errors.EdgeX); ok {
+		r0 = rf(d)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateTransmission provides a mock function with given fields: trans
 func (_m *DBClient) UpdateTransmission(trans models.Transmission) errors.EdgeX {
 	ret := _m.Called(trans)
@@ -20,10 +20,14 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 
 	"github.com/gorilla/mux"
 )
@@ -41,11 +45,33 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization for the notifications service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	LoadRestRoutes(b.router, dic)
 
+	// V2 audit trail
+	configuration := container.ConfigurationFrom(dic.Get)
+	if !audit.StartTrail(ctx, wg, dic, common.SupportNotificationsServiceKey, configuration.Audit, container.DBClientFrom(dic.Get)) {
+		return false
+	}
+
+	// V2 digest mode of the subscriptions
+	digester := application.NewDigester(dic)
+	dic.Update(di.ServiceConstructorMap{
+		application.DigesterName: func(get di.Get) interface{} {
+			return digester
+		},
+	})
+	digester.Start(ctx, wg)
+
 	restSender := channel.NewRESTSender(dic)
 	emailSender := channel.NewEmailSender(dic)
+	mqttSender := channel.NewMQTTSender(dic)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		mqttSender.Close()
+	}()
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -53,6 +79,9 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return mqttSender
+		},
 	})
 
 	return true
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	bootstrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-messaging/v2/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v2/pkg/types"
+)
+
+// BootstrapHandler fulfills the BootstrapHandler contract. If the message bus channel is enabled, it connects to the
+// MessageBus and adds the channel.MessageBusSender publishing the notifications to the DIC.
+func BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messageBusInfo := container.ConfigurationFrom(dic.Get).MessageQueue
+	if messageBusInfo.PublishTopicPrefix == "" {
+		return true
+	}
+
+	messageBusInfo.AuthMode = strings.ToLower(strings.TrimSpace(messageBusInfo.AuthMode))
+	if len(messageBusInfo.AuthMode) > 0 && messageBusInfo.AuthMode != bootstrapMessaging.AuthModeNone {
+		if err := bootstrapMessaging.SetOptionsAuthData(&messageBusInfo, lc, dic); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	msgClient, err := messaging.NewMessageClient(
+		types.MessageBusConfig{
+			PublishHost: types.HostInfo{
+				Host:     messageBusInfo.Host,
+				Port:     messageBusInfo.Port,
+				Protocol: messageBusInfo.Protocol,
+			},
+			Type:     messageBusInfo.Type,
+			Optional: messageBusInfo.Optional,
+		})
+
+	if err != nil {
+		lc.Errorf("Failed to create MessageClient: %v", err)
+		return false
+	}
+
+	for startupTimer.HasNotElapsed() {
+		select {
+		case <-ctx.Done():
+			return false
+		default:
+			err = msgClient.Connect()
+			if err != nil {
+				lc.Warnf("Unable to connect MessageBus: %v", err)
+				startupTimer.SleepForInterval()
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-ctx.Done()
+				_ = msgClient.Disconnect()
+				lc.Infof("Disconnected from MessageBus")
+			}()
+
+			sender := channel.NewMessageBusSender(dic, msgClient)
+			dic.Update(di.ServiceConstructorMap{
+				channel.MessageBusSenderName: func(get di.Get) interface{} {
+					return sender
+				},
+			})
+
+			lc.Infof(
+				"Connected to %s Message Bus @ %s://%s:%d publishing the notifications on '%s' prefix topic with AuthMode='%s'",
+				messageBusInfo.Type,
+				messageBusInfo.Protocol,
+				messageBusInfo.Host,
+				messageBusInfo.Port,
+				messageBusInfo.PublishTopicPrefix,
+				messageBusInfo.AuthMode)
+
+			return true
+		}
+	}
+
+	lc.Error("Connecting to MessageBus time out")
+	return false
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/gorilla/mux"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	notificationsController "github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/http"
@@ -37,6 +39,20 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiSubscriptionByNameRoute, sc.DeleteSubscriptionByName).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiSubscriptionRoute, sc.PatchSubscription).Methods(http.MethodPatch)
 
+	// Subscription template
+	stc := notificationsController.NewSubscriptionTemplateController(dic)
+	r.HandleFunc(pkgCommon.ApiSubscriptionTemplateRoute, stc.AddSubscriptionTemplate).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllSubscriptionTemplateRoute, stc.AllSubscriptionTemplates).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSubscriptionTemplateBySubscriptionNameRoute, stc.SubscriptionTemplateBySubscriptionName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSubscriptionTemplateBySubscriptionNameRoute, stc.DeleteSubscriptionTemplateBySubscriptionName).Methods(http.MethodDelete)
+
+	// Subscription digest
+	sdc := notificationsController.NewSubscriptionDigestController(dic)
+	r.HandleFunc(pkgCommon.ApiSubscriptionDigestRoute, sdc.AddSubscriptionDigest).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllSubscriptionDigestRoute, sdc.AllSubscriptionDigests).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSubscriptionDigestBySubscriptionNameRoute, sdc.SubscriptionDigestBySubscriptionName).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSubscriptionDigestBySubscriptionNameRoute, sdc.DeleteSubscriptionDigestBySubscriptionName).Methods(http.MethodDelete)
+
 	// Notification
 	nc := notificationsController.NewNotificationController(dic)
 	r.HandleFunc(common.ApiNotificationRoute, nc.AddNotification).Methods(http.MethodPost)
@@ -60,6 +76,14 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiTransmissionByAgeRoute, trans.DeleteProcessedTransmissionsByAge).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiTransmissionBySubscriptionNameRoute, trans.TransmissionsBySubscriptionName).Methods(http.MethodGet)
 
+	// Audit
+	ac := commonController.NewAuditController(dic)
+	r.HandleFunc(pkgCommon.ApiAllAuditRoute, ac.AllAuditRecords).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByTimeRangeRoute, ac.AuditRecordsByTimeRange).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByEntityRoute, ac.AuditRecordsByEntity).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByActorRoute, ac.AuditRecordsByActor).Methods(http.MethodGet)
+
 	r.Use(correlation.ManageHeader)
+	r.Use(audit.ManageActor)
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 }
@@ -9,7 +9,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
@@ -38,6 +40,7 @@ func AddInterval(interval models.Interval, ctx context.Context, dic *di.Containe
 	lc.Debugf("Interval created on DB successfully. Interval ID: %s, Correlation-ID: %s ",
 		addedInterval.Id,
 		correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditInterval, addedInterval.Name, nil, dtos.FromIntervalModelToDTO(addedInterval))
 
 	return addedInterval.Id, nil
 }
@@ -87,6 +90,10 @@ func DeleteIntervalByName(name string, ctx context.Context, dic *di.Container) e
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the interval when associated intervalAction exists", nil)
 	}
 
+	interval, err := dbClient.IntervalByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	err = dbClient.DeleteIntervalByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -95,6 +102,7 @@ func DeleteIntervalByName(name string, ctx context.Context, dic *di.Container) e
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditInterval, name, dtos.FromIntervalModelToDTO(interval), nil)
 	return nil
 }
 
@@ -129,6 +137,7 @@ func PatchInterval(dto dtos.UpdateInterval, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to patch the interval when associated intervalAction exists", nil)
 	}
 
+	before := dtos.FromIntervalModelToDTO(interval)
 	requests.ReplaceIntervalModelFieldsWithDTO(&interval, dto)
 
 	edgeXerr = dbClient.UpdateInterval(interval)
@@ -144,6 +153,7 @@ func PatchInterval(dto dtos.UpdateInterval, ctx context.Context, dic *di.Contain
 		"Interval patched on DB successfully. Correlation-ID: %s ",
 		correlation.FromContext(ctx),
 	)
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditInterval, interval.Name, before, dtos.FromIntervalModelToDTO(interval))
 	return nil
 }
 
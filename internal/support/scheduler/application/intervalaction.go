@@ -9,7 +9,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 
@@ -45,6 +47,7 @@ func AddIntervalAction(action models.IntervalAction, ctx context.Context, dic *d
 	lc.Debugf("IntervalAction created on DB successfully. IntervalAction ID: %s, Correlation-ID: %s ",
 		addedAction.Id,
 		correlation.FromContext(ctx))
+	audit.Record(ctx, dic, pkgModels.AuditAdd, pkgModels.AuditIntervalAction, addedAction.Name, nil, dtos.FromIntervalActionModelToDTO(addedAction))
 
 	return addedAction.Id, nil
 }
@@ -86,7 +89,11 @@ func DeleteIntervalActionByName(name string, ctx context.Context, dic *di.Contai
 	dbClient := container.DBClientFrom(dic.Get)
 	schedulerManager := container.SchedulerManagerFrom(dic.Get)
 
-	err := dbClient.DeleteIntervalActionByName(name)
+	action, err := dbClient.IntervalActionByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteIntervalActionByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -94,6 +101,7 @@ func DeleteIntervalActionByName(name string, ctx context.Context, dic *di.Contai
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	audit.Record(ctx, dic, pkgModels.AuditDelete, pkgModels.AuditIntervalAction, name, dtos.FromIntervalActionModelToDTO(action), nil)
 	return nil
 }
 
@@ -128,6 +136,7 @@ func PatchIntervalAction(dto dtos.UpdateIntervalAction, ctx context.Context, dic
 		}
 	}
 
+	before := dtos.FromIntervalActionModelToDTO(action)
 	requests.ReplaceIntervalActionModelFieldsWithDTO(&action, dto)
 
 	edgeXerr = dbClient.UpdateIntervalAction(action)
@@ -142,6 +151,7 @@ func PatchIntervalAction(dto dtos.UpdateIntervalAction, ctx context.Context, dic
 		"IntervalAction patched on DB successfully. Correlation-ID: %s ",
 		correlation.FromContext(ctx),
 	)
+	audit.Record(ctx, dic, pkgModels.AuditUpdate, pkgModels.AuditIntervalAction, action.Name, before, dtos.FromIntervalActionModelToDTO(action))
 	return nil
 }
 
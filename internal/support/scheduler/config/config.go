@@ -15,6 +15,8 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+
 	"fmt"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
@@ -30,6 +32,7 @@ type ConfigurationStruct struct {
 	Intervals       map[string]IntervalInfo
 	IntervalActions map[string]IntervalActionInfo
 	SecretStore     bootstrapConfig.SecretStoreInfo
+	Audit           audit.TrailInfo
 	// ScheduleIntervalTime is a time(Millisecond) to create a ticker to delay the scheduler loop
 	ScheduleIntervalTime int
 }
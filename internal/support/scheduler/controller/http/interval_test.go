@@ -306,8 +306,10 @@ func TestDeleteIntervalByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	schedulerManagerMock := &dbMock.SchedulerManager{}
+	dbClientMock.On("IntervalByName", interval.Name).Return(interval, nil)
 	dbClientMock.On("DeleteIntervalByName", interval.Name).Return(nil)
 	dbClientMock.On("IntervalActionsByIntervalName", 0, 1, interval.Name).Return([]models.IntervalAction{}, nil)
+	dbClientMock.On("IntervalByName", notFoundName).Return(models.Interval{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "interval doesn't exist in the database", nil))
 	dbClientMock.On("DeleteIntervalByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "interval doesn't exist in the database", nil))
 	dbClientMock.On("IntervalActionsByIntervalName", 0, 1, notFoundName).Return([]models.IntervalAction{}, nil)
 	schedulerManagerMock.On("DeleteIntervalByName", interval.Name).Return(nil)
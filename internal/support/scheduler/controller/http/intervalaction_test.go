@@ -271,8 +271,10 @@ func TestDeleteIntervalActionByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	schedulerManagerMock := &dbMock.SchedulerManager{}
+	dbClientMock.On("IntervalActionByName", action.Name).Return(action, nil)
 	dbClientMock.On("DeleteIntervalActionByName", action.Name).Return(nil)
 	schedulerManagerMock.On("DeleteIntervalActionByName", action.Name).Return(nil)
+	dbClientMock.On("IntervalActionByName", notFoundName).Return(models.IntervalAction{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "intervalAction doesn't exist in the database", nil))
 	dbClientMock.On("DeleteIntervalActionByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "intervalAction doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
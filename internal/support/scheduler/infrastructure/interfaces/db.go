@@ -6,6 +6,8 @@
 package interfaces
 
 import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 )
@@ -27,4 +29,11 @@ type DBClient interface {
 	DeleteIntervalActionByName(name string) errors.EdgeX
 	IntervalActionById(id string) (model.IntervalAction, errors.EdgeX)
 	UpdateIntervalAction(action model.IntervalAction) errors.EdgeX
+
+	AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX)
+	DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX)
 }
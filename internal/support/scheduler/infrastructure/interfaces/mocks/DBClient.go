@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,6 +17,29 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddAuditRecord provides a mock function with given fields: r
+func (_m *DBClient) AddAuditRecord(r pkgModels.AuditRecord) (pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	var r0 pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(pkgModels.AuditRecord) pkgModels.AuditRecord); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(pkgModels.AuditRecord)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.AuditRecord) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddInterval provides a mock function with given fields: interval
 func (_m *DBClient) AddInterval(interval models.Interval) (models.Interval, errors.EdgeX) {
 	ret := _m.Called(interval)
@@ -111,11 +136,134 @@ func (_m *DBClient) AllIntervals(offset int, limit int) ([]models.Interval, erro
 	return r0, r1
 }
 
+// AuditRecordsByActor provides a mock function with given fields: service, actor, offset, limit
+func (_m *DBClient) AuditRecordsByActor(service string, actor string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, actor, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, actor, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(service, actor, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByEntity provides a mock function with given fields: entityType, name, offset, limit
+func (_m *DBClient) AuditRecordsByEntity(entityType pkgModels.AuditEntityType, name string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(entityType, name, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(pkgModels.AuditEntityType, string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(entityType, name, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgModels.AuditEntityType, string, int, int) errors.EdgeX); ok {
+		r1 = rf(entityType, name, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByService provides a mock function with given fields: service, offset, limit
+func (_m *DBClient) AuditRecordsByService(service string, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int) errors.EdgeX); ok {
+		r1 = rf(service, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordsByTimeRange provides a mock function with given fields: service, start, end, offset, limit
+func (_m *DBClient) AuditRecordsByTimeRange(service string, start int, end int, offset int, limit int) ([]pkgModels.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(service, start, end, offset, limit)
+
+	var r0 []pkgModels.AuditRecord
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) []pkgModels.AuditRecord); ok {
+		r0 = rf(service, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.AuditRecord)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int, int, int) errors.EdgeX); ok {
+		r1 = rf(service, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteAuditRecordsByAge provides a mock function with given fields: service, age
+func (_m *DBClient) DeleteAuditRecordsByAge(service string, age int64) (int, errors.EdgeX) {
+	ret := _m.Called(service, age)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int64) int); ok {
+		r0 = rf(service, age)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int64) errors.EdgeX); ok {
+		r1 = rf(service, age)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteIntervalActionByName provides a mock function with given fields: name
 func (_m *DBClient) DeleteIntervalActionByName(name string) errors.EdgeX {
 	ret := _m.Called(name)
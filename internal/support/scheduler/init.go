@@ -19,6 +19,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application/scheduler"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
@@ -26,6 +27,7 @@ import (
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 
 	"github.com/gorilla/mux"
 )
@@ -49,6 +51,11 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	configuration := container.ConfigurationFrom(dic.Get)
 
+	// V2 audit trail
+	if !audit.StartTrail(ctx, wg, dic, common.SupportSchedulerServiceKey, configuration.Audit, container.DBClientFrom(dic.Get)) {
+		return false
+	}
+
 	// V2 Scheduler
 	schedulerManager := scheduler.NewManager(lc, configuration)
 	dic.Update(di.ServiceConstructorMap{
@@ -12,6 +12,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/gorilla/mux"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	schedulerController "github.com/edgexfoundry/edgex-go/internal/support/scheduler/controller/http"
@@ -41,6 +43,14 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(common.ApiIntervalActionByNameRoute, action.DeleteIntervalActionByName).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiIntervalActionRoute, action.PatchIntervalAction).Methods(http.MethodPatch)
 
+	// Audit
+	ac := commonController.NewAuditController(dic)
+	r.HandleFunc(pkgCommon.ApiAllAuditRoute, ac.AllAuditRecords).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByTimeRangeRoute, ac.AuditRecordsByTimeRange).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByEntityRoute, ac.AuditRecordsByEntity).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiAuditByActorRoute, ac.AuditRecordsByActor).Methods(http.MethodGet)
+
 	r.Use(correlation.ManageHeader)
+	r.Use(audit.ManageActor)
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 }